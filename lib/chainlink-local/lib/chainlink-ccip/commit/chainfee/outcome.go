@@ -10,6 +10,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 
+	"github.com/smartcontractkit/chainlink-ccip/commit/internal/pricebudget"
 	"github.com/smartcontractkit/chainlink-ccip/internal/libs/mathslib"
 
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
@@ -138,36 +139,42 @@ func (p *processor) getConsensusObservation(
 
 	twoFChainPlus1 := consensus.MakeMultiThreshold(fChains, consensus.TwoFPlus1)
 
-	feeComponents := consensus.GetConsensusMapAggregator(
-		lggr,
-		"FeeComponents",
-		aggObs.FeeComponents,
-		twoFChainPlus1,
-		// Aggregator function
-		func(vals []types.ChainFeeComponents) types.ChainFeeComponents {
-			executionFees := make([]cciptypes.BigInt, len(vals))
-			dataAvailabilityFees := make([]cciptypes.BigInt, len(vals))
-			for i, feeComp := range vals {
-				executionFees[i] = cciptypes.NewBigInt(feeComp.ExecutionFee)
-				dataAvailabilityFees[i] = cciptypes.NewBigInt(feeComp.DataAvailabilityFee)
-			}
-			return types.ChainFeeComponents{
-				ExecutionFee:        consensus.Median(executionFees, consensus.BigIntComparator).Int,
-				DataAvailabilityFee: consensus.Median(dataAvailabilityFees, consensus.BigIntComparator).Int,
-			}
-		},
-	)
+	var feeComponents map[cciptypes.ChainSelector]types.ChainFeeComponents
+	var nativeTokenPrices map[cciptypes.ChainSelector]cciptypes.BigInt
+	if p.cfg.PriceConsensus != nil {
+		feeComponents, nativeTokenPrices = p.getWeightedPriceConsensus(lggr, aos, twoFChainPlus1)
+	} else {
+		feeComponents = consensus.GetConsensusMapAggregator(
+			lggr,
+			"FeeComponents",
+			aggObs.FeeComponents,
+			twoFChainPlus1,
+			// Aggregator function
+			func(vals []types.ChainFeeComponents) types.ChainFeeComponents {
+				executionFees := make([]cciptypes.BigInt, len(vals))
+				dataAvailabilityFees := make([]cciptypes.BigInt, len(vals))
+				for i, feeComp := range vals {
+					executionFees[i] = cciptypes.NewBigInt(feeComp.ExecutionFee)
+					dataAvailabilityFees[i] = cciptypes.NewBigInt(feeComp.DataAvailabilityFee)
+				}
+				return types.ChainFeeComponents{
+					ExecutionFee:        consensus.Median(executionFees, consensus.BigIntComparator).Int,
+					DataAvailabilityFee: consensus.Median(dataAvailabilityFees, consensus.BigIntComparator).Int,
+				}
+			},
+		)
 
-	nativeTokenPrices := consensus.GetConsensusMapAggregator(
-		lggr,
-		"NativeTokenPrices",
-		aggObs.NativeTokenPrices,
-		twoFChainPlus1,
-		// Aggregator function
-		func(vals []cciptypes.BigInt) cciptypes.BigInt {
-			return consensus.Median(vals, consensus.BigIntComparator)
-		},
-	)
+		nativeTokenPrices = consensus.GetConsensusMapAggregator(
+			lggr,
+			"NativeTokenPrices",
+			aggObs.NativeTokenPrices,
+			twoFChainPlus1,
+			// Aggregator function
+			func(vals []cciptypes.BigInt) cciptypes.BigInt {
+				return consensus.Median(vals, consensus.BigIntComparator)
+			},
+		)
+	}
 
 	consensusObs := Observation{
 		FChain:            fChains,
@@ -180,6 +187,61 @@ func (p *processor) getConsensusObservation(
 	return consensusObs, nil
 }
 
+// getWeightedPriceConsensus reaches consensus on the observed fees and native token prices, each observation
+// is weighted by the weight of its oracle and the outliers are rejected according to the price consensus config.
+func (p *processor) getWeightedPriceConsensus(
+	lggr logger.Logger,
+	aos []plugincommon.AttributedObservation[Observation],
+	thresh consensus.MultiThreshold[cciptypes.ChainSelector],
+) (
+	feeComponents map[cciptypes.ChainSelector]types.ChainFeeComponents,
+	nativeTokenPrices map[cciptypes.ChainSelector]cciptypes.BigInt,
+) {
+	weights := consensus.OracleWeights(p.cfg.PriceConsensus.OracleWeights)
+	minWeight := weights.QuorumWeight()
+	bigIntAggregator := consensus.BigIntWeightedAggregator(p.cfg.PriceConsensus.OutlierMADMultiplier)
+
+	weightedFeeComponents := make(map[cciptypes.ChainSelector][]consensus.Weighted[types.ChainFeeComponents])
+	weightedNativeTokenPrices := make(map[cciptypes.ChainSelector][]consensus.Weighted[cciptypes.BigInt])
+	for _, ao := range aos {
+		weight := weights.Weight(ao.OracleID)
+		for chainSel, feeComp := range ao.Observation.FeeComponents {
+			weightedFeeComponents[chainSel] = append(weightedFeeComponents[chainSel],
+				consensus.Weighted[types.ChainFeeComponents]{Value: feeComp, Weight: weight})
+		}
+		for chainSel, tokenPrice := range ao.Observation.NativeTokenPrices {
+			weightedNativeTokenPrices[chainSel] = append(weightedNativeTokenPrices[chainSel],
+				consensus.Weighted[cciptypes.BigInt]{Value: tokenPrice, Weight: weight})
+		}
+	}
+
+	feeComponents = consensus.GetWeightedConsensusMapAggregator(
+		lggr,
+		"FeeComponents",
+		weightedFeeComponents,
+		thresh,
+		minWeight,
+		// the execution and data availability fees are aggregated independently.
+		func(vals []consensus.Weighted[types.ChainFeeComponents]) types.ChainFeeComponents {
+			executionFees := make([]consensus.Weighted[cciptypes.BigInt], len(vals))
+			dataAvailabilityFees := make([]consensus.Weighted[cciptypes.BigInt], len(vals))
+			for i, feeComp := range vals {
+				executionFees[i] = consensus.Weighted[cciptypes.BigInt]{
+					Value: cciptypes.NewBigInt(feeComp.Value.ExecutionFee), Weight: feeComp.Weight}
+				dataAvailabilityFees[i] = consensus.Weighted[cciptypes.BigInt]{
+					Value: cciptypes.NewBigInt(feeComp.Value.DataAvailabilityFee), Weight: feeComp.Weight}
+			}
+			return types.ChainFeeComponents{
+				ExecutionFee:        bigIntAggregator(executionFees).Int,
+				DataAvailabilityFee: bigIntAggregator(dataAvailabilityFees).Int,
+			}
+		},
+	)
+	nativeTokenPrices = consensus.GetWeightedConsensusMapAggregator(
+		lggr, "NativeTokenPrices", weightedNativeTokenPrices, thresh, minWeight, bigIntAggregator)
+	return feeComponents, nativeTokenPrices
+}
+
 func aggregateObservations(aos []plugincommon.AttributedObservation[Observation]) AggregateObservation {
 	aggObs := AggregateObservation{
 		FeeComponents:     make(map[cciptypes.ChainSelector][]types.ChainFeeComponents),
@@ -223,13 +285,15 @@ func aggregateObservations(aos []plugincommon.AttributedObservation[Observation]
 // A chain fee is selected for update if it meets one of 2 conditions:
 // 1. If time passed since the last update is greater than the stale threshold.
 // 2. If deviation between the fee quoter and latest observed chain fee exceeds the chain's configured threshold.
+// If a price update budget is configured, the deviating chain fees that do not fit in the budget are suppressed.
 func (p *processor) getGasPricesToUpdate(
 	lggr logger.Logger,
 	currentChainUSDFees map[cciptypes.ChainSelector]ComponentsUSDPrices,
 	latestUpdates map[cciptypes.ChainSelector]Update,
 	consensusTimestamp time.Time,
 ) []cciptypes.GasPriceChain {
-	var gasPrices []cciptypes.GasPriceChain
+	gasPrices := make(map[cciptypes.ChainSelector]cciptypes.GasPriceChain)
+	candidates := make([]pricebudget.Candidate[cciptypes.ChainSelector], 0, len(currentChainUSDFees))
 
 	for chain, currentChainFee := range currentChainUSDFees {
 		chainCfg, err := p.homeChain.GetChainConfig(chain)
@@ -250,10 +314,11 @@ func (p *processor) getGasPricesToUpdate(
 		// If the chain is not in the fee quoter updates or is stale, then we should update it
 		if !exists {
 			lggr.Infow("chain fee update needed: no previous update exists")
-			gasPrices = append(gasPrices, cciptypes.GasPriceChain{
+			gasPrices[chain] = cciptypes.GasPriceChain{
 				ChainSel: chain,
 				GasPrice: packedFee,
-			})
+			}
+			candidates = append(candidates, pricebudget.Candidate[cciptypes.ChainSelector]{Key: chain, Mandatory: true})
 			continue
 		}
 
@@ -263,10 +328,11 @@ func (p *processor) getGasPricesToUpdate(
 				"nextUpdateTime", nextUpdateTime,
 				"consensusTimestamp", consensusTimestamp,
 				"heartbeatInterval", p.cfg.RemoteGasPriceBatchWriteFrequency)
-			gasPrices = append(gasPrices, cciptypes.GasPriceChain{
+			gasPrices[chain] = cciptypes.GasPriceChain{
 				ChainSel: chain,
 				GasPrice: packedFee,
-			})
+			}
+			candidates = append(candidates, pricebudget.Candidate[cciptypes.ChainSelector]{Key: chain, Mandatory: true})
 			continue
 		}
 
@@ -300,9 +366,13 @@ func (p *processor) getGasPricesToUpdate(
 				"dataAvFeeDeviates", dataAvFeeDeviates,
 				"executionFeeDeviationPPB", feeConfig.GasPriceDeviationPPB,
 				"dataAvFeeDeviationPPB", feeConfig.DAGasPriceDeviationPPB)
-			gasPrices = append(gasPrices, cciptypes.GasPriceChain{
+			gasPrices[chain] = cciptypes.GasPriceChain{
 				ChainSel: chain,
 				GasPrice: packedFee,
+			}
+			candidates = append(candidates, pricebudget.Candidate[cciptypes.ChainSelector]{
+				Key:          chain,
+				DeviationPPB: chainFeeDeviationPPB(currentChainFee, lastUpdate.ChainFee),
 			})
 		} else {
 			lggr.Debugw("chain fee update not needed: within deviation thresholds",
@@ -311,7 +381,67 @@ func (p *processor) getGasPricesToUpdate(
 		}
 	}
 
-	return gasPrices
+	selected := p.selectWithinBudget(lggr, candidates, latestUpdates, consensusTimestamp)
+	var updates []cciptypes.GasPriceChain
+	for _, chain := range selected {
+		updates = append(updates, gasPrices[chain])
+	}
+	return updates
+}
+
+// selectWithinBudget returns the chains whose fees should be updated according to the price update budget.
+// All the candidates are returned if the budget of the gas price updates is not configured.
+func (p *processor) selectWithinBudget(
+	lggr logger.Logger,
+	candidates []pricebudget.Candidate[cciptypes.ChainSelector],
+	latestUpdates map[cciptypes.ChainSelector]Update,
+	consensusTimestamp time.Time,
+) []cciptypes.ChainSelector {
+	if p.cfg.PriceUpdateBudget == nil {
+		return pricebudget.Select(candidates, len(candidates))
+	}
+
+	budget := pricebudget.NewBudget(
+		p.cfg.PriceUpdateBudget.Window.Duration(),
+		p.cfg.PriceUpdateBudget.GasPriceUpdatesMaxGas,
+		p.cfg.PriceUpdateBudget.GasPerGasPriceUpdate,
+	)
+	if !budget.Enabled() {
+		return pricebudget.Select(candidates, len(candidates))
+	}
+
+	lastUpdates := make([]time.Time, 0, len(latestUpdates))
+	for _, update := range latestUpdates {
+		lastUpdates = append(lastUpdates, update.Timestamp)
+	}
+	remaining := budget.Remaining(consensusTimestamp, lastUpdates)
+
+	selected := pricebudget.Select(candidates, remaining)
+	if len(selected) < len(candidates) {
+		lggr.Infow("chain fee updates suppressed by the price update budget",
+			"remainingUpdates", remaining,
+			"candidates", len(candidates),
+			"selected", selected)
+	}
+	return selected
+}
+
+// chainFeeDeviationPPB returns the largest deviation of the fee components from the last onchain update,
+// nil if the deviation is unbounded.
+func chainFeeDeviationPPB(current, last ComponentsUSDPrices) *big.Int {
+	deviation := mathslib.DeviationPPB(current.ExecutionFeePriceUSD, last.ExecutionFeePriceUSD)
+	if deviation == nil {
+		return nil
+	}
+
+	daDeviation := mathslib.DeviationPPB(current.DataAvFeePriceUSD, last.DataAvFeePriceUSD)
+	if daDeviation == nil {
+		return nil
+	}
+	if daDeviation.Cmp(deviation) > 0 {
+		return daDeviation
+	}
+	return deviation
 }
 
 // chainFeeUpdateAggregator aggregates a slice of ChainFeeUpdates into a single Update
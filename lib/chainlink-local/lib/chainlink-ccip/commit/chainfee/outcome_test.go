@@ -204,6 +204,42 @@ func TestGetConsensusObservation(t *testing.T) {
 	assert.Len(t, consensusObs.NativeTokenPrices, 2)
 }
 
+func TestGetConsensusObservation_PriceConsensus(t *testing.T) {
+	lggr := logger.Test(t)
+	p := &processor{
+		lggr:      lggr,
+		destChain: internal.EvmChainSelector,
+		fRoleDON:  1,
+		cfg: pluginconfig.CommitOffchainConfig{
+			PriceConsensus: &pluginconfig.PriceConsensusConfig{OutlierMADMultiplier: 3},
+		},
+	}
+
+	aos := sameObs(4, obsNeedUpdate)
+	for i, execFee := range []int64{100, 101, 102, 1_000_000} {
+		obs := aos[i].Observation
+		obs.FeeComponents = map[cciptypes.ChainSelector]types.ChainFeeComponents{
+			internal.EvmChainSelector: {
+				ExecutionFee:        big.NewInt(execFee),
+				DataAvailabilityFee: feeComponentsMap[internal.EvmChainSelector].DataAvailabilityFee,
+			},
+		}
+		aos[i].Observation = obs
+	}
+
+	consensusObs, err := p.getConsensusObservation(lggr, aos)
+	require.NoError(t, err)
+	// the extreme execution fee is rejected, the plain median would be 102.
+	assert.Len(t, consensusObs.FeeComponents, 1)
+	assert.Equal(t, int64(101), consensusObs.FeeComponents[internal.EvmChainSelector].ExecutionFee.Int64())
+	assert.Equal(t, feeComponentsMap[internal.EvmChainSelector].DataAvailabilityFee,
+		consensusObs.FeeComponents[internal.EvmChainSelector].DataAvailabilityFee)
+	// chain selector 2 has an F of 2 and does not reach consensus with 4 observations.
+	assert.Len(t, consensusObs.NativeTokenPrices, 1)
+	assert.Equal(t, nativeTokenPricesMap[internal.EvmChainSelector],
+		consensusObs.NativeTokenPrices[internal.EvmChainSelector])
+}
+
 func TestProcessor_Outcome(t *testing.T) {
 	oneMinuteAgo := time.Now().Add(-time.Minute).UTC()
 	numOracles := 5 // Use a consistent number for generating aos
@@ -411,3 +447,81 @@ func TestProcessor_Outcome(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessor_getGasPricesToUpdate_PriceUpdateBudget(t *testing.T) {
+	oneMinuteAgo := ts.Add(-time.Minute)
+	lastChainFee := ComponentsUSDPrices{ExecutionFeePriceUSD: big.NewInt(2), DataAvFeePriceUSD: big.NewInt(1)}
+	latestUpdates := map[cciptypes.ChainSelector]Update{
+		internal.EvmChainSelector:  {Timestamp: oneMinuteAgo, ChainFee: lastChainFee},
+		internal.EvmChainSelector2: {Timestamp: oneMinuteAgo, ChainFee: lastChainFee},
+	}
+	currentChainUSDFees := map[cciptypes.ChainSelector]ComponentsUSDPrices{
+		// 100% execution fee deviation
+		internal.EvmChainSelector: {ExecutionFeePriceUSD: big.NewInt(4), DataAvFeePriceUSD: big.NewInt(1)},
+		// 50% execution fee deviation
+		internal.EvmChainSelector2: {ExecutionFeePriceUSD: big.NewInt(3), DataAvFeePriceUSD: big.NewInt(1)},
+	}
+
+	testCases := []struct {
+		name      string
+		budget    *pluginconfig.PriceUpdateBudgetConfig
+		expChains []cciptypes.ChainSelector
+	}{
+		{
+			name:      "no budget",
+			expChains: []cciptypes.ChainSelector{internal.EvmChainSelector, internal.EvmChainSelector2},
+		},
+		{
+			name: "largest deviation is selected first",
+			budget: &pluginconfig.PriceUpdateBudgetConfig{
+				Window:                *commonconfig.MustNewDuration(time.Hour),
+				GasPriceUpdatesMaxGas: 300, // 2 updates within the window, 1 remaining
+				GasPerGasPriceUpdate:  100,
+			},
+			expChains: []cciptypes.ChainSelector{internal.EvmChainSelector},
+		},
+		{
+			name: "budget exhausted",
+			budget: &pluginconfig.PriceUpdateBudgetConfig{
+				Window:                *commonconfig.MustNewDuration(time.Hour),
+				GasPriceUpdatesMaxGas: 200,
+				GasPerGasPriceUpdate:  100,
+			},
+			expChains: []cciptypes.ChainSelector{},
+		},
+		{
+			name: "updates outside of the window do not consume the budget",
+			budget: &pluginconfig.PriceUpdateBudgetConfig{
+				Window:                *commonconfig.MustNewDuration(time.Second),
+				GasPriceUpdatesMaxGas: 200,
+				GasPerGasPriceUpdate:  100,
+			},
+			expChains: []cciptypes.ChainSelector{internal.EvmChainSelector, internal.EvmChainSelector2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			homeChainMock := mock_home_chain.NewMockHomeChain(t)
+			homeChainMock.EXPECT().GetChainConfig(mock.Anything).Return(defaultChainConfig, nil)
+			p := &processor{
+				lggr:      logger.Test(t),
+				destChain: internal.EvmChainSelector,
+				fRoleDON:  1,
+				cfg: pluginconfig.CommitOffchainConfig{
+					RemoteGasPriceBatchWriteFrequency: *commonconfig.MustNewDuration(time.Hour),
+					PriceUpdateBudget:                 tc.budget,
+				},
+				metricsReporter: plugincommon.NoopReporter{},
+				homeChain:       homeChainMock,
+			}
+
+			gasPrices := p.getGasPricesToUpdate(p.lggr, currentChainUSDFees, latestUpdates, ts)
+			chains := make([]cciptypes.ChainSelector, 0, len(gasPrices))
+			for _, gp := range gasPrices {
+				chains = append(chains, gp.ChainSel)
+			}
+			assert.ElementsMatch(t, tc.expChains, chains)
+		})
+	}
+}
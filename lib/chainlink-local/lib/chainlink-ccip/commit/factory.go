@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
 
 	sel "github.com/smartcontractkit/chain-selectors"
 
@@ -18,9 +19,9 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/commit/internal/builder"
 	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn"
 	"github.com/smartcontractkit/chainlink-ccip/commit/metrics"
+	"github.com/smartcontractkit/chainlink-ccip/commit/replay"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal/reader"
-	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
 	readerpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
@@ -77,6 +78,7 @@ type PluginFactory struct {
 	chainWriters      map[cciptypes.ChainSelector]types.ContractWriter
 	rmnPeerClient     rmn.PeerClient
 	rmnCrypto         cciptypes.RMNCrypto
+	recording         *replay.RecorderConfig
 }
 
 type CommitPluginFactoryParams struct {
@@ -92,6 +94,9 @@ type CommitPluginFactoryParams struct {
 	ContractWriters   map[cciptypes.ChainSelector]types.ContractWriter
 	RmnPeerClient     rmn.PeerClient
 	RmnCrypto         cciptypes.RMNCrypto
+	// Recording enables the recording of the rounds of the plugin for replays, disabled if not set.
+	// The rounds of every OCR config are recorded in a sub directory named after the config digest.
+	Recording *replay.RecorderConfig
 }
 
 // NewCommitPluginFactory creates a new PluginFactory instance. For commit plugin, oracle instances are not managed by
@@ -110,6 +115,7 @@ func NewCommitPluginFactory(params CommitPluginFactoryParams) *PluginFactory {
 		chainWriters:      params.ContractWriters,
 		rmnPeerClient:     params.RmnPeerClient,
 		rmnCrypto:         params.RmnCrypto,
+		recording:         params.Recording,
 	}
 }
 
@@ -127,6 +133,10 @@ func (p *PluginFactory) NewReportingPlugin(ctx context.Context, config ocr3types
 		return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to validate commit offchain config: %w", err)
 	}
 
+	if err = offchainConfig.ValidateFeedAddresses(p.addrCodec); err != nil {
+		return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to validate commit offchain config: %w", err)
+	}
+
 	var oracleIDToP2PID = make(map[commontypes.OracleID]ragep2ptypes.PeerID)
 	for oracleID, node := range p.ocrConfig.Config.Nodes {
 		oracleIDToP2PID[commontypes.OracleID(oracleID)] = node.P2pID
@@ -190,13 +200,11 @@ func (p *PluginFactory) NewReportingPlugin(ctx context.Context, config ocr3types
 	// The node supports the chain that the token prices are on.
 	_, ok := readers[offchainConfig.PriceFeedChainSelector]
 	if ok {
-		// Bind all token aggregate contracts
-		var bcs []types.BoundContract
-		for _, info := range offchainConfig.TokenInfo {
-			bcs = append(bcs, types.BoundContract{
-				Address: string(info.AggregatorAddress),
-				Name:    consts.ContractNamePriceAggregator,
-			})
+		// Bind all token aggregate and exchange rate contracts
+		bcs, err1 := readerpkg.FeedChainContracts(
+			offchainConfig.TokenInfo, offchainConfig.PriceFeedChainSelector, p.addrCodec)
+		if err1 != nil {
+			return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to get token price contracts: %w", err1)
 		}
 		if err1 := readers[offchainConfig.PriceFeedChainSelector].Bind(ctx, bcs); err1 != nil {
 			return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to bind token price contracts: %w", err1)
@@ -217,43 +225,61 @@ func (p *PluginFactory) NewReportingPlugin(ctx context.Context, config ocr3types
 		return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to create metrics reporter: %w", err)
 	}
 
-	reportBuilder, err := builder.NewReportBuilder(
-		offchainConfig.RMNEnabled,
-		offchainConfig.MaxMerkleRootsPerReport,
-		offchainConfig.MaxPricesPerReport,
+	var reportBuilder builder.ReportBuilderFunc
+	if offchainConfig.ReportSplitting != nil {
+		reportBuilder = builder.NewSplitReportBuilder(
+			builder.NewConfigEstimateProvider(*offchainConfig.ReportSplitting), maxReportCount)
+	} else {
+		reportBuilder, err = builder.NewReportBuilder(
+			offchainConfig.RMNEnabled,
+			offchainConfig.MaxMerkleRootsPerReport,
+			offchainConfig.MaxPricesPerReport,
+		)
+		if err != nil {
+			return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to create report builder: %w", err)
+		}
+	}
+
+	var plugin ocr3types.ReportingPlugin[[]byte] = NewPlugin(
+		p.donID,
+		oracleIDToP2PID,
+		offchainConfig,
+		p.ocrConfig.Config.ChainSelector,
+		ccipReader,
+		onChainTokenPricesReader,
+		p.commitCodec,
+		p.msgHasher,
+		lggr,
+		p.homeChainReader,
+		rmnHomeReader,
+		p.rmnCrypto,
+		p.rmnPeerClient,
+		config,
+		metricsReporter,
+		p.addrCodec,
+		reportBuilder,
 	)
-	if err != nil {
-		return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to create report builder: %w", err)
+
+	if p.recording != nil {
+		recordingCfg := *p.recording
+		recordingCfg.Dir = filepath.Join(recordingCfg.Dir, config.ConfigDigest.Hex())
+		recorder, err1 := replay.NewRecorder(lggr, plugin, recordingCfg)
+		if err1 != nil {
+			return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to create round recorder: %w", err1)
+		}
+		plugin = recorder
 	}
 
-	return NewPlugin(
-			p.donID,
-			oracleIDToP2PID,
-			offchainConfig,
-			p.ocrConfig.Config.ChainSelector,
-			ccipReader,
-			onChainTokenPricesReader,
-			p.commitCodec,
-			p.msgHasher,
-			lggr,
-			p.homeChainReader,
-			rmnHomeReader,
-			p.rmnCrypto,
-			p.rmnPeerClient,
-			config,
-			metricsReporter,
-			p.addrCodec,
-			reportBuilder,
-		), ocr3types.ReportingPluginInfo{
-			Name: "CCIPRoleCommit",
-			Limits: ocr3types.ReportingPluginLimits{
-				MaxQueryLength:       maxQueryLength,
-				MaxObservationLength: maxObservationLength,
-				MaxOutcomeLength:     maxOutcomeLength,
-				MaxReportLength:      maxReportLength,
-				MaxReportCount:       maxReportCount,
-			},
-		}, nil
+	return plugin, ocr3types.ReportingPluginInfo{
+		Name: "CCIPRoleCommit",
+		Limits: ocr3types.ReportingPluginLimits{
+			MaxQueryLength:       maxQueryLength,
+			MaxObservationLength: maxObservationLength,
+			MaxOutcomeLength:     maxOutcomeLength,
+			MaxReportLength:      maxReportLength,
+			MaxReportCount:       maxReportCount,
+		},
+	}, nil
 }
 
 func validateOcrConfig(cfg readerpkg.OCR3Config) error {
@@ -0,0 +1,239 @@
+package builder
+
+import (
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/commit/committypes"
+	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+// Estimates of the ABI encoded size of the commit report items.
+const (
+	// reportBaseSize covers the offsets and lengths of the dynamic fields of the report.
+	reportBaseSize = 8 * 32
+	// merkleRootBaseSize is the size of a merkle root without the data of the onRamp address.
+	merkleRootBaseSize = 6 * 32
+	rmnSignatureSize   = 2 * 32
+	tokenPriceSize     = 2 * 32
+	gasPriceSize       = 2 * 32
+)
+
+// EstimateProvider estimates the destination cost of a commit report,
+// it is the commit counterpart of cciptypes.EstimateProvider.
+type EstimateProvider interface {
+	CalculateReportGas(report cciptypes.CommitPluginReport) uint64
+	CalculateReportSize(report cciptypes.CommitPluginReport) uint64
+}
+
+// configEstimateProvider estimates the gas of a report from the configured per item costs
+// and the size of a report from its ABI encoding.
+type configEstimateProvider struct {
+	cfg pluginconfig.ReportSplittingConfig
+}
+
+// NewConfigEstimateProvider returns an EstimateProvider based on the report splitting config.
+func NewConfigEstimateProvider(cfg pluginconfig.ReportSplittingConfig) EstimateProvider {
+	return configEstimateProvider{cfg: cfg}
+}
+
+func (e configEstimateProvider) CalculateReportGas(report cciptypes.CommitPluginReport) uint64 {
+	numRoots := uint64(len(report.BlessedMerkleRoots) + len(report.UnblessedMerkleRoots))
+	return e.cfg.ReportBaseGas +
+		numRoots*e.cfg.GasPerMerkleRoot +
+		uint64(len(report.RMNSignatures))*e.cfg.GasPerRMNSignature +
+		uint64(len(report.PriceUpdates.TokenPriceUpdates))*e.cfg.GasPerTokenPriceUpdate +
+		uint64(len(report.PriceUpdates.GasPriceUpdates))*e.cfg.GasPerGasPriceUpdate
+}
+
+func (e configEstimateProvider) CalculateReportSize(report cciptypes.CommitPluginReport) uint64 {
+	size := uint64(reportBaseSize)
+	for _, roots := range [][]cciptypes.MerkleRootChain{report.BlessedMerkleRoots, report.UnblessedMerkleRoots} {
+		for _, root := range roots {
+			size += merkleRootBaseSize + (uint64(len(root.OnRampAddress))+31)/32*32
+		}
+	}
+	size += uint64(len(report.RMNSignatures)) * rmnSignatureSize
+	size += uint64(len(report.PriceUpdates.TokenPriceUpdates)) * tokenPriceSize
+	size += uint64(len(report.PriceUpdates.GasPriceUpdates)) * gasPriceSize
+	return size
+}
+
+// NewSplitReportBuilder returns a ReportBuilderFunc that splits the outcome into reports that fit in the
+// limits of the report splitting config according to the estimates of the provided EstimateProvider.
+// At most maxReports reports are built.
+func NewSplitReportBuilder(estimateProvider EstimateProvider, maxReports int) ReportBuilderFunc {
+	return func(
+		lggr logger.Logger,
+		outcome committypes.Outcome,
+		config pluginconfig.CommitOffchainConfig,
+	) ([]Report, error) {
+		return buildSplitReports(lggr, outcome, config, estimateProvider, maxReports)
+	}
+}
+
+// buildSplitReports builds the reports in the following order:
+//  1. a single report with all the blessed merkle roots and the RMN signatures, since the signatures cover
+//     all the blessed roots it is never split.
+//  2. reports with the unblessed merkle roots.
+//  3. reports with the price updates, token prices first.
+//
+// Reports are filled in order until the next item does not fit. A single item that does not fit
+// on its own is still reported. The reports beyond maxReports are dropped, the price updates go first.
+//
+// The price reports carry the last sequence number of the roots of every source chain, so that they
+// are only transmitted once the roots are committed.
+func buildSplitReports(
+	lggr logger.Logger,
+	outcome committypes.Outcome,
+	config pluginconfig.CommitOffchainConfig,
+	estimateProvider EstimateProvider,
+	maxReports int,
+) ([]Report, error) {
+	if config.ReportSplitting == nil {
+		return nil, fmt.Errorf("report splitting is not configured")
+	}
+	limits := *config.ReportSplitting
+	fits := func(report cciptypes.CommitPluginReport) bool {
+		return WithinLimits(limits, estimateProvider, report)
+	}
+
+	var reports []Report
+	var afterRoots map[cciptypes.ChainSelector]cciptypes.SeqNum
+	if outcome.MerkleRootOutcome.OutcomeType == merkleroot.ReportGenerated {
+		blessedRoots := make([]cciptypes.MerkleRootChain, 0)
+		unblessedRoots := make([]cciptypes.MerkleRootChain, 0)
+		for _, r := range outcome.MerkleRootOutcome.RootsToReport {
+			if afterRoots == nil {
+				afterRoots = make(map[cciptypes.ChainSelector]cciptypes.SeqNum)
+			}
+			afterRoots[r.ChainSel] = max(afterRoots[r.ChainSel], r.SeqNumsRange.End())
+			if outcome.MerkleRootOutcome.RMNEnabledChains[r.ChainSel] {
+				blessedRoots = append(blessedRoots, r)
+			} else {
+				unblessedRoots = append(unblessedRoots, r)
+			}
+		}
+
+		if len(blessedRoots) > 0 {
+			report := buildOneReport(
+				lggr,
+				merkleroot.ReportGenerated,
+				blessedRoots,
+				nil,
+				outcome.MerkleRootOutcome.RMNReportSignatures,
+				outcome.MerkleRootOutcome.RMNRemoteCfg.FSign,
+				cciptypes.PriceUpdates{},
+			)
+			if !fits(report.Report) {
+				lggr.Warnw("blessed merkle roots report exceeds the report limits, it cannot be split",
+					"numRoots", len(blessedRoots))
+			}
+			reports = append(reports, withSplit(report, cciptypes.CommitReportKindBlessedRoots))
+		}
+
+		chunks := splitWithinLimits(unblessedRoots, func(roots []cciptypes.MerkleRootChain) bool {
+			return fits(cciptypes.CommitPluginReport{UnblessedMerkleRoots: roots})
+		})
+		for _, roots := range chunks {
+			report := buildOneReport(
+				lggr, merkleroot.ReportGenerated, nil, roots, nil, 0, cciptypes.PriceUpdates{})
+			reports = append(reports, withSplit(report, cciptypes.CommitReportKindUnblessedRoots))
+		}
+	}
+
+	// priceUpdate is a union of the different types of price updates.
+	type priceUpdate struct {
+		tokenPrice *cciptypes.TokenPrice
+		gasPrice   *cciptypes.GasPriceChain
+	}
+	toPriceUpdates := func(updates []priceUpdate) cciptypes.PriceUpdates {
+		var priceUpdates cciptypes.PriceUpdates
+		for _, u := range updates {
+			if u.tokenPrice != nil {
+				priceUpdates.TokenPriceUpdates = append(priceUpdates.TokenPriceUpdates, *u.tokenPrice)
+			}
+			if u.gasPrice != nil {
+				priceUpdates.GasPriceUpdates = append(priceUpdates.GasPriceUpdates, *u.gasPrice)
+			}
+		}
+		return priceUpdates
+	}
+
+	var updates []priceUpdate
+	for _, tokenPrice := range outcome.TokenPriceOutcome.TokenPrices.ToSortedSlice() {
+		updates = append(updates, priceUpdate{tokenPrice: &tokenPrice})
+	}
+	for _, gasPrice := range outcome.ChainFeeOutcome.GasPrices {
+		updates = append(updates, priceUpdate{gasPrice: &gasPrice})
+	}
+
+	chunks := splitWithinLimits(updates, func(updates []priceUpdate) bool {
+		return fits(cciptypes.CommitPluginReport{PriceUpdates: toPriceUpdates(updates)})
+	})
+	for _, chunk := range chunks {
+		report := buildOneReport(
+			lggr, outcome.MerkleRootOutcome.OutcomeType, nil, nil, nil, 0, toPriceUpdates(chunk))
+		report = withSplit(report, cciptypes.CommitReportKindPrices)
+		report.ReportInfo.Split.AfterRoots = afterRoots
+		reports = append(reports, report)
+	}
+
+	if maxReports > 0 && len(reports) > maxReports {
+		lggr.Warnw("split reports exceed the max report count, dropping the last reports",
+			"numReports", len(reports), "maxReports", maxReports)
+		reports = reports[:maxReports]
+	}
+
+	for i := range reports {
+		reports[i].ReportInfo.Split.Index = i
+		reports[i].ReportInfo.Split.Count = len(reports)
+		reports[i].ReportInfo.Split.EstimatedGas = estimateProvider.CalculateReportGas(reports[i].Report)
+	}
+
+	lggr.Debugw("built split reports", "numReports", len(reports))
+	return reports, nil
+}
+
+// withSplit sets the split info of the report, the position of the report is set once all reports are built.
+func withSplit(report Report, kind cciptypes.CommitReportKind) Report {
+	report.ReportInfo.Split = &cciptypes.CommitReportSplit{Kind: kind}
+	return report
+}
+
+// WithinLimits returns true if the estimated gas and size of the report do not exceed the configured limits.
+func WithinLimits(
+	limits pluginconfig.ReportSplittingConfig,
+	estimateProvider EstimateProvider,
+	report cciptypes.CommitPluginReport,
+) bool {
+	if limits.MaxReportGas > 0 && estimateProvider.CalculateReportGas(report) > limits.MaxReportGas {
+		return false
+	}
+	if limits.MaxReportSizeBytes > 0 && estimateProvider.CalculateReportSize(report) > limits.MaxReportSizeBytes {
+		return false
+	}
+	return true
+}
+
+// splitWithinLimits splits the items in consecutive chunks, a chunk is extended as long as it fits.
+func splitWithinLimits[T any](items []T, fits func([]T) bool) [][]T {
+	var chunks [][]T
+	var chunk []T
+	for _, item := range items {
+		extended := append(chunk[:len(chunk):len(chunk)], item)
+		if len(chunk) > 0 && !fits(extended) {
+			chunks = append(chunks, chunk)
+			chunk = []T{item}
+			continue
+		}
+		chunk = extended
+	}
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
@@ -0,0 +1,197 @@
+package builder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/commit/chainfee"
+	"github.com/smartcontractkit/chainlink-ccip/commit/committypes"
+	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot"
+	"github.com/smartcontractkit/chainlink-ccip/commit/tokenprice"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+func TestBuildSplitReports(t *testing.T) {
+	root := func(chainSel ccipocr3.ChainSelector) ccipocr3.MerkleRootChain {
+		return ccipocr3.MerkleRootChain{
+			ChainSel:      chainSel,
+			OnRampAddress: []byte{1, 2, 3},
+			SeqNumsRange:  ccipocr3.NewSeqNumRange(10, 20),
+			MerkleRoot:    ccipocr3.Bytes32{byte(chainSel)},
+		}
+	}
+	outcome := committypes.Outcome{
+		MerkleRootOutcome: merkleroot.Outcome{
+			OutcomeType:   merkleroot.ReportGenerated,
+			RootsToReport: []ccipocr3.MerkleRootChain{root(1), root(2), root(3), root(4), root(5), root(6)},
+			RMNEnabledChains: map[ccipocr3.ChainSelector]bool{
+				2: true,
+				4: true,
+			},
+			RMNReportSignatures: []ccipocr3.RMNECDSASignature{{R: [32]byte{1}}, {R: [32]byte{2}}},
+			RMNRemoteCfg:        ccipocr3.RemoteConfig{FSign: 1},
+		},
+		TokenPriceOutcome: tokenprice.Outcome{
+			TokenPrices: ccipocr3.TokenPriceMap{
+				"a": ccipocr3.NewBigIntFromInt64(1),
+				"b": ccipocr3.NewBigIntFromInt64(2),
+			},
+		},
+		ChainFeeOutcome: chainfee.Outcome{
+			GasPrices: []ccipocr3.GasPriceChain{
+				{ChainSel: 1, GasPrice: ccipocr3.NewBigIntFromInt64(3)},
+			},
+		},
+	}
+
+	splitCfg := pluginconfig.ReportSplittingConfig{
+		MaxReportGas:           250,
+		ReportBaseGas:          50,
+		GasPerMerkleRoot:       100,
+		GasPerRMNSignature:     10,
+		GasPerTokenPriceUpdate: 80,
+		GasPerGasPriceUpdate:   80,
+	}
+	afterRoots := map[ccipocr3.ChainSelector]ccipocr3.SeqNum{1: 20, 2: 20, 3: 20, 4: 20, 5: 20, 6: 20}
+
+	testCases := []struct {
+		name       string
+		outcome    func() committypes.Outcome
+		cfg        pluginconfig.ReportSplittingConfig
+		maxReports int
+		expReports []ccipocr3.CommitReportSplit
+	}{
+		{
+			name:    "split by gas",
+			outcome: func() committypes.Outcome { return outcome },
+			cfg:     splitCfg,
+			expReports: []ccipocr3.CommitReportSplit{
+				// the blessed roots exceed the limit but can't be split.
+				{Kind: ccipocr3.CommitReportKindBlessedRoots, Index: 0, Count: 5, EstimatedGas: 270},
+				{Kind: ccipocr3.CommitReportKindUnblessedRoots, Index: 1, Count: 5, EstimatedGas: 250},
+				{Kind: ccipocr3.CommitReportKindUnblessedRoots, Index: 2, Count: 5, EstimatedGas: 250},
+				{Kind: ccipocr3.CommitReportKindPrices, Index: 3, Count: 5, EstimatedGas: 210, AfterRoots: afterRoots},
+				{Kind: ccipocr3.CommitReportKindPrices, Index: 4, Count: 5, EstimatedGas: 130, AfterRoots: afterRoots},
+			},
+		},
+		{
+			name:       "reports beyond the max report count are dropped",
+			outcome:    func() committypes.Outcome { return outcome },
+			cfg:        splitCfg,
+			maxReports: 4,
+			expReports: []ccipocr3.CommitReportSplit{
+				{Kind: ccipocr3.CommitReportKindBlessedRoots, Index: 0, Count: 4, EstimatedGas: 270},
+				{Kind: ccipocr3.CommitReportKindUnblessedRoots, Index: 1, Count: 4, EstimatedGas: 250},
+				{Kind: ccipocr3.CommitReportKindUnblessedRoots, Index: 2, Count: 4, EstimatedGas: 250},
+				{Kind: ccipocr3.CommitReportKindPrices, Index: 3, Count: 4, EstimatedGas: 210, AfterRoots: afterRoots},
+			},
+		},
+		{
+			name:    "split by size",
+			outcome: func() committypes.Outcome { return outcome },
+			cfg: pluginconfig.ReportSplittingConfig{
+				// fits the base size and 3 merkle roots.
+				MaxReportSizeBytes: reportBaseSize + 3*(merkleRootBaseSize+32),
+			},
+			expReports: []ccipocr3.CommitReportSplit{
+				{Kind: ccipocr3.CommitReportKindBlessedRoots, Index: 0, Count: 4},
+				{Kind: ccipocr3.CommitReportKindUnblessedRoots, Index: 1, Count: 4},
+				{Kind: ccipocr3.CommitReportKindUnblessedRoots, Index: 2, Count: 4},
+				{Kind: ccipocr3.CommitReportKindPrices, Index: 3, Count: 4, AfterRoots: afterRoots},
+			},
+		},
+		{
+			name: "roots are not reported if the merkle root outcome has no report",
+			outcome: func() committypes.Outcome {
+				o := outcome
+				o.MerkleRootOutcome.OutcomeType = merkleroot.ReportInFlight
+				return o
+			},
+			cfg: splitCfg,
+			expReports: []ccipocr3.CommitReportSplit{
+				{Kind: ccipocr3.CommitReportKindPrices, Index: 0, Count: 2, EstimatedGas: 210},
+				{Kind: ccipocr3.CommitReportKindPrices, Index: 1, Count: 2, EstimatedGas: 130},
+			},
+		},
+		{
+			name: "empty outcome",
+			outcome: func() committypes.Outcome {
+				return committypes.Outcome{}
+			},
+			cfg:        splitCfg,
+			expReports: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lggr := logger.Test(t)
+			cfg := pluginconfig.CommitOffchainConfig{ReportSplitting: &tc.cfg}
+			reportBuilder := NewSplitReportBuilder(NewConfigEstimateProvider(tc.cfg), tc.maxReports)
+
+			reports, err := reportBuilder(lggr, tc.outcome(), cfg)
+			require.NoError(t, err)
+			require.Len(t, reports, len(tc.expReports))
+
+			numRoots := 0
+			numPrices := 0
+			for i, report := range reports {
+				require.False(t, report.Report.IsEmpty())
+				require.NotNil(t, report.ReportInfo.Split)
+				require.Equal(t, tc.expReports[i], *report.ReportInfo.Split)
+
+				rep := report.Report
+				switch report.ReportInfo.Split.Kind {
+				case ccipocr3.CommitReportKindBlessedRoots:
+					require.Empty(t, rep.UnblessedMerkleRoots)
+					require.Equal(t, outcome.MerkleRootOutcome.RMNReportSignatures, rep.RMNSignatures)
+					require.Equal(t, outcome.MerkleRootOutcome.RMNRemoteCfg.FSign, report.ReportInfo.RemoteF)
+				case ccipocr3.CommitReportKindUnblessedRoots:
+					require.Empty(t, rep.BlessedMerkleRoots)
+					require.Empty(t, rep.RMNSignatures)
+				case ccipocr3.CommitReportKindPrices:
+					require.Empty(t, rep.BlessedMerkleRoots)
+					require.Empty(t, rep.UnblessedMerkleRoots)
+				}
+				numRoots += len(rep.BlessedMerkleRoots) + len(rep.UnblessedMerkleRoots)
+				numPrices += len(rep.PriceUpdates.TokenPriceUpdates) + len(rep.PriceUpdates.GasPriceUpdates)
+			}
+
+			o := tc.outcome()
+			if tc.maxReports > 0 {
+				return
+			}
+			if o.MerkleRootOutcome.OutcomeType == merkleroot.ReportGenerated {
+				require.Equal(t, len(o.MerkleRootOutcome.RootsToReport), numRoots)
+			}
+			require.Equal(t, len(o.TokenPriceOutcome.TokenPrices)+len(o.ChainFeeOutcome.GasPrices), numPrices)
+		})
+	}
+
+	t.Run("not configured", func(t *testing.T) {
+		reportBuilder := NewSplitReportBuilder(NewConfigEstimateProvider(splitCfg), 0)
+		_, err := reportBuilder(logger.Test(t), outcome, pluginconfig.CommitOffchainConfig{})
+		require.Error(t, err)
+	})
+}
+
+func Test_splitWithinLimits(t *testing.T) {
+	sumAtMost := func(limit int) func([]int) bool {
+		return func(items []int) bool {
+			sum := 0
+			for _, item := range items {
+				sum += item
+			}
+			return sum <= limit
+		}
+	}
+
+	require.Nil(t, splitWithinLimits(nil, sumAtMost(10)))
+	require.Equal(t, [][]int{{1, 2, 3, 4}, {5}, {6}}, splitWithinLimits([]int{1, 2, 3, 4, 5, 6}, sumAtMost(10)))
+	// items that don't fit on their own get their own chunk.
+	require.Equal(t, [][]int{{1}, {20}, {2, 3}}, splitWithinLimits([]int{1, 20, 2, 3}, sumAtMost(10)))
+}
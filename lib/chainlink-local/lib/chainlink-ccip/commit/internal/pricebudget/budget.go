@@ -0,0 +1,102 @@
+// Package pricebudget limits the price updates of the commit plugin to a destination gas budget per window.
+//
+// The gas spent within a window is estimated from the timestamps of the latest onchain price updates that are part
+// of the consensus observation, so that every oracle reaches the same selection. Since only the latest update of
+// every chain or token is known, an item that was updated several times within the window is counted once.
+package pricebudget
+
+import (
+	"cmp"
+	"math/big"
+	"sort"
+	"time"
+)
+
+// Candidate is a price update that is needed either because it is mandatory (no previous update or heartbeat)
+// or because the price deviates from the last onchain price.
+type Candidate[K cmp.Ordered] struct {
+	Key K
+	// Mandatory candidates are always selected, they still consume the budget of the window.
+	Mandatory bool
+	// DeviationPPB is the deviation from the last onchain price, nil means that the deviation is unbounded.
+	DeviationPPB *big.Int
+}
+
+// Budget is the destination gas that a kind of price updates may spend within a sliding window.
+type Budget struct {
+	window       time.Duration
+	maxGas       uint64
+	gasPerUpdate uint64
+}
+
+// NewBudget creates a budget of maxGas per window, every price update is estimated to cost gasPerUpdate.
+// A zero window, maxGas or gasPerUpdate disables the budget.
+func NewBudget(window time.Duration, maxGas, gasPerUpdate uint64) Budget {
+	return Budget{
+		window:       window,
+		maxGas:       maxGas,
+		gasPerUpdate: gasPerUpdate,
+	}
+}
+
+// Enabled returns true if the budget limits the price updates.
+func (b Budget) Enabled() bool {
+	return b.window > 0 && b.maxGas > 0 && b.gasPerUpdate > 0
+}
+
+// Remaining returns the number of price updates that still fit in the window ending at now,
+// lastUpdates are the timestamps of the latest onchain updates of every chain or token.
+func (b Budget) Remaining(now time.Time, lastUpdates []time.Time) int {
+	windowStart := now.Add(-b.window)
+	spentGas := uint64(0)
+	for _, ts := range lastUpdates {
+		if ts.After(windowStart) && !ts.After(now) {
+			spentGas += b.gasPerUpdate
+		}
+	}
+	if spentGas >= b.maxGas {
+		return 0
+	}
+	return int((b.maxGas - spentGas) / b.gasPerUpdate)
+}
+
+// Select returns the keys of the candidates to update given the number of remaining updates.
+// Mandatory candidates are always selected and the remaining updates are assigned to the deviating candidates
+// by descending deviation. Ties are broken by key so that the selection is deterministic.
+func Select[K cmp.Ordered](candidates []Candidate[K], remaining int) []K {
+	sorted := make([]Candidate[K], len(candidates))
+	copy(sorted, candidates)
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.Mandatory != b.Mandatory {
+			return a.Mandatory
+		}
+		if c := compareDeviations(a.DeviationPPB, b.DeviationPPB); c != 0 {
+			return c > 0
+		}
+		return a.Key < b.Key
+	})
+
+	selected := make([]K, 0, len(sorted))
+	for _, c := range sorted {
+		if !c.Mandatory && len(selected) >= remaining {
+			break
+		}
+		selected = append(selected, c.Key)
+	}
+	return selected
+}
+
+// compareDeviations compares two deviations where nil is greater than any bounded deviation.
+func compareDeviations(a, b *big.Int) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	default:
+		return a.Cmp(b)
+	}
+}
@@ -0,0 +1,68 @@
+package pricebudget
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudget_Remaining(t *testing.T) {
+	now := time.Now().UTC()
+	b := NewBudget(time.Hour, 1000, 300)
+	require.True(t, b.Enabled())
+
+	require.Equal(t, 3, b.Remaining(now, nil))
+	require.Equal(t, 2, b.Remaining(now, []time.Time{
+		now.Add(-time.Minute),
+		now.Add(-2 * time.Hour), // before the window
+		now.Add(time.Minute),    // in the future
+	}))
+	require.Equal(t, 0, b.Remaining(now, []time.Time{
+		now.Add(-time.Minute), now.Add(-2 * time.Minute), now.Add(-3 * time.Minute), now.Add(-4 * time.Minute),
+	}))
+
+	require.False(t, NewBudget(0, 1000, 300).Enabled())
+	require.False(t, NewBudget(time.Hour, 0, 300).Enabled())
+	require.False(t, NewBudget(time.Hour, 1000, 0).Enabled())
+}
+
+func TestSelect(t *testing.T) {
+	candidates := []Candidate[string]{
+		{Key: "small", DeviationPPB: big.NewInt(10)},
+		{Key: "heartbeat", Mandatory: true},
+		{Key: "large", DeviationPPB: big.NewInt(1000)},
+		{Key: "unbounded", DeviationPPB: nil},
+		{Key: "medium-b", DeviationPPB: big.NewInt(100)},
+		{Key: "medium-a", DeviationPPB: big.NewInt(100)},
+	}
+
+	testCases := []struct {
+		name      string
+		remaining int
+		exp       []string
+	}{
+		{
+			name:      "everything fits",
+			remaining: 10,
+			exp:       []string{"heartbeat", "unbounded", "large", "medium-a", "medium-b", "small"},
+		},
+		{
+			name:      "largest deviations first",
+			remaining: 4,
+			exp:       []string{"heartbeat", "unbounded", "large", "medium-a"},
+		},
+		{
+			name:      "mandatory updates are always selected",
+			remaining: 0,
+			exp:       []string{"heartbeat"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, Select(candidates, tc.remaining))
+		})
+	}
+}
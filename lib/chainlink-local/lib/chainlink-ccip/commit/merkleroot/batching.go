@@ -0,0 +1,129 @@
+package merkleroot
+
+import (
+	"sort"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+// applyAdaptiveBatching filters and resizes the ranges of the outcome according to the adaptive batching config.
+// The range size every source chain has to reach is derived from the backlog, see adaptiveRangeSize.
+// A range is kept if either:
+//   - it contains at least the adaptive range size messages (ranges truncated by MaxMerkleTreeSize always do),
+//   - its oldest message has been pending for at least TargetLatency,
+//   - it belongs to a resumed lane, since the backfill of paused lanes is prioritized.
+//
+// Otherwise the messages wait for the next rounds. Kept ranges are truncated to the adaptive range size, which never
+// exceeds MaxMerkleTreeSize, and the rest of their backlog is committed in the next rounds. Ranges of the resumed
+// lanes are only limited by MaxMerkleTreeSize.
+//
+// The backlog is taken from the consensus ranges and the pending batches are tracked in the outcome using
+// the consensus timestamp, so all the oracles make the same decision.
+// If there is no consensus timestamp all the ranges are kept.
+func applyAdaptiveBatching(
+	lggr logger.Logger,
+	offchainCfg pluginconfig.CommitOffchainConfig,
+	now time.Time,
+	prevPendingBatches []PendingBatch,
+	outcome Outcome,
+) Outcome {
+	if now.IsZero() {
+		lggr.Warnw("no consensus timestamp, adaptive batching is skipped in this round")
+		outcome.PendingBatches = prevPendingBatches
+		return outcome
+	}
+
+	prevBatches := make(map[cciptypes.ChainSelector]PendingBatch, len(prevPendingBatches))
+	for _, batch := range prevPendingBatches {
+		prevBatches[batch.ChainSel] = batch
+	}
+	resumed := resumedLanes(outcome.PausedLanes)
+	cfg := *offchainCfg.AdaptiveMerkleRootBatching
+	rangeSize := adaptiveRangeSize(cfg, offchainCfg.MaxMerkleTreeSize, offchainCfg.MaxMerkleRootsPerReport,
+		outcome.RangesSelectedForReport)
+
+	selectedRanges := make([]plugintypes.ChainRange, 0, len(outcome.RangesSelectedForReport))
+	pendingBatches := make([]PendingBatch, 0, len(outcome.RangesSelectedForReport))
+	for _, chainRange := range outcome.RangesSelectedForReport {
+		batch, exists := prevBatches[chainRange.ChainSel]
+		if !exists || batch.FirstSeqNum != chainRange.SeqNumRange.Start() {
+			batch = PendingBatch{ChainSel: chainRange.ChainSel, FirstSeqNum: chainRange.SeqNumRange.Start(), Since: now}
+		}
+		// Batches are tracked until the messages are committed, in case the report is not transmitted.
+		pendingBatches = append(pendingBatches, batch)
+
+		backlog := uint64(chainRange.SeqNumRange.Length())
+		waiting := now.Sub(batch.Since)
+		if backlog >= rangeSize || waiting >= cfg.TargetLatency.Duration() || resumed[chainRange.ChainSel] {
+			maxRangeSize := rangeSize
+			if resumed[chainRange.ChainSel] {
+				maxRangeSize = offchainCfg.MaxMerkleTreeSize
+			}
+			if maxRangeSize > 0 {
+				chainRange.SeqNumRange = chainRange.SeqNumRange.Limit(maxRangeSize)
+			}
+			selectedRanges = append(selectedRanges, chainRange)
+			continue
+		}
+
+		lggr.Debugw("range deferred by adaptive batching",
+			"range", chainRange, "rangeSize", rangeSize, "waiting", waiting)
+	}
+
+	sort.Slice(pendingBatches, func(i, j int) bool { return pendingBatches[i].ChainSel < pendingBatches[j].ChainSel })
+	if len(pendingBatches) == 0 {
+		pendingBatches = nil
+	}
+
+	if len(selectedRanges) == 0 {
+		lggr.Info("All ranges deferred by adaptive batching, outcomeType is ReportEmpty")
+		return Outcome{
+			OutcomeType:    ReportEmpty,
+			PausedLanes:    outcome.PausedLanes,
+			PendingBatches: pendingBatches,
+		}
+	}
+
+	outcome.RangesSelectedForReport = selectedRanges
+	outcome.PendingBatches = pendingBatches
+	return outcome
+}
+
+// adaptiveRangeSize returns the number of messages a source chain range has to contain to be committed without
+// waiting for TargetLatency. It's the fair share of the total backlog per merkle root of a report, so that when
+// many messages are pending, every root carries a larger part of them instead of committing tiny roots every round.
+// The report fits all the pending ranges unless it's limited by maxMerkleRootsPerReport. The size is at least
+// MinRangeSize and at most maxMerkleTreeSize, the backlog of a chain is its range which is truncated by
+// maxMerkleTreeSize.
+func adaptiveRangeSize(
+	cfg pluginconfig.AdaptiveBatchingConfig,
+	maxMerkleTreeSize uint64,
+	maxMerkleRootsPerReport uint64,
+	ranges []plugintypes.ChainRange,
+) uint64 {
+	if len(ranges) == 0 {
+		return cfg.MinRangeSize
+	}
+
+	var totalBacklog uint64
+	for _, chainRange := range ranges {
+		totalBacklog += uint64(chainRange.SeqNumRange.Length())
+	}
+
+	rootsPerReport := uint64(len(ranges))
+	if maxMerkleRootsPerReport > 0 && maxMerkleRootsPerReport < rootsPerReport {
+		rootsPerReport = maxMerkleRootsPerReport
+	}
+
+	rangeSize := (totalBacklog + rootsPerReport - 1) / rootsPerReport
+	rangeSize = max(rangeSize, cfg.MinRangeSize)
+	if maxMerkleTreeSize > 0 {
+		rangeSize = min(rangeSize, maxMerkleTreeSize)
+	}
+	return rangeSize
+}
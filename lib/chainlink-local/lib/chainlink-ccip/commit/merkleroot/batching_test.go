@@ -0,0 +1,249 @@
+package merkleroot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+func Test_applyAdaptiveBatching(t *testing.T) {
+	lggr := logger.Test(t)
+	cfg := pluginconfig.CommitOffchainConfig{
+		MaxMerkleTreeSize: 100,
+		AdaptiveMerkleRootBatching: &pluginconfig.AdaptiveBatchingConfig{
+			MinRangeSize:  10,
+			TargetLatency: *commonconfig.MustNewDuration(time.Minute),
+		},
+	}
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	rangesOutcome := func(ranges ...plugintypes.ChainRange) Outcome {
+		return Outcome{
+			OutcomeType:             ReportIntervalsSelected,
+			RangesSelectedForReport: ranges,
+			OffRampNextSeqNums:      []plugintypes.SeqNumChain{{ChainSel: 1, SeqNum: 5}, {ChainSel: 2, SeqNum: 20}},
+		}
+	}
+	small := plugintypes.ChainRange{ChainSel: 1, SeqNumRange: cciptypes.NewSeqNumRange(5, 7)}
+	large := plugintypes.ChainRange{ChainSel: 2, SeqNumRange: cciptypes.NewSeqNumRange(20, 29)}
+
+	testCases := []struct {
+		name               string
+		now                time.Time
+		prevPendingBatches []PendingBatch
+		outcome            Outcome
+		expOutcome         Outcome
+	}{
+		{
+			name:       "no ranges",
+			now:        now,
+			outcome:    Outcome{OutcomeType: ReportEmpty},
+			expOutcome: Outcome{OutcomeType: ReportEmpty},
+		},
+		{
+			name:    "small range is deferred, large range is reported",
+			now:     now,
+			outcome: rangesOutcome(small, large),
+			expOutcome: Outcome{
+				OutcomeType:             ReportIntervalsSelected,
+				RangesSelectedForReport: []plugintypes.ChainRange{large},
+				OffRampNextSeqNums:      []plugintypes.SeqNumChain{{ChainSel: 1, SeqNum: 5}, {ChainSel: 2, SeqNum: 20}},
+				PendingBatches: []PendingBatch{
+					{ChainSel: 1, FirstSeqNum: 5, Since: now},
+					{ChainSel: 2, FirstSeqNum: 20, Since: now},
+				},
+			},
+		},
+		{
+			name: "range size grows with the backlog, reported range is resized",
+			now:  now,
+			outcome: rangesOutcome(
+				plugintypes.ChainRange{ChainSel: 1, SeqNumRange: cciptypes.NewSeqNumRange(5, 16)},
+				plugintypes.ChainRange{ChainSel: 2, SeqNumRange: cciptypes.NewSeqNumRange(20, 67)},
+			),
+			expOutcome: Outcome{
+				OutcomeType: ReportIntervalsSelected,
+				RangesSelectedForReport: []plugintypes.ChainRange{
+					{ChainSel: 2, SeqNumRange: cciptypes.NewSeqNumRange(20, 49)},
+				},
+				OffRampNextSeqNums: []plugintypes.SeqNumChain{{ChainSel: 1, SeqNum: 5}, {ChainSel: 2, SeqNum: 20}},
+				PendingBatches: []PendingBatch{
+					{ChainSel: 1, FirstSeqNum: 5, Since: now},
+					{ChainSel: 2, FirstSeqNum: 20, Since: now},
+				},
+			},
+		},
+		{
+			name:    "all ranges deferred",
+			now:     now,
+			outcome: rangesOutcome(small),
+			expOutcome: Outcome{
+				OutcomeType:    ReportEmpty,
+				PendingBatches: []PendingBatch{{ChainSel: 1, FirstSeqNum: 5, Since: now}},
+			},
+		},
+		{
+			name:               "small range is reported once the target latency is reached",
+			now:                now.Add(time.Minute),
+			prevPendingBatches: []PendingBatch{{ChainSel: 1, FirstSeqNum: 5, Since: now}},
+			outcome:            rangesOutcome(small),
+			expOutcome: Outcome{
+				OutcomeType:             ReportIntervalsSelected,
+				RangesSelectedForReport: []plugintypes.ChainRange{small},
+				OffRampNextSeqNums:      []plugintypes.SeqNumChain{{ChainSel: 1, SeqNum: 5}, {ChainSel: 2, SeqNum: 20}},
+				PendingBatches:          []PendingBatch{{ChainSel: 1, FirstSeqNum: 5, Since: now}},
+			},
+		},
+		{
+			name:               "new batch starts once the previous one is committed",
+			now:                now.Add(time.Minute),
+			prevPendingBatches: []PendingBatch{{ChainSel: 1, FirstSeqNum: 2, Since: now}},
+			outcome:            rangesOutcome(small),
+			expOutcome: Outcome{
+				OutcomeType:    ReportEmpty,
+				PendingBatches: []PendingBatch{{ChainSel: 1, FirstSeqNum: 5, Since: now.Add(time.Minute)}},
+			},
+		},
+		{
+			name: "resumed lanes are not deferred",
+			now:  now,
+			outcome: Outcome{
+				OutcomeType:             ReportIntervalsSelected,
+				RangesSelectedForReport: []plugintypes.ChainRange{small},
+				PausedLanes:             []PausedLane{{ChainSel: 1, FirstSeqNum: 5, LastSeqNum: 6, Resumed: true}},
+			},
+			expOutcome: Outcome{
+				OutcomeType:             ReportIntervalsSelected,
+				RangesSelectedForReport: []plugintypes.ChainRange{small},
+				PausedLanes:             []PausedLane{{ChainSel: 1, FirstSeqNum: 5, LastSeqNum: 6, Resumed: true}},
+				PendingBatches:          []PendingBatch{{ChainSel: 1, FirstSeqNum: 5, Since: now}},
+			},
+		},
+		{
+			name:               "no consensus timestamp",
+			prevPendingBatches: []PendingBatch{{ChainSel: 1, FirstSeqNum: 5, Since: now}},
+			outcome:            rangesOutcome(small),
+			expOutcome: Outcome{
+				OutcomeType:             ReportIntervalsSelected,
+				RangesSelectedForReport: []plugintypes.ChainRange{small},
+				OffRampNextSeqNums:      []plugintypes.SeqNumChain{{ChainSel: 1, SeqNum: 5}, {ChainSel: 2, SeqNum: 20}},
+				PendingBatches:          []PendingBatch{{ChainSel: 1, FirstSeqNum: 5, Since: now}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			outcome := applyAdaptiveBatching(lggr, cfg, tc.now, tc.prevPendingBatches, tc.outcome)
+			require.Equal(t, tc.expOutcome, outcome)
+		})
+	}
+}
+
+func Test_applyAdaptiveBatching_rangeWidths(t *testing.T) {
+	cfg := pluginconfig.CommitOffchainConfig{
+		MaxMerkleTreeSize: 40,
+		AdaptiveMerkleRootBatching: &pluginconfig.AdaptiveBatchingConfig{
+			MinRangeSize:  10,
+			TargetLatency: *commonconfig.MustNewDuration(time.Minute),
+		},
+	}
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	chainRange := func(chain cciptypes.ChainSelector, size uint64) plugintypes.ChainRange {
+		return plugintypes.ChainRange{ChainSel: chain, SeqNumRange: cciptypes.NewSeqNumRange(1, cciptypes.SeqNum(size))}
+	}
+
+	// backlog of 3+40+40+40+2 messages over 5 roots, range size is 25
+	outcome := Outcome{
+		OutcomeType: ReportIntervalsSelected,
+		RangesSelectedForReport: []plugintypes.ChainRange{
+			chainRange(1, 3),
+			chainRange(2, 40),
+			chainRange(3, 40),
+			chainRange(4, 40),
+			chainRange(5, 2),
+		},
+		PausedLanes: []PausedLane{{ChainSel: 4, FirstSeqNum: 1, LastSeqNum: 60, Resumed: true}},
+	}
+	prevPendingBatches := []PendingBatch{
+		{ChainSel: 3, FirstSeqNum: 1, Since: now.Add(-time.Minute)},
+		{ChainSel: 5, FirstSeqNum: 1, Since: now.Add(-time.Minute)},
+	}
+
+	got := applyAdaptiveBatching(logger.Test(t), cfg, now, prevPendingBatches, outcome)
+
+	widths := make(map[cciptypes.ChainSelector]int, len(got.RangesSelectedForReport))
+	for _, r := range got.RangesSelectedForReport {
+		require.Equal(t, cciptypes.SeqNum(1), r.SeqNumRange.Start())
+		widths[r.ChainSel] = r.SeqNumRange.Length()
+	}
+	require.Equal(t, map[cciptypes.ChainSelector]int{
+		// chain 1 is deferred
+		2: 25, // resized to the range size
+		3: 25, // waited for the target latency, resized to the range size
+		4: 40, // resumed lane, limited by the max merkle tree size only
+		5: 2,  // waited for the target latency, smaller than the range size
+	}, widths)
+}
+
+func Test_adaptiveRangeSize(t *testing.T) {
+	cfg := pluginconfig.AdaptiveBatchingConfig{MinRangeSize: 10}
+	chainRange := func(chain cciptypes.ChainSelector, size uint64) plugintypes.ChainRange {
+		return plugintypes.ChainRange{ChainSel: chain, SeqNumRange: cciptypes.NewSeqNumRange(1, cciptypes.SeqNum(size))}
+	}
+
+	testCases := []struct {
+		name                    string
+		maxMerkleTreeSize       uint64
+		maxMerkleRootsPerReport uint64
+		ranges                  []plugintypes.ChainRange
+		expRangeSize            uint64
+	}{
+		{
+			name:              "no backlog",
+			maxMerkleTreeSize: 100,
+			expRangeSize:      10,
+		},
+		{
+			name:              "low backlog uses the min range size",
+			maxMerkleTreeSize: 100,
+			ranges:            []plugintypes.ChainRange{chainRange(1, 3), chainRange(2, 12)},
+			expRangeSize:      10,
+		},
+		{
+			name:              "share of the backlog per root",
+			maxMerkleTreeSize: 100,
+			ranges:            []plugintypes.ChainRange{chainRange(1, 12), chainRange(2, 48), chainRange(3, 3)},
+			expRangeSize:      21,
+		},
+		{
+			name:                    "limited roots per report",
+			maxMerkleTreeSize:       100,
+			maxMerkleRootsPerReport: 2,
+			ranges:                  []plugintypes.ChainRange{chainRange(1, 12), chainRange(2, 48), chainRange(3, 3)},
+			expRangeSize:            32,
+		},
+		{
+			name:                    "capped by the max merkle tree size",
+			maxMerkleTreeSize:       30,
+			maxMerkleRootsPerReport: 1,
+			ranges:                  []plugintypes.ChainRange{chainRange(1, 30), chainRange(2, 30)},
+			expRangeSize:            30,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expRangeSize,
+				adaptiveRangeSize(cfg, tc.maxMerkleTreeSize, tc.maxMerkleRootsPerReport, tc.ranges))
+		})
+	}
+}
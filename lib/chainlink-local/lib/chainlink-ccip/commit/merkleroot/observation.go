@@ -230,12 +230,18 @@ func (p *Processor) getObservation(
 	nextState := previousOutcome.nextState()
 	switch nextState {
 	case selectingRangesForReport:
-		return Observation{
+		obs := Observation{
 			OnRampMaxSeqNums:   p.observer.ObserveLatestOnRampSeqNums(ctx),
 			OffRampNextSeqNums: p.observer.ObserveOffRampNextSeqNums(ctx),
 			RMNRemoteConfig:    p.observer.ObserveRMNRemoteCfg(ctx),
 			FChain:             p.observer.ObserveFChain(ctx),
-		}, nextState, nil
+			PausedLanes:        p.observer.ObservePausedLanes(ctx),
+		}
+		if p.offchainCfg.AdaptiveMerkleRootBatching != nil {
+			// the timestamp is needed to agree on how long the pending messages are waiting
+			obs.Timestamp = time.Now().UTC()
+		}
+		return obs, nextState, nil
 	case buildingReport:
 		if q.RetryRMNSignatures {
 			// RMN signature computation failed, we only want to retry getting the RMN signatures in the next round.
@@ -285,6 +291,11 @@ type Observer interface {
 	// NOTE: Make sure that caller supports the destination chain.
 	ObserveOffRampNextSeqNums(ctx context.Context) []plugintypes.SeqNumChain
 
+	// ObservePausedLanes observes the next OffRamp sequence numbers of the source chains that are paused,
+	// i.e. the cursed source chains or all the source chains if the destination chain is cursed.
+	// NOTE: Make sure that caller supports the destination chain.
+	ObservePausedLanes(ctx context.Context) []plugintypes.SeqNumChain
+
 	// ObserveLatestOnRampSeqNums observes the latest OnRamp sequence numbers for each configured source chain.
 	// NOTE: Make sure that caller supports the destination chain.
 	ObserveLatestOnRampSeqNums(ctx context.Context) []plugintypes.SeqNumChain
@@ -315,6 +326,7 @@ type asyncObserver struct {
 	mu                  *sync.RWMutex
 	offRampNextSeqNums  []plugintypes.SeqNumChain
 	onRampLatestSeqNums []plugintypes.SeqNumChain
+	pausedLanes         []plugintypes.SeqNumChain
 }
 
 // newAsyncObserver creates a new asyncObserver.
@@ -330,6 +342,7 @@ func newAsyncObserver(lggr logger.Logger, observer observerImpl, tickDur, syncTi
 		mu:                  &sync.RWMutex{},
 		offRampNextSeqNums:  make([]plugintypes.SeqNumChain, 0),
 		onRampLatestSeqNums: make([]plugintypes.SeqNumChain, 0),
+		pausedLanes:         make([]plugintypes.SeqNumChain, 0),
 	}
 
 	ticker := time.NewTicker(tickDur)
@@ -384,6 +397,15 @@ func (o *asyncObserver) sync(ctx context.Context, syncTimeout time.Duration) {
 				o.mu.Unlock()
 			},
 		},
+		{
+			id: "pausedLanes",
+			op: func(ctx context.Context) {
+				pausedLanes := o.syncObserver.ObservePausedLanes(ctxSync)
+				o.mu.Lock()
+				o.pausedLanes = pausedLanes
+				o.mu.Unlock()
+			},
+		},
 	}
 
 	wg := &sync.WaitGroup{}
@@ -421,6 +443,14 @@ func (o *asyncObserver) ObserveLatestOnRampSeqNums(_ context.Context) []pluginty
 	return o.onRampLatestSeqNums
 }
 
+// ObservePausedLanes observes the next OffRamp sequence numbers of the paused source chains.
+// Values are fetched from observers state which are fetched async.
+func (o *asyncObserver) ObservePausedLanes(_ context.Context) []plugintypes.SeqNumChain {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.pausedLanes
+}
+
 // ObserveMerkleRoots observes the merkle roots for the given sequence number ranges.
 // It directly calls the base observer since this values cannot be known in advance.
 func (o *asyncObserver) ObserveMerkleRoots(
@@ -534,6 +564,63 @@ func (o observerImpl) ObserveOffRampNextSeqNums(ctx context.Context) []plugintyp
 	return result
 }
 
+// ObservePausedLanes observes the next sequence numbers of the paused source chains from the OffRamp.
+// A source chain is paused if it's cursed, all source chains are paused if the destination chain is cursed.
+func (o observerImpl) ObservePausedLanes(ctx context.Context) []plugintypes.SeqNumChain {
+	lggr := logutil.WithContextValues(ctx, o.lggr)
+
+	supportsDestChain, err := o.chainSupport.SupportsDestChain(o.oracleID)
+	if err != nil {
+		lggr.Warnw("call to SupportsDestChain failed", "err", err)
+		return nil
+	}
+
+	if !supportsDestChain {
+		lggr.Debugw("cannot observe paused lanes since destination chain is not supported")
+		return nil
+	}
+
+	allSourceChains, err := o.chainSupport.KnownSourceChainsSlice()
+	if err != nil {
+		lggr.Warnw("call to KnownSourceChainsSlice failed", "err", err)
+		return nil
+	}
+
+	curseInfo, err := o.ccipReader.GetRmnCurseInfo(ctx)
+	if err != nil {
+		lggr.Errorw("cannot observe paused lanes: rmn read error", "err", err)
+		return nil
+	}
+
+	pausedChains := allSourceChains
+	if !curseInfo.GlobalCurse && !curseInfo.CursedDestination {
+		pausedChains = make([]cciptypes.ChainSelector, 0)
+		for _, chain := range allSourceChains {
+			if curseInfo.CursedSourceChains[chain] {
+				pausedChains = append(pausedChains, chain)
+			}
+		}
+	}
+	if len(pausedChains) == 0 {
+		return nil
+	}
+
+	offRampNextSeqNums, err := o.ccipReader.NextSeqNum(ctx, pausedChains)
+	if err != nil {
+		lggr.Warnw("call to NextSeqNum of paused chains failed", "err", err)
+		return nil
+	}
+
+	result := make([]plugintypes.SeqNumChain, 0, len(pausedChains))
+	for chainSelector, seqNum := range offRampNextSeqNums {
+		result = append(result, plugintypes.NewSeqNumChain(chainSelector, seqNum))
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].ChainSel < result[j].ChainSel })
+	lggr.Debugw("observed paused lanes", "curseInfo", curseInfo, "pausedLanes", result)
+	return result
+}
+
 // ObserveLatestOnRampSeqNums observes the latest onRamp sequence numbers for each configured source chain.
 func (o observerImpl) ObserveLatestOnRampSeqNums(ctx context.Context) []plugintypes.SeqNumChain {
 	lggr := logutil.WithContextValues(ctx, o.lggr)
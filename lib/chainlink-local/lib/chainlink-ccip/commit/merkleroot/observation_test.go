@@ -85,12 +85,15 @@ func TestObservation(t *testing.T) {
 					[]plugintypes.SeqNumChain{{ChainSel: 1, SeqNum: 15}})
 				mockObserver.EXPECT().ObserveRMNRemoteCfg(mock.Anything).Return(cciptypes.RemoteConfig{})
 				mockObserver.EXPECT().ObserveFChain(mock.Anything).Return(map[cciptypes.ChainSelector]int{1: 3})
+				mockObserver.EXPECT().ObservePausedLanes(mock.Anything).Return(
+					[]plugintypes.SeqNumChain{{ChainSel: 2, SeqNum: 7}}).Once()
 			},
 			expectedObs: Observation{
 				OffRampNextSeqNums: []plugintypes.SeqNumChain{{ChainSel: 1, SeqNum: 10}},
 				OnRampMaxSeqNums:   []plugintypes.SeqNumChain{{ChainSel: 1, SeqNum: 15}},
 				RMNRemoteConfig:    cciptypes.RemoteConfig{},
 				FChain:             map[cciptypes.ChainSelector]int{1: 3},
+				PausedLanes:        []plugintypes.SeqNumChain{{ChainSel: 2, SeqNum: 7}},
 			},
 		},
 		{
@@ -381,6 +384,92 @@ func Test_ObserveOffRampNextSeqNums(t *testing.T) {
 	}
 }
 
+func Test_ObservePausedLanes(t *testing.T) {
+	const nodeID commontypes.OracleID = 1
+	knownSourceChains := []cciptypes.ChainSelector{4, 7, 19}
+	nextSeqNums := map[cciptypes.ChainSelector]cciptypes.SeqNum{4: 345, 7: 608, 19: 7713}
+
+	testCases := []struct {
+		name      string
+		curseInfo reader.CurseInfo
+		expChains []cciptypes.ChainSelector
+		expResult []plugintypes.SeqNumChain
+	}{
+		{
+			name:      "no curses",
+			curseInfo: reader.CurseInfo{},
+		},
+		{
+			name: "cursed source chains are paused",
+			curseInfo: reader.CurseInfo{
+				CursedSourceChains: map[cciptypes.ChainSelector]bool{4: false, 7: true, 19: true},
+			},
+			expChains: []cciptypes.ChainSelector{7, 19},
+			expResult: []plugintypes.SeqNumChain{
+				plugintypes.NewSeqNumChain(7, 608),
+				plugintypes.NewSeqNumChain(19, 7713),
+			},
+		},
+		{
+			name:      "all source chains are paused when dest chain is cursed",
+			curseInfo: reader.CurseInfo{CursedDestination: true},
+			expChains: knownSourceChains,
+			expResult: []plugintypes.SeqNumChain{
+				plugintypes.NewSeqNumChain(4, 345),
+				plugintypes.NewSeqNumChain(7, 608),
+				plugintypes.NewSeqNumChain(19, 7713),
+			},
+		},
+		{
+			name:      "all source chains are paused on global curse",
+			curseInfo: reader.CurseInfo{GlobalCurse: true},
+			expChains: knownSourceChains,
+			expResult: []plugintypes.SeqNumChain{
+				plugintypes.NewSeqNumChain(4, 345),
+				plugintypes.NewSeqNumChain(7, 608),
+				plugintypes.NewSeqNumChain(19, 7713),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := tests.Context(t)
+
+			chainSupport := common_mock.NewMockChainSupport(t)
+			chainSupport.EXPECT().SupportsDestChain(nodeID).Return(true, nil)
+			chainSupport.EXPECT().KnownSourceChainsSlice().Return(knownSourceChains, nil)
+			ccipReader := reader_mock.NewMockCCIPReader(t)
+			ccipReader.EXPECT().GetRmnCurseInfo(mock.Anything).Return(tc.curseInfo, nil)
+			if len(tc.expChains) > 0 {
+				seqNums := make(map[cciptypes.ChainSelector]cciptypes.SeqNum)
+				for _, chain := range tc.expChains {
+					seqNums[chain] = nextSeqNums[chain]
+				}
+				ccipReader.EXPECT().NextSeqNum(mock.Anything, tc.expChains).Return(seqNums, nil)
+			}
+
+			o := newObserverImpl(
+				logger.Test(t),
+				nil,
+				nodeID,
+				chainSupport,
+				ccipReader,
+				mocks.NewMessageHasher(),
+			)
+
+			assert.Equal(t, tc.expResult, o.ObservePausedLanes(ctx))
+		})
+	}
+
+	t.Run("nil is returned when supportsDestChain is false", func(t *testing.T) {
+		chainSupport := common_mock.NewMockChainSupport(t)
+		chainSupport.EXPECT().SupportsDestChain(nodeID).Return(false, nil)
+		o := newObserverImpl(logger.Test(t), nil, nodeID, chainSupport, nil, mocks.NewMessageHasher())
+		assert.Nil(t, o.ObservePausedLanes(tests.Context(t)))
+	})
+}
+
 func Test_ObserveMerkleRoots(t *testing.T) {
 	testCases := []struct {
 		name                     string
@@ -60,14 +60,24 @@ func (p *Processor) getOutcome(
 	consObservation, err := getConsensusObservation(lggr, p.reportingCfg.F, p.destChain, aos)
 	if err != nil {
 		lggr.Warnw("Get consensus observation failed, empty outcome", "err", err)
-		return Outcome{}, nextState, nil
+		return Outcome{
+			PausedLanes:    previousOutcome.PausedLanes,
+			PendingBatches: previousOutcome.PendingBatches,
+		}, nextState, nil
 	}
 
 	switch nextState {
 	case selectingRangesForReport:
-		return reportRangesOutcome(q, lggr, consObservation, p.offchainCfg.MaxMerkleTreeSize, p.destChain),
-			nextState,
-			nil
+		pausedLanes := trackPausedLanes(lggr, previousOutcome.PausedLanes, consObservation)
+		outcome := reportRangesOutcome(q, lggr, consObservation, p.offchainCfg.MaxMerkleTreeSize, p.destChain)
+		outcome.PausedLanes = pausedLanes
+		if p.offchainCfg.AdaptiveMerkleRootBatching != nil {
+			outcome = applyAdaptiveBatching(lggr, p.offchainCfg, consObservation.Timestamp,
+				previousOutcome.PendingBatches, outcome)
+		}
+		// ranges of the resumed lanes are reported first
+		outcome.Sort()
+		return outcome, nextState, nil
 	case buildingReport:
 		if q.RetryRMNSignatures {
 			// We want to retry getting the RMN signatures on the exact same outcome we had before.
@@ -88,6 +98,8 @@ func (p *Processor) getOutcome(
 		attempts := p.offchainCfg.MaxReportTransmissionCheckAttempts
 		multipleReports := p.offchainCfg.MultipleReportsEnabled
 		outcome := checkForReportTransmission(lggr, attempts, multipleReports, previousOutcome, consObservation)
+		outcome.PausedLanes = previousOutcome.PausedLanes
+		outcome.PendingBatches = previousOutcome.PendingBatches
 		return outcome, nextState, nil
 	default:
 		return Outcome{}, nextState, fmt.Errorf("unexpected next state in Outcome: %v", nextState)
@@ -173,6 +185,74 @@ func reportRangesOutcome(
 	return outcome
 }
 
+// trackPausedLanes updates the lanes that were paused in the previous outcomes using the consensus observation:
+//   - a lane observed as paused for the first time is tracked starting from its OffRamp next sequence number,
+//   - while a lane is paused the end of the paused range follows the OnRamp max sequence number,
+//   - a lane that is observed as active again is marked as resumed,
+//   - a resumed lane is not tracked anymore once the OffRamp has committed the whole paused range.
+//
+// Lanes without a consensus on their state are carried over unchanged.
+func trackPausedLanes(
+	lggr logger.Logger,
+	prevPausedLanes []PausedLane,
+	consObservation consensusObservation,
+) []PausedLane {
+	lanes := make(map[cciptypes.ChainSelector]PausedLane, len(prevPausedLanes))
+	for _, lane := range prevPausedLanes {
+		lanes[lane.ChainSel] = lane
+	}
+
+	for chainSel, offRampNextSeqNum := range consObservation.PausedLanes {
+		lane, exists := lanes[chainSel]
+		if !exists {
+			lggr.Warnw("lane paused", "chain", chainSel, "offRampNextSeqNum", offRampNextSeqNum)
+			lane = PausedLane{ChainSel: chainSel, FirstSeqNum: offRampNextSeqNum, LastSeqNum: offRampNextSeqNum - 1}
+		} else if lane.Resumed {
+			lggr.Warnw("lane paused again before its paused range was committed", "lane", lane)
+			lane.Resumed = false
+		}
+
+		lane.FirstSeqNum = max(lane.FirstSeqNum, offRampNextSeqNum)
+		if onRampMaxSeqNum, ok := consObservation.OnRampMaxSeqNums[chainSel]; ok {
+			lane.LastSeqNum = max(lane.LastSeqNum, onRampMaxSeqNum)
+		}
+		lanes[chainSel] = lane
+	}
+
+	for chainSel, lane := range lanes {
+		if _, paused := consObservation.PausedLanes[chainSel]; paused {
+			continue
+		}
+
+		offRampNextSeqNum, active := consObservation.OffRampNextSeqNums[chainSel]
+		if !active {
+			continue
+		}
+
+		if !lane.Resumed {
+			lggr.Infow("lane resumed, backfilling the paused range", "lane", lane)
+			lane.Resumed = true
+		}
+
+		if offRampNextSeqNum > lane.LastSeqNum {
+			lggr.Infow("paused range of the lane was committed", "lane", lane, "offRampNextSeqNum", offRampNextSeqNum)
+			delete(lanes, chainSel)
+			continue
+		}
+
+		lane.FirstSeqNum = max(lane.FirstSeqNum, offRampNextSeqNum)
+		lanes[chainSel] = lane
+	}
+
+	if len(lanes) == 0 {
+		return nil
+	}
+
+	pausedLanes := maps.Values(lanes)
+	sort.Slice(pausedLanes, func(i, j int) bool { return pausedLanes[i].ChainSel < pausedLanes[j].ChainSel })
+	return pausedLanes
+}
+
 // buildMerkleRootsOutcome is given a set of agreed observed merkle roots and RMN signatures
 // and construct a merkleRoots outcome.
 func buildMerkleRootsOutcome(
@@ -218,6 +298,8 @@ func buildMerkleRootsOutcome(
 		OffRampNextSeqNums:  prevOutcome.OffRampNextSeqNums,
 		RMNReportSignatures: sigs,
 		RMNRemoteCfg:        prevOutcome.RMNRemoteCfg,
+		PausedLanes:         prevOutcome.PausedLanes,
+		PendingBatches:      prevOutcome.PendingBatches,
 	}
 
 	return outcome, nil
@@ -448,6 +530,12 @@ func getConsensusObservation(
 	twoFChainPlus1 := consensus.MakeMultiThreshold(fChains, consensus.TwoFPlus1)
 	fChain := consensus.MakeMultiThreshold(fChains, consensus.F)
 
+	// all the oracles observe the timestamp, the consensus uses the role DON F value.
+	var timestamp time.Time
+	if !consensus.LtTwoFPlusOne(fRoleDON, len(aggObs.Timestamps)) {
+		timestamp = consensus.TimestampsMedian(aggObs.Timestamps)
+	}
+
 	consensusObs := consensusObservation{
 		MerkleRoots:      consensus.GetConsensusMap(lggr, "Merkle Root", aggObs.MerkleRoots, twoFChainPlus1),
 		RMNEnabledChains: consensus.GetConsensusMap(lggr, "RMNEnabledChains", aggObs.RMNEnabledChains, twoFChainPlus1),
@@ -463,6 +551,12 @@ func getConsensusObservation(
 			fChain),
 		RMNRemoteConfig: consensus.GetConsensusMap(lggr, "RMNRemote cfg", rmnRemoteConfigs, twoFChainPlus1),
 		FChain:          fChains,
+		PausedLanes: consensus.GetOrderedConsensus(
+			lggr,
+			"Paused Lanes",
+			aggObs.PausedLanes,
+			fChain),
+		Timestamp: timestamp,
 	}
 
 	return consensusObs, nil
@@ -1150,6 +1150,63 @@ func Test_buildMerkleRootsOutcome(t *testing.T) {
 			require.Equal(t, report1, report2)
 		}
 	})
+
+	t.Run("resumed lane keeps the RMN signed order", func(t *testing.T) {
+		laneUpdate := func(chainSel uint64, root cciptypes.Bytes32) *rmnpb.FixedDestLaneUpdate {
+			return &rmnpb.FixedDestLaneUpdate{
+				LaneSource:     &rmnpb.LaneSource{SourceChainSelector: chainSel, OnrampAddress: []byte{0xa}},
+				ClosedInterval: &rmnpb.ClosedInterval{MinMsgNr: 10, MaxMsgNr: 20},
+				Root:           root[:],
+			}
+		}
+		rootChain := func(chainSel cciptypes.ChainSelector, root cciptypes.Bytes32) cciptypes.MerkleRootChain {
+			return cciptypes.MerkleRootChain{
+				ChainSel:      chainSel,
+				OnRampAddress: []byte{0xa},
+				SeqNumsRange:  cciptypes.NewSeqNumRange(10, 20),
+				MerkleRoot:    root,
+			}
+		}
+
+		// RMN signs the lane updates ordered by the source chain selector
+		signedLaneUpdates := []*rmnpb.FixedDestLaneUpdate{
+			laneUpdate(1, cciptypes.Bytes32{1}),
+			laneUpdate(3, cciptypes.Bytes32{3}),
+		}
+		obs := consensusObservation{
+			MerkleRoots: map[cciptypes.ChainSelector]cciptypes.MerkleRootChain{
+				1: rootChain(1, cciptypes.Bytes32{1}),
+				2: rootChain(2, cciptypes.Bytes32{2}),
+				3: rootChain(3, cciptypes.Bytes32{3}),
+			},
+			RMNEnabledChains: map[cciptypes.ChainSelector]bool{1: true, 2: false, 3: true},
+		}
+		prevOutcome := Outcome{
+			OutcomeType: ReportIntervalsSelected,
+			PausedLanes: []PausedLane{{ChainSel: 3, FirstSeqNum: 10, LastSeqNum: 20, Resumed: true}},
+		}
+		rmnSigs := rmn.ReportSignatures{
+			Signatures:  []*rmnpb.EcdsaSignature{{R: internal.RandBytes(), S: internal.RandBytes()}},
+			LaneUpdates: signedLaneUpdates,
+		}
+
+		outcome, err := buildMerkleRootsOutcome(rmnSigs, true, logger.Test(t), obs, prevOutcome, mockAddrCodec)
+		require.NoError(t, err)
+		require.Len(t, outcome.RootsToReport, 3)
+
+		// blessed roots are copied to the report in the RootsToReport order
+		blessedRoots := make([]cciptypes.ChainSelector, 0)
+		for _, root := range outcome.RootsToReport {
+			if outcome.RMNEnabledChains[root.ChainSel] {
+				blessedRoots = append(blessedRoots, root.ChainSel)
+			}
+		}
+		signedRoots := make([]cciptypes.ChainSelector, 0)
+		for _, laneUpdate := range signedLaneUpdates {
+			signedRoots = append(signedRoots, cciptypes.ChainSelector(laneUpdate.LaneSource.SourceChainSelector))
+		}
+		require.Equal(t, signedRoots, blessedRoots)
+	})
 }
 
 func Test_reportRangesOutcome(t *testing.T) {
@@ -1235,6 +1292,124 @@ func Test_reportRangesOutcome(t *testing.T) {
 	}
 }
 
+func Test_trackPausedLanes(t *testing.T) {
+	lggr := logger.Test(t)
+
+	testCases := []struct {
+		name                 string
+		prevPausedLanes      []PausedLane
+		consensusObservation consensusObservation
+		expPausedLanes       []PausedLane
+	}{
+		{
+			name: "no paused lanes",
+			consensusObservation: consensusObservation{
+				OnRampMaxSeqNums:   map[cciptypes.ChainSelector]cciptypes.SeqNum{1: 20},
+				OffRampNextSeqNums: map[cciptypes.ChainSelector]cciptypes.SeqNum{1: 18},
+			},
+		},
+		{
+			name: "lane paused",
+			consensusObservation: consensusObservation{
+				OnRampMaxSeqNums:   map[cciptypes.ChainSelector]cciptypes.SeqNum{1: 20, 2: 30},
+				OffRampNextSeqNums: map[cciptypes.ChainSelector]cciptypes.SeqNum{1: 18},
+				PausedLanes:        map[cciptypes.ChainSelector]cciptypes.SeqNum{2: 25},
+			},
+			expPausedLanes: []PausedLane{{ChainSel: 2, FirstSeqNum: 25, LastSeqNum: 30}},
+		},
+		{
+			name: "lane paused without pending messages",
+			consensusObservation: consensusObservation{
+				PausedLanes: map[cciptypes.ChainSelector]cciptypes.SeqNum{2: 25},
+			},
+			expPausedLanes: []PausedLane{{ChainSel: 2, FirstSeqNum: 25, LastSeqNum: 24}},
+		},
+		{
+			name:            "paused range follows the onRamp",
+			prevPausedLanes: []PausedLane{{ChainSel: 2, FirstSeqNum: 25, LastSeqNum: 30}},
+			consensusObservation: consensusObservation{
+				OnRampMaxSeqNums: map[cciptypes.ChainSelector]cciptypes.SeqNum{2: 40},
+				PausedLanes:      map[cciptypes.ChainSelector]cciptypes.SeqNum{2: 25},
+			},
+			expPausedLanes: []PausedLane{{ChainSel: 2, FirstSeqNum: 25, LastSeqNum: 40}},
+		},
+		{
+			name:            "lane resumed",
+			prevPausedLanes: []PausedLane{{ChainSel: 2, FirstSeqNum: 25, LastSeqNum: 40}},
+			consensusObservation: consensusObservation{
+				OnRampMaxSeqNums:   map[cciptypes.ChainSelector]cciptypes.SeqNum{2: 50},
+				OffRampNextSeqNums: map[cciptypes.ChainSelector]cciptypes.SeqNum{2: 30},
+			},
+			expPausedLanes: []PausedLane{{ChainSel: 2, FirstSeqNum: 30, LastSeqNum: 40, Resumed: true}},
+		},
+		{
+			name:            "paused range committed",
+			prevPausedLanes: []PausedLane{{ChainSel: 2, FirstSeqNum: 30, LastSeqNum: 40, Resumed: true}},
+			consensusObservation: consensusObservation{
+				OffRampNextSeqNums: map[cciptypes.ChainSelector]cciptypes.SeqNum{2: 41},
+			},
+		},
+		{
+			name:            "resumed lane paused again",
+			prevPausedLanes: []PausedLane{{ChainSel: 2, FirstSeqNum: 30, LastSeqNum: 40, Resumed: true}},
+			consensusObservation: consensusObservation{
+				OnRampMaxSeqNums: map[cciptypes.ChainSelector]cciptypes.SeqNum{2: 45},
+				PausedLanes:      map[cciptypes.ChainSelector]cciptypes.SeqNum{2: 35},
+			},
+			expPausedLanes: []PausedLane{{ChainSel: 2, FirstSeqNum: 35, LastSeqNum: 45}},
+		},
+		{
+			name: "lanes without consensus are carried over",
+			prevPausedLanes: []PausedLane{
+				{ChainSel: 2, FirstSeqNum: 25, LastSeqNum: 40},
+				{ChainSel: 3, FirstSeqNum: 5, LastSeqNum: 8, Resumed: true},
+			},
+			consensusObservation: consensusObservation{
+				PausedLanes: map[cciptypes.ChainSelector]cciptypes.SeqNum{1: 7},
+			},
+			expPausedLanes: []PausedLane{
+				{ChainSel: 1, FirstSeqNum: 7, LastSeqNum: 6},
+				{ChainSel: 2, FirstSeqNum: 25, LastSeqNum: 40},
+				{ChainSel: 3, FirstSeqNum: 5, LastSeqNum: 8, Resumed: true},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expPausedLanes, trackPausedLanes(lggr, tc.prevPausedLanes, tc.consensusObservation))
+		})
+	}
+}
+
+func TestOutcome_Sort_resumedRangesFirst(t *testing.T) {
+	outcome := Outcome{
+		RangesSelectedForReport: []plugintypes.ChainRange{
+			{ChainSel: 3, SeqNumRange: cciptypes.NewSeqNumRange(1, 2)},
+			{ChainSel: 1, SeqNumRange: cciptypes.NewSeqNumRange(1, 2)},
+			{ChainSel: 2, SeqNumRange: cciptypes.NewSeqNumRange(1, 2)},
+		},
+		RootsToReport: []cciptypes.MerkleRootChain{{ChainSel: 3}, {ChainSel: 1}, {ChainSel: 2}},
+		PausedLanes: []PausedLane{
+			{ChainSel: 3, FirstSeqNum: 1, LastSeqNum: 10, Resumed: true},
+			{ChainSel: 2, FirstSeqNum: 1, LastSeqNum: 10},
+		},
+	}
+	outcome.Sort()
+
+	rangeChains := make([]cciptypes.ChainSelector, 0)
+	for _, r := range outcome.RangesSelectedForReport {
+		rangeChains = append(rangeChains, r.ChainSel)
+	}
+	rootChains := make([]cciptypes.ChainSelector, 0)
+	for _, r := range outcome.RootsToReport {
+		rootChains = append(rootChains, r.ChainSel)
+	}
+	require.Equal(t, []cciptypes.ChainSelector{3, 1, 2}, rangeChains)
+	require.Equal(t, []cciptypes.ChainSelector{1, 2, 3}, rootChains)
+	require.Equal(t, cciptypes.ChainSelector(2), outcome.PausedLanes[0].ChainSel)
+}
+
 func TestCheckForReportTransmission(t *testing.T) {
 	source1, source2 := cciptypes.ChainSelector(1), cciptypes.ChainSelector(2)
 	tests := []struct {
@@ -122,6 +122,10 @@ type controller struct {
 	reportsInitialRequestTimerDuration time.Duration
 
 	metricsReporter MetricsReporter
+
+	// nodeScores keeps the health score of the RMN nodes, used to prefer fast and reliable nodes
+	// and to hedge the requests sent to slow ones.
+	nodeScores *nodeScorer
 }
 
 // NewController creates a new RMN Controller instance.
@@ -150,6 +154,7 @@ func NewController(
 		observationsInitialRequestTimerDuration: observationsInitialRequestTimerDuration,
 		reportsInitialRequestTimerDuration:      reportsInitialRequestTimerDuration,
 		metricsReporter:                         metricsReporter,
+		nodeScores:                              newNodeScorer(),
 	}
 }
 
@@ -301,10 +306,12 @@ func (c *controller) getRmnSignedObservations(
 	requestsPerNode := make(map[rmntypes.NodeID][]*rmnpb.FixedDestLaneUpdateRequest) // grouped requests for each node
 
 	// Send to every RMN node all the lane update requests it supports until all chains have a sufficient amount.
-	// of initial observers. Upon timer expiration, additional requests are sent to the rest of the RMN nodes.
+	// of initial observers. Nodes with the best health score are requested first.
+	// Slow requests are hedged to additional nodes and upon timer expiration, additional requests are sent
+	// to the rest of the RMN nodes.
 
 	chainsWithEnoughRequests := mapset.NewSet[uint64]()
-	for nodeID := range rmnNodeInfo {
+	for _, nodeID := range c.nodeScores.rank(maps.Keys(rmnNodeInfo)) {
 		if chainsWithEnoughRequests.Cardinality() == len(updateRequestsPerChain) {
 			break // We have enough initial observers for all source chains.
 		}
@@ -427,6 +434,7 @@ func (c *controller) sendObservationRequests(
 		lggr.Infow("sending observation request", "laneUpdateRequests", requests)
 		if err := c.marshalAndSend(req, rmnNode); err != nil {
 			c.metricsReporter.TrackRmnRequest(RmnMethodObservation, 0, uint64(nodeID), "failed_to_send_request")
+			c.trackNodeFailure(uint64(nodeID))
 			lggr.Errorw("failed to send observation request", "err", err)
 			continue
 		}
@@ -457,12 +465,15 @@ func (c *controller) listenForRmnObservationResponses(
 		mapset.NewSetFromMapKeys(inFlightRequests).String())
 
 	finishedRequestIDs := mapset.NewSet[uint64]()
+	hedgedRequestIDs := mapset.NewSet[uint64]()
 	rmnObservationResponses := make([]rmnSignedObservationWithMeta, 0)
 
 	initialObservationRequestTimer := time.NewTimer(c.observationsInitialRequestTimerDuration)
 	timerExpired := false
+	hedgeTicker := time.NewTicker(hedgeCheckInterval)
 
 	defer initialObservationRequestTimer.Stop()
+	defer hedgeTicker.Stop()
 	for {
 		select {
 		case resp := <-c.peerClient.Recv():
@@ -484,10 +495,12 @@ func (c *controller) listenForRmnObservationResponses(
 
 			if err != nil {
 				c.metricsReporter.TrackRmnRequest(RmnMethodObservation, latency, uint64(resp.RMNNodeID), "invalid_response")
+				c.trackNodeScore(uint64(resp.RMNNodeID), latency, false)
 				lggr.Warnw("skipping an invalid RMN observation response", "err", err)
 				initialObservationRequestTimer.Reset(0) // immediately schedule the additional requests
 			} else {
 				c.metricsReporter.TrackRmnRequest(RmnMethodObservation, latency, uint64(resp.RMNNodeID), "")
+				c.trackNodeScore(uint64(resp.RMNNodeID), latency, true)
 				rmnObservationResponses = append(rmnObservationResponses, rmnSignedObservationWithMeta{
 					SignedObservation: parsedResp.GetSignedObservation(),
 					RMNNodeID:         resp.RMNNodeID,
@@ -502,6 +515,7 @@ func (c *controller) listenForRmnObservationResponses(
 
 			if chainsWithSufficientResponses.Cardinality() == len(lursPerChain) {
 				lggr.Info("all chains have enough observation responses with matching roots")
+				c.trackPendingRequests(inFlightRequests, finishedRequestIDs)
 				return rmnObservationResponses, nil
 			}
 
@@ -517,6 +531,18 @@ func (c *controller) listenForRmnObservationResponses(
 				lggr.Warnw("observation requests were finished, but results are not sufficient")
 				return rmnObservationResponses, ErrInsufficientObservationResponses
 			}
+		case <-hedgeTicker.C:
+			if timerExpired {
+				continue // all the nodes are already requested
+			}
+			requestsPerNode := c.hedgeObservationRequests(
+				inFlightRequests, finishedRequestIDs, hedgedRequestIDs, lursPerChain, requestedNodes)
+			if len(requestsPerNode) == 0 {
+				continue
+			}
+			lggr.Infow("hedging slow RMN observation requests", "nodes", maps.Keys(requestsPerNode))
+			newInFlightRequests := c.sendObservationRequests(lggr, destChain, requestsPerNode, rmnNodeInfo)
+			maps.Copy(inFlightRequests, newInFlightRequests)
 		case <-initialObservationRequestTimer.C:
 			if timerExpired {
 				continue
@@ -526,7 +552,7 @@ func (c *controller) listenForRmnObservationResponses(
 			lggr.Warn("sending additional RMN observation requests")
 			requestsPerNode := make(map[rmntypes.NodeID][]*rmnpb.FixedDestLaneUpdateRequest)
 			for sourceChain, updateReq := range lursPerChain {
-				for _, nodeID := range randomShuffle(updateReq.RmnNodes.ToSlice()) {
+				for _, nodeID := range c.nodeScores.rank(updateReq.RmnNodes.ToSlice()) {
 					if requestedNodes[sourceChain].Contains(nodeID) {
 						continue
 					}
@@ -542,6 +568,7 @@ func (c *controller) listenForRmnObservationResponses(
 				if !finishedRequestIDs.Contains(requestID) {
 					c.metricsReporter.TrackRmnRequest(RmnMethodObservation, requestInfo.Latency(),
 						requestInfo.nodeID, "timeout")
+					c.trackNodeScore(requestInfo.nodeID, requestInfo.Latency(), false)
 					lggr.Warnw("Timed out waiting for an observation response from RMN",
 						"requestID", requestID, "nodeID", requestInfo.nodeID, "latency", requestInfo.Latency())
 				}
@@ -887,8 +914,8 @@ func (c *controller) sendReportSignatureRequest(
 	inFlightRequests = make(map[uint64]InFlightRmnRequest)
 	signersRequested = mapset.NewSet[rmntypes.NodeID]()
 
-	// Send the report signature request to at least #remoteF+1
-	for _, node := range randomShuffle(remoteSigners) {
+	// Send the report signature request to at least #remoteF+1, nodes with the best health score first.
+	for _, node := range c.rankSigners(remoteSigners) {
 		if consensus.GteFPlusOne(remoteF, len(inFlightRequests)) {
 			break
 		}
@@ -912,6 +939,7 @@ func (c *controller) sendReportSignatureRequest(
 		if err != nil {
 			lggr.Warnw("failed to send report signature request", "node", node.NodeIndex, "err", err)
 			c.metricsReporter.TrackRmnRequest(RmnMethodReportSignature, 0, node.NodeIndex, "failed_to_send_request")
+			c.trackNodeFailure(node.NodeIndex)
 			continue
 		}
 
@@ -946,9 +974,14 @@ func (c *controller) listenForRmnReportSignatures(
 ) ([]*rmnpb.EcdsaSignature, error) {
 	tReportsInitialRequest := time.NewTimer(c.reportsInitialRequestTimerDuration)
 	timerExpired := false
+	hedgeTicker := time.NewTicker(hedgeCheckInterval)
+
+	defer tReportsInitialRequest.Stop()
+	defer hedgeTicker.Stop()
 
 	reportSigs := make([]reportSigWithSignerAddress, 0)
 	finishedRequests := mapset.NewSet[uint64]()
+	hedgedRequests := mapset.NewSet[uint64]()
 	inFlightRequests = maps.Clone(inFlightRequests)
 	requestIDs := mapset.NewSetFromMapKeys(inFlightRequests)
 	lggr.Infof("waiting for report signatures, requestIDs: %s", requestIDs.String())
@@ -968,16 +1001,19 @@ func (c *controller) listenForRmnReportSignatures(
 
 			if err != nil {
 				c.metricsReporter.TrackRmnRequest(RmnMethodReportSignature, latency, uint64(resp.RMNNodeID), "invalid_response")
+				c.trackNodeScore(uint64(resp.RMNNodeID), latency, false)
 				lggr.Warnw("skipping an invalid RMN report signature response", "err", err)
 				tReportsInitialRequest.Reset(0) // schedule additional requests if any
 			} else {
 				c.metricsReporter.TrackRmnRequest(RmnMethodReportSignature, latency, uint64(resp.RMNNodeID), "")
+				c.trackNodeScore(uint64(resp.RMNNodeID), latency, true)
 				lggr.Infow("received valid report signature", "node", resp.RMNNodeID, "requestID", responseTyp.RequestId)
 				reportSigs = append(reportSigs, *reportSig)
 			}
 
 			if consensus.GteFPlusOne(remoteF, len(reportSigs)) {
 				lggr.Infof("got enough RMN report signatures")
+				c.trackPendingRequests(inFlightRequests, finishedRequests)
 				return sortAndParseReportSigs(reportSigs), nil
 			}
 
@@ -985,6 +1021,12 @@ func (c *controller) listenForRmnReportSignatures(
 				lggr.Warn("report signature requests were finished, but results are not sufficient")
 				return nil, ErrInsufficientSignatureResponses
 			}
+		case <-hedgeTicker.C:
+			if timerExpired {
+				continue // all the signers are already requested
+			}
+			c.hedgeReportSignatureRequests(lggr, reportSigReq, inFlightRequests, finishedRequests, hedgedRequests,
+				signersRequested, signers, rmnNodeInfo)
 		case <-tReportsInitialRequest.C:
 			if timerExpired {
 				continue
@@ -993,7 +1035,7 @@ func (c *controller) listenForRmnReportSignatures(
 
 			lggr.Warnw("sending additional RMN signature requests")
 
-			for _, node := range randomShuffle(signers) {
+			for _, node := range c.rankSigners(signers) {
 				nodeIndex := node.NodeIndex
 				if signersRequested.Contains(rmntypes.NodeID(nodeIndex)) {
 					continue
@@ -1025,6 +1067,7 @@ func (c *controller) listenForRmnReportSignatures(
 				if !finishedRequests.Contains(requestID) {
 					c.metricsReporter.TrackRmnRequest(RmnMethodReportSignature, requestInfo.Latency(),
 						requestInfo.nodeID, "timeout")
+					c.trackNodeScore(requestInfo.nodeID, requestInfo.Latency(), false)
 					lggr.Warnw("Timed out waiting for a report signature response from RMN",
 						"requestID", requestID, "nodeID", requestInfo.nodeID, "latency", requestInfo.Latency())
 				}
@@ -1129,6 +1172,132 @@ type rmnSignedObservationWithMeta struct {
 	RMNNodeID         rmntypes.NodeID
 }
 
+// hedgeObservationRequests returns the observation requests to send on behalf of the in-flight requests
+// that take longer than expected for their node. For every source chain of a slow request, the node with
+// the best health score that was not requested yet for the chain is picked.
+func (c *controller) hedgeObservationRequests(
+	inFlightRequests map[uint64]InFlightRmnRequest,
+	finishedRequestIDs mapset.Set[uint64],
+	hedgedRequestIDs mapset.Set[uint64],
+	lursPerChain map[uint64]updateRequestWithMeta,
+	requestedNodes map[uint64]mapset.Set[rmntypes.NodeID],
+) map[rmntypes.NodeID][]*rmnpb.FixedDestLaneUpdateRequest {
+	requestsPerNode := make(map[rmntypes.NodeID][]*rmnpb.FixedDestLaneUpdateRequest)
+
+	for requestID, requestInfo := range inFlightRequests {
+		if finishedRequestIDs.Contains(requestID) || hedgedRequestIDs.Contains(requestID) {
+			continue
+		}
+		nodeID := rmntypes.NodeID(requestInfo.nodeID)
+		if time.Since(requestInfo.sent) < c.nodeScores.hedgeDelay(nodeID, c.observationsInitialRequestTimerDuration) {
+			continue
+		}
+		hedgedRequestIDs.Add(requestID)
+
+		for sourceChain, updateReq := range lursPerChain {
+			if !requestedNodes[sourceChain].Contains(nodeID) {
+				continue
+			}
+			candidates := updateReq.RmnNodes.Difference(requestedNodes[sourceChain]).ToSlice()
+			if len(candidates) == 0 {
+				continue
+			}
+			hedgeNodeID := c.nodeScores.rank(candidates)[0]
+			requestedNodes[sourceChain].Add(hedgeNodeID)
+			requestsPerNode[hedgeNodeID] = append(requestsPerNode[hedgeNodeID], updateReq.Data)
+		}
+	}
+
+	return requestsPerNode
+}
+
+// hedgeReportSignatureRequests sends an additional report signature request for every in-flight request
+// that takes longer than expected for its node, to the signer with the best health score not requested yet.
+func (c *controller) hedgeReportSignatureRequests(
+	lggr logger.Logger,
+	reportSigReq *rmnpb.ReportSignatureRequest,
+	inFlightRequests map[uint64]InFlightRmnRequest,
+	finishedRequests mapset.Set[uint64],
+	hedgedRequests mapset.Set[uint64],
+	signersRequested mapset.Set[rmntypes.NodeID],
+	signers []cciptypes.RemoteSignerInfo,
+	rmnNodeInfo map[rmntypes.NodeID]rmntypes.HomeNodeInfo,
+) {
+	for requestID, requestInfo := range maps.Clone(inFlightRequests) {
+		if finishedRequests.Contains(requestID) || hedgedRequests.Contains(requestID) {
+			continue
+		}
+		nodeID := rmntypes.NodeID(requestInfo.nodeID)
+		if time.Since(requestInfo.sent) < c.nodeScores.hedgeDelay(nodeID, c.reportsInitialRequestTimerDuration) {
+			continue
+		}
+		hedgedRequests.Add(requestID)
+
+		for _, node := range c.rankSigners(signers) {
+			if signersRequested.Contains(rmntypes.NodeID(node.NodeIndex)) {
+				continue
+			}
+			rmnNode, exists := rmnNodeInfo[rmntypes.NodeID(node.NodeIndex)]
+			if !exists {
+				continue
+			}
+
+			req := &rmnpb.Request{
+				RequestId: newRequestID(lggr),
+				Request: &rmnpb.Request_ReportSignatureRequest{
+					ReportSignatureRequest: reportSigReq,
+				},
+			}
+			lggr.Infow("hedging slow report signature request",
+				"slowNode", nodeID, "node", node.NodeIndex, "requestID", req.RequestId)
+			if err := c.marshalAndSend(req, rmnNode); err != nil {
+				lggr.Warnw("failed to send report signature request", "node", node.NodeIndex, "err", err)
+				c.metricsReporter.TrackRmnRequest(RmnMethodReportSignature, 0, node.NodeIndex, "failed_to_send_request")
+				c.trackNodeFailure(node.NodeIndex)
+				signersRequested.Add(rmntypes.NodeID(node.NodeIndex))
+				continue
+			}
+			inFlightRequests[req.RequestId] = NewInFlightRmnRequest(node.NodeIndex)
+			signersRequested.Add(rmntypes.NodeID(node.NodeIndex))
+			break
+		}
+	}
+}
+
+// rankSigners orders the signers by their health score, best first.
+func (c *controller) rankSigners(signers []cciptypes.RemoteSignerInfo) []cciptypes.RemoteSignerInfo {
+	return rankNodes(c.nodeScores, signers, func(s cciptypes.RemoteSignerInfo) rmntypes.NodeID {
+		return rmntypes.NodeID(s.NodeIndex)
+	})
+}
+
+// trackNodeScore updates the health score of the node with the result of a request and reports it.
+func (c *controller) trackNodeScore(nodeID uint64, latency float64, ok bool) {
+	c.nodeScores.recordResponse(rmntypes.NodeID(nodeID), latency, ok)
+	c.metricsReporter.TrackRmnNodeScore(nodeID, c.nodeScores.score(rmntypes.NodeID(nodeID)))
+}
+
+// trackNodeFailure updates the health score of the node with a request that could not be sent and reports it.
+func (c *controller) trackNodeFailure(nodeID uint64) {
+	c.nodeScores.recordFailure(rmntypes.NodeID(nodeID))
+	c.metricsReporter.TrackRmnNodeScore(nodeID, c.nodeScores.score(rmntypes.NodeID(nodeID)))
+}
+
+// trackPendingRequests updates the health score of the nodes of the requests that are still in flight
+// once enough responses were collected, so that nodes that are consistently slower than the rest are deprioritized.
+func (c *controller) trackPendingRequests(
+	inFlightRequests map[uint64]InFlightRmnRequest,
+	finishedRequests mapset.Set[uint64],
+) {
+	for requestID, requestInfo := range inFlightRequests {
+		if finishedRequests.Contains(requestID) {
+			continue
+		}
+		c.nodeScores.recordPending(rmntypes.NodeID(requestInfo.nodeID), requestInfo.Latency())
+		c.metricsReporter.TrackRmnNodeScore(requestInfo.nodeID, c.nodeScores.score(rmntypes.NodeID(requestInfo.nodeID)))
+	}
+}
+
 func (c *controller) marshalAndSend(req *rmnpb.Request, rmnNode rmntypes.HomeNodeInfo) error {
 	reqBytes, err := proto.Marshal(req)
 	if err != nil {
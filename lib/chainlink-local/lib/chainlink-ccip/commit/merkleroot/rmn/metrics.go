@@ -7,8 +7,11 @@ const (
 
 type MetricsReporter interface {
 	TrackRmnRequest(method string, latency float64, nodeID uint64, err string)
+	TrackRmnNodeScore(nodeID uint64, score float64)
 }
 
 type NoopMetrics struct{}
 
 func (n NoopMetrics) TrackRmnRequest(string, float64, uint64, string) {}
+
+func (n NoopMetrics) TrackRmnNodeScore(uint64, float64) {}
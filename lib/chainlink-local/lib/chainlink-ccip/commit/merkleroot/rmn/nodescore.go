@@ -0,0 +1,193 @@
+// nodescore.go contains the per-node health scoring used by the RMN controller to prefer fast and reliable
+// RMN nodes and to hedge requests sent to slow ones.
+
+package rmn
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	rmntypes "github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn/types"
+)
+
+const (
+	// scoreEWMAWeight is the weight of a new sample in the moving averages of the node stats.
+	scoreEWMAWeight = 0.2
+
+	// scoreHalfLife is the duration after which the stats of a node are halfway back to the neutral values,
+	// it allows nodes that recovered to be preferred again even if they were not requested for a while.
+	scoreHalfLife = 10 * time.Minute
+
+	// scoreLatencyScaleMs is the latency in milliseconds at which the score of a reliable node is halved.
+	scoreLatencyScaleMs = 500.0
+
+	// hedgeLatencyMultiplier is the multiple of the expected node latency after which a request is hedged.
+	hedgeLatencyMultiplier = 3.0
+
+	// minHedgeDelay is the minimum duration a request is in flight before it is hedged.
+	minHedgeDelay = 50 * time.Millisecond
+
+	// hedgeCheckInterval is the interval at which the in-flight requests are checked for hedging.
+	hedgeCheckInterval = 25 * time.Millisecond
+)
+
+// nodeStats holds the moving averages of the requests sent to an RMN node.
+type nodeStats struct {
+	// latencyMs is the moving average of the response latency in milliseconds.
+	latencyMs float64
+	// reliability is the moving average of the ratio of valid responses, between 0 and 1.
+	reliability float64
+	// samples is the number of requests that contributed to the reliability.
+	samples int
+	// latencySamples is the number of requests that contributed to the latency, requests that could not be sent
+	// have no latency.
+	latencySamples int
+	lastUpdate     time.Time
+}
+
+// nodeScorer keeps a health score per RMN node based on the latencies and the results of the requests.
+// Nodes without stats are considered healthy so that they are explored.
+type nodeScorer struct {
+	mu    sync.RWMutex
+	stats map[rmntypes.NodeID]nodeStats
+	now   func() time.Time
+}
+
+func newNodeScorer() *nodeScorer {
+	return &nodeScorer{
+		stats: make(map[rmntypes.NodeID]nodeStats),
+		now:   time.Now,
+	}
+}
+
+// recordResponse records the result of a finished request, ok is false for invalid responses and timeouts.
+func (s *nodeScorer) recordResponse(nodeID rmntypes.NodeID, latencyMs float64, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	reliability := 0.0
+	if ok {
+		reliability = 1.0
+	}
+
+	st := s.decayedStats(nodeID)
+	st.addLatency(latencyMs)
+	st.addReliability(reliability)
+	st.lastUpdate = s.now()
+	s.stats[nodeID] = st
+}
+
+// recordFailure records a request that could not be sent to the node. Only the reliability of the node is
+// updated, there is no response latency.
+func (s *nodeScorer) recordFailure(nodeID rmntypes.NodeID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.decayedStats(nodeID)
+	st.addReliability(0.0)
+	st.lastUpdate = s.now()
+	s.stats[nodeID] = st
+}
+
+// recordPending records the latency of a request that did not finish before the results were collected.
+// The latency is a lower bound of the actual one, so it only makes the node look slower.
+func (s *nodeScorer) recordPending(nodeID rmntypes.NodeID, latencyMs float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.decayedStats(nodeID)
+	if st.latencySamples > 0 && latencyMs <= st.latencyMs {
+		return
+	}
+	if st.samples == 0 {
+		st.addReliability(1.0)
+	}
+	st.addLatency(latencyMs)
+	st.lastUpdate = s.now()
+	s.stats[nodeID] = st
+}
+
+// score returns the health score of the node between 0 (unhealthy) and 1 (healthy).
+func (s *nodeScorer) score(nodeID rmntypes.NodeID) float64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st := s.decayedStats(nodeID)
+	if st.samples == 0 {
+		return 1.0
+	}
+	return st.reliability / (1 + st.latencyMs/scoreLatencyScaleMs)
+}
+
+// hedgeDelay returns the duration after which a request to the node is considered slow and is hedged.
+// If the node latency is not known, the fallback is returned.
+func (s *nodeScorer) hedgeDelay(nodeID rmntypes.NodeID, fallback time.Duration) time.Duration {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st := s.decayedStats(nodeID)
+	if st.latencySamples == 0 {
+		return fallback
+	}
+	delay := time.Duration(hedgeLatencyMultiplier * st.latencyMs * float64(time.Millisecond))
+	return min(max(delay, minHedgeDelay), fallback)
+}
+
+// decayedStats returns the stats of the node moved back towards the neutral values according to their age.
+func (s *nodeScorer) decayedStats(nodeID rmntypes.NodeID) nodeStats {
+	st, exists := s.stats[nodeID]
+	if !exists {
+		return nodeStats{}
+	}
+
+	age := s.now().Sub(st.lastUpdate)
+	if age <= 0 {
+		return st
+	}
+	keep := math.Pow(0.5, float64(age)/float64(scoreHalfLife))
+	st.latencyMs *= keep
+	st.reliability = 1 - (1-st.reliability)*keep
+	return st
+}
+
+// rank orders the node IDs by their score, best first. Ties are broken randomly.
+func (s *nodeScorer) rank(nodeIDs []rmntypes.NodeID) []rmntypes.NodeID {
+	return rankNodes(s, nodeIDs, func(nodeID rmntypes.NodeID) rmntypes.NodeID { return nodeID })
+}
+
+// rankNodes orders the items by the score of their node, best first. Ties are broken randomly.
+func rankNodes[T any](s *nodeScorer, items []T, nodeID func(T) rmntypes.NodeID) []T {
+	ranked := randomShuffle(items)
+	scores := make(map[rmntypes.NodeID]float64, len(ranked))
+	for _, item := range ranked {
+		scores[nodeID(item)] = s.score(nodeID(item))
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return scores[nodeID(ranked[i])] > scores[nodeID(ranked[j])]
+	})
+	return ranked
+}
+
+func (st *nodeStats) addLatency(latencyMs float64) {
+	if st.latencySamples == 0 {
+		st.latencyMs = latencyMs
+	} else {
+		st.latencyMs = ewma(st.latencyMs, latencyMs)
+	}
+	st.latencySamples++
+}
+
+func (st *nodeStats) addReliability(reliability float64) {
+	if st.samples == 0 {
+		st.reliability = reliability
+	} else {
+		st.reliability = ewma(st.reliability, reliability)
+	}
+	st.samples++
+}
+
+func ewma(avg, sample float64) float64 {
+	return (1-scoreEWMAWeight)*avg + scoreEWMAWeight*sample
+}
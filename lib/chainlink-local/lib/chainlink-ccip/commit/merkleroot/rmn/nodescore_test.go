@@ -0,0 +1,66 @@
+package rmn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	rmntypes "github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn/types"
+)
+
+func Test_nodeScorer(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	s := newNodeScorer()
+	s.now = func() time.Time { return now }
+
+	// unknown nodes are considered healthy
+	require.Equal(t, 1.0, s.score(1))
+	require.Equal(t, time.Second, s.hedgeDelay(1, time.Second))
+
+	s.recordResponse(1, 10, true)
+	s.recordResponse(2, 1000, true)
+	s.recordResponse(3, 10, false)
+
+	require.Greater(t, s.score(1), s.score(2))
+	require.Greater(t, s.score(2), s.score(3))
+	require.Equal(t, []rmntypes.NodeID{4, 1, 2, 3}, s.rank([]rmntypes.NodeID{3, 2, 1, 4}))
+
+	t.Run("hedge delay", func(t *testing.T) {
+		require.Equal(t, minHedgeDelay, s.hedgeDelay(1, time.Second))
+		require.Equal(t, time.Second, s.hedgeDelay(2, time.Second))
+		require.Equal(t, 3*time.Second, s.hedgeDelay(2, time.Minute))
+	})
+
+	t.Run("pending requests only make the node slower", func(t *testing.T) {
+		scoreBefore := s.score(1)
+		s.recordPending(1, 5)
+		require.Equal(t, scoreBefore, s.score(1))
+		s.recordPending(1, 2000)
+		require.Less(t, s.score(1), scoreBefore)
+	})
+
+	t.Run("failed sends only affect the reliability", func(t *testing.T) {
+		s.recordFailure(5)
+		require.Less(t, s.score(5), 1.0)
+		require.Equal(t, time.Second, s.hedgeDelay(5, time.Second))
+
+		s.recordResponse(5, 100, true)
+		require.Equal(t, 100.0, s.stats[5].latencyMs)
+		require.Equal(t, 300*time.Millisecond, s.hedgeDelay(5, time.Second))
+
+		latencyBefore := s.stats[1].latencyMs
+		reliabilityBefore := s.stats[1].reliability
+		s.recordFailure(1)
+		require.Equal(t, latencyBefore, s.stats[1].latencyMs)
+		require.Less(t, s.stats[1].reliability, reliabilityBefore)
+	})
+
+	t.Run("stats decay back to neutral", func(t *testing.T) {
+		scoreBefore := s.score(3)
+		now = now.Add(scoreHalfLife)
+		require.Greater(t, s.score(3), scoreBefore)
+		now = now.Add(100 * scoreHalfLife)
+		require.InDelta(t, 1.0, s.score(3), 0.01)
+	})
+}
@@ -0,0 +1,638 @@
+// Package rmnsim provides an in-process simulator of an RMN network.
+//
+// The simulator implements the rmn.PeerClient interface and serves the rmnpb request/response protocol
+// (observation requests and report signature requests) using local keys. It allows exercising the RMN
+// controller end-to-end on a single machine, without ragep2p streams or real RMN nodes.
+// Node faults such as latency, malformed responses and equivocation can be injected per node.
+package rmnsim
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/protobuf/proto"
+
+	ragep2ptypes "github.com/smartcontractkit/libocr/ragep2p/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	rmnpb "github.com/smartcontractkit/chainlink-protos/rmn/v1.6/go/serialization"
+
+	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn"
+	rmntypes "github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn/types"
+	"github.com/smartcontractkit/chainlink-ccip/internal/libs/slicelib"
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon/consensus"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// DefaultSignObservationPrefix is the prefix the simulated nodes use to sign their observations.
+const DefaultSignObservationPrefix = "chainlink ccip 1.6 rmn observation"
+
+// NodeBehavior describes how a simulated RMN node responds to the requests it receives.
+// The zero value is an honest node that responds immediately.
+type NodeBehavior struct {
+	// Latency delays every response of the node.
+	Latency time.Duration
+	// Offline nodes accept requests but never respond.
+	Offline bool
+	// Malformed nodes respond with payloads that cannot be decoded as an rmnpb.Response.
+	Malformed bool
+	// Equivocating nodes observe merkle roots that differ from the ones of the honest nodes
+	// and sign reports containing their own roots.
+	Equivocate bool
+}
+
+// RootFunc returns the merkle root an honest node observes for the given source chain and interval.
+type RootFunc func(sourceChain cciptypes.ChainSelector, interval cciptypes.SeqNumRange) cciptypes.Bytes32
+
+// ReportHasher returns the digest the simulated nodes sign for an RMN report.
+// The digest must match the one used by the RMNCrypto implementation verifying the signatures.
+type ReportHasher func(report cciptypes.RMNReport) (cciptypes.Bytes32, error)
+
+// Config is the configuration of the simulated RMN network.
+type Config struct {
+	// NumNodes is the number of simulated RMN nodes, node IDs start from 1.
+	NumNodes int
+	// SourceChains are the source chains supported by every node.
+	SourceChains []cciptypes.ChainSelector
+	// FObserve is the max number of faulty nodes per source chain on the RMN home side.
+	FObserve int
+	// FSign is the max number of faulty signers on the RMN remote side.
+	FSign uint64
+	// ConfigDigest is the RMN home config digest the nodes are configured with.
+	ConfigDigest cciptypes.Bytes32
+	// RMNRemoteAddress is the address of the RMN remote contract on the destination chain.
+	RMNRemoteAddress cciptypes.UnknownAddress
+	// ReportVersion is the RMN report version digest.
+	ReportVersion cciptypes.Bytes32
+}
+
+// Validate checks that the simulator config is valid.
+func (c Config) Validate() error {
+	if c.NumNodes <= 0 {
+		return errors.New("at least one node is required")
+	}
+	if len(c.SourceChains) == 0 {
+		return errors.New("at least one source chain is required")
+	}
+	if c.NumNodes < 2*c.FObserve+1 {
+		return fmt.Errorf("not enough nodes %d for FObserve %d", c.NumNodes, c.FObserve)
+	}
+	if uint64(c.NumNodes) < c.FSign+1 {
+		return fmt.Errorf("not enough nodes %d for FSign %d", c.NumNodes, c.FSign)
+	}
+	return nil
+}
+
+// Option configures the simulator.
+type Option func(s *Simulator)
+
+// WithNodeBehavior sets the behavior of the node with the provided ID.
+func WithNodeBehavior(nodeID rmntypes.NodeID, behavior NodeBehavior) Option {
+	return func(s *Simulator) {
+		s.behaviors[nodeID] = behavior
+	}
+}
+
+// WithRootFunc sets the function computing the merkle roots observed by the honest nodes.
+func WithRootFunc(rootFunc RootFunc) Option {
+	return func(s *Simulator) {
+		s.rootFunc = rootFunc
+	}
+}
+
+// WithReportHasher sets the function computing the report digests signed by the nodes.
+func WithReportHasher(hasher ReportHasher) Option {
+	return func(s *Simulator) {
+		s.reportHasher = hasher
+	}
+}
+
+// WithSignObservationPrefix sets the prefix used by the nodes to sign their observations.
+func WithSignObservationPrefix(prefix string) Option {
+	return func(s *Simulator) {
+		s.signObservationPrefix = prefix
+	}
+}
+
+type simNode struct {
+	id          rmntypes.NodeID
+	offchainKey ed25519.PrivateKey
+	onchainKey  *ecdsa.PrivateKey
+}
+
+func (n simNode) offchainPublicKey() ed25519.PublicKey {
+	return n.offchainKey.Public().(ed25519.PublicKey)
+}
+
+func (n simNode) onchainAddress() cciptypes.UnknownAddress {
+	return crypto.PubkeyToAddress(n.onchainKey.PublicKey).Bytes()
+}
+
+// Simulator is an in-process RMN network implementing rmn.PeerClient.
+type Simulator struct {
+	lggr                  logger.Logger
+	cfg                   Config
+	nodes                 map[rmntypes.NodeID]simNode
+	behaviors             map[rmntypes.NodeID]NodeBehavior
+	rootFunc              RootFunc
+	reportHasher          ReportHasher
+	signObservationPrefix string
+
+	respChan  chan rmn.PeerResponse
+	stopChan  chan struct{}
+	connected bool
+	wg        sync.WaitGroup
+	mu        sync.RWMutex
+}
+
+var _ rmn.PeerClient = (*Simulator)(nil)
+
+// NewSimulator creates a new simulated RMN network. Node keys are derived deterministically from the node IDs.
+func NewSimulator(lggr logger.Logger, cfg Config, opts ...Option) (*Simulator, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid simulator config: %w", err)
+	}
+
+	s := &Simulator{
+		lggr:                  lggr,
+		cfg:                   cfg,
+		nodes:                 make(map[rmntypes.NodeID]simNode, cfg.NumNodes),
+		behaviors:             make(map[rmntypes.NodeID]NodeBehavior),
+		rootFunc:              DefaultRootFunc,
+		reportHasher:          DefaultReportHasher,
+		signObservationPrefix: DefaultSignObservationPrefix,
+		respChan:              make(chan rmn.PeerResponse, 100),
+		stopChan:              make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	for i := 1; i <= cfg.NumNodes; i++ {
+		nodeID := rmntypes.NodeID(i)
+		seed := sha256.Sum256(binary.BigEndian.AppendUint64([]byte("rmnsim node "), uint64(nodeID)))
+		onchainKey, err := crypto.ToECDSA(seed[:])
+		if err != nil {
+			return nil, fmt.Errorf("derive onchain key of node %d: %w", nodeID, err)
+		}
+		s.nodes[nodeID] = simNode{
+			id:          nodeID,
+			offchainKey: ed25519.NewKeyFromSeed(seed[:]),
+			onchainKey:  onchainKey,
+		}
+	}
+	return s, nil
+}
+
+// HomeNodes returns the RMN home configuration of the simulated nodes.
+func (s *Simulator) HomeNodes() []rmntypes.HomeNodeInfo {
+	nodes := make([]rmntypes.HomeNodeInfo, 0, len(s.nodes))
+	for _, n := range s.sortedNodes() {
+		pubKey := n.offchainPublicKey()
+		var peerID ragep2ptypes.PeerID
+		copy(peerID[:], pubKey)
+		nodes = append(nodes, rmntypes.HomeNodeInfo{
+			ID:                    n.id,
+			PeerID:                peerID,
+			SupportedSourceChains: mapset.NewSet(s.cfg.SourceChains...),
+			OffchainPublicKey:     &pubKey,
+		})
+	}
+	return nodes
+}
+
+// FObserve returns the RMN home F of every source chain.
+func (s *Simulator) FObserve() map[cciptypes.ChainSelector]int {
+	fObserve := make(map[cciptypes.ChainSelector]int, len(s.cfg.SourceChains))
+	for _, chain := range s.cfg.SourceChains {
+		fObserve[chain] = s.cfg.FObserve
+	}
+	return fObserve
+}
+
+// RemoteConfig returns the RMN remote configuration, every simulated node is a signer.
+func (s *Simulator) RemoteConfig() cciptypes.RemoteConfig {
+	signers := make([]cciptypes.RemoteSignerInfo, 0, len(s.nodes))
+	for _, n := range s.sortedNodes() {
+		signers = append(signers, cciptypes.RemoteSignerInfo{
+			OnchainPublicKey: n.onchainAddress(),
+			NodeIndex:        uint64(n.id),
+		})
+	}
+	return cciptypes.RemoteConfig{
+		ContractAddress:  s.cfg.RMNRemoteAddress,
+		ConfigDigest:     s.cfg.ConfigDigest,
+		Signers:          signers,
+		FSign:            s.cfg.FSign,
+		RmnReportVersion: s.cfg.ReportVersion,
+	}
+}
+
+// SignObservationPrefix returns the prefix used by the nodes to sign their observations.
+func (s *Simulator) SignObservationPrefix() string {
+	return s.signObservationPrefix
+}
+
+// Crypto returns an RMNCrypto implementation verifying the report signatures of the simulated nodes.
+func (s *Simulator) Crypto() cciptypes.RMNCrypto {
+	return reportVerifier{reportHasher: s.reportHasher}
+}
+
+// InitConnection implements rmn.PeerClient.
+func (s *Simulator) InitConnection(
+	_ context.Context,
+	_ cciptypes.Bytes32,
+	rmnHomeConfigDigest cciptypes.Bytes32,
+	_ []ragep2ptypes.PeerID,
+	_ []rmntypes.HomeNodeInfo,
+) error {
+	if err := s.Close(); err != nil {
+		return fmt.Errorf("close existing connection: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if rmnHomeConfigDigest != s.cfg.ConfigDigest {
+		s.lggr.Warnw("simulated RMN nodes are configured with a different rmn home config digest",
+			"requested", rmnHomeConfigDigest, "configured", s.cfg.ConfigDigest)
+	}
+	s.stopChan = make(chan struct{})
+	s.connected = true
+	return nil
+}
+
+// Close implements rmn.PeerClient, it waits for the pending responses to be dropped.
+func (s *Simulator) Close() error {
+	s.mu.Lock()
+	if s.connected {
+		close(s.stopChan)
+		s.connected = false
+	}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+	return nil
+}
+
+// Send implements rmn.PeerClient, the request is handled asynchronously by the target node.
+func (s *Simulator) Send(rmnNode rmntypes.HomeNodeInfo, request []byte) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if !s.connected {
+		return rmn.ErrNoConn
+	}
+
+	node, exists := s.nodes[rmnNode.ID]
+	if !exists {
+		return fmt.Errorf("rmn node %d not found", rmnNode.ID)
+	}
+
+	req := &rmnpb.Request{}
+	if err := proto.Unmarshal(request, req); err != nil {
+		return fmt.Errorf("proto unmarshal RMN request: %w", err)
+	}
+
+	behavior := s.behaviors[node.id]
+	if behavior.Offline {
+		s.lggr.Debugw("offline simulated RMN node drops the request", "node", node.id, "requestID", req.RequestId)
+		return nil
+	}
+
+	stopChan := s.stopChan
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.respond(node, behavior, req, stopChan)
+	}()
+	return nil
+}
+
+// Recv implements rmn.PeerClient.
+func (s *Simulator) Recv() <-chan rmn.PeerResponse {
+	return s.respChan
+}
+
+func (s *Simulator) respond(node simNode, behavior NodeBehavior, req *rmnpb.Request, stopChan <-chan struct{}) {
+	if behavior.Latency > 0 {
+		timer := time.NewTimer(behavior.Latency)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-stopChan:
+			return
+		}
+	}
+
+	body, err := s.handleRequest(node, behavior, req)
+	if err != nil {
+		s.lggr.Warnw("simulated RMN node failed to handle the request",
+			"node", node.id, "requestID", req.RequestId, "err", err)
+		return
+	}
+
+	select {
+	case s.respChan <- rmn.PeerResponse{RMNNodeID: node.id, Body: body}:
+	case <-stopChan:
+	}
+}
+
+func (s *Simulator) handleRequest(node simNode, behavior NodeBehavior, req *rmnpb.Request) ([]byte, error) {
+	if behavior.Malformed {
+		return []byte{0xff, 0xff, 0xff, 0xff}, nil
+	}
+
+	resp := &rmnpb.Response{RequestId: req.RequestId}
+	switch {
+	case req.GetObservationRequest() != nil:
+		signedObs, err := s.observe(node, behavior, req.GetObservationRequest())
+		if err != nil {
+			return nil, fmt.Errorf("observe: %w", err)
+		}
+		resp.Response = &rmnpb.Response_SignedObservation{SignedObservation: signedObs}
+	case req.GetReportSignatureRequest() != nil:
+		reportSig, err := s.signReport(node, behavior, req.GetReportSignatureRequest())
+		if err != nil {
+			return nil, fmt.Errorf("sign report: %w", err)
+		}
+		resp.Response = &rmnpb.Response_ReportSignature{ReportSignature: reportSig}
+	default:
+		return nil, fmt.Errorf("unexpected request type %T", req.Request)
+	}
+
+	return proto.Marshal(resp)
+}
+
+// observe computes and signs the merkle roots of the requested intervals.
+func (s *Simulator) observe(
+	node simNode,
+	behavior NodeBehavior,
+	obsReq *rmnpb.ObservationRequest,
+) (*rmnpb.SignedObservation, error) {
+	supportedChains := mapset.NewSet(s.cfg.SourceChains...)
+
+	laneUpdates := make([]*rmnpb.FixedDestLaneUpdate, 0, len(obsReq.FixedDestLaneUpdateRequests))
+	for _, updateReq := range obsReq.FixedDestLaneUpdateRequests {
+		sourceChain := cciptypes.ChainSelector(updateReq.LaneSource.SourceChainSelector)
+		if !supportedChains.Contains(sourceChain) {
+			return nil, fmt.Errorf("source chain %d is not supported", sourceChain)
+		}
+		root := s.root(node, behavior, sourceChain, updateReq.ClosedInterval)
+		laneUpdates = append(laneUpdates, &rmnpb.FixedDestLaneUpdate{
+			LaneSource:     updateReq.LaneSource,
+			ClosedInterval: updateReq.ClosedInterval,
+			Root:           root[:],
+		})
+	}
+
+	obs := &rmnpb.Observation{
+		RmnHomeContractConfigDigest: s.cfg.ConfigDigest[:],
+		LaneDest:                    obsReq.LaneDest,
+		FixedDestLaneUpdates:        laneUpdates,
+		Timestamp:                   uint64(time.Now().UnixMilli()),
+	}
+
+	msg, err := observationSigningMessage(s.signObservationPrefix, obs)
+	if err != nil {
+		return nil, err
+	}
+	return &rmnpb.SignedObservation{
+		Observation: obs,
+		Signature:   ed25519.Sign(node.offchainKey, msg),
+	}, nil
+}
+
+// signReport builds the RMN report out of the attributed signed observations and signs it.
+// Like a real RMN node, only the roots observed by at least F+1 nodes with valid signatures are accepted.
+// An equivocating node signs a report containing its own roots instead.
+func (s *Simulator) signReport(
+	node simNode,
+	behavior NodeBehavior,
+	sigReq *rmnpb.ReportSignatureRequest,
+) (*rmnpb.ReportSignature, error) {
+	reportCtx := sigReq.GetContext()
+	if reportCtx == nil || reportCtx.LaneDest == nil {
+		return nil, errors.New("missing report context")
+	}
+	if len(reportCtx.RmnHomeContractConfigDigest) != len(s.cfg.ConfigDigest) ||
+		cciptypes.Bytes32(reportCtx.RmnHomeContractConfigDigest) != s.cfg.ConfigDigest {
+		return nil, fmt.Errorf("unexpected rmn home config digest %x", reportCtx.RmnHomeContractConfigDigest)
+	}
+
+	laneUpdates, err := s.selectLaneUpdates(sigReq.AttributedSignedObservations)
+	if err != nil {
+		return nil, err
+	}
+	if behavior.Equivocate {
+		for i := range laneUpdates {
+			laneUpdates[i].MerkleRoot = s.root(node, behavior, laneUpdates[i].SourceChainSelector,
+				&rmnpb.ClosedInterval{
+					MinMsgNr: uint64(laneUpdates[i].MinSeqNr),
+					MaxMsgNr: uint64(laneUpdates[i].MaxSeqNr),
+				})
+		}
+	}
+
+	report := cciptypes.NewRMNReport(
+		s.cfg.ReportVersion,
+		cciptypes.NewBigIntFromInt64(int64(reportCtx.EvmDestChainId)),
+		cciptypes.ChainSelector(reportCtx.LaneDest.DestChainSelector),
+		reportCtx.RmnRemoteContractAddress,
+		reportCtx.LaneDest.OfframpAddress,
+		cciptypes.Bytes32(reportCtx.RmnHomeContractConfigDigest),
+		laneUpdates,
+	)
+	digest, err := s.reportHasher(report)
+	if err != nil {
+		return nil, fmt.Errorf("hash report: %w", err)
+	}
+
+	sig, err := crypto.Sign(digest[:], node.onchainKey)
+	if err != nil {
+		return nil, fmt.Errorf("ecdsa sign report: %w", err)
+	}
+	return &rmnpb.ReportSignature{
+		Signature: &rmnpb.EcdsaSignature{R: sig[:32], S: sig[32:64]},
+	}, nil
+}
+
+// selectLaneUpdates returns the lane updates of the roots observed by at least F+1 nodes, ordered by source chain.
+// OnRamp addresses are abi encoded back to 32 bytes, since the observations carry 20 bytes evm addresses.
+func (s *Simulator) selectLaneUpdates(
+	attributedObs []*rmnpb.AttributedSignedObservation,
+) ([]cciptypes.RMNLaneUpdate, error) {
+	type laneKey struct {
+		chain    cciptypes.ChainSelector
+		interval cciptypes.SeqNumRange
+		root     cciptypes.Bytes32
+	}
+	votes := make(map[laneKey]mapset.Set[rmntypes.NodeID])
+	onRamps := make(map[cciptypes.ChainSelector]cciptypes.UnknownAddress)
+
+	for _, ao := range attributedObs {
+		signer, exists := s.nodes[rmntypes.NodeID(ao.SignerNodeIndex)]
+		if !exists {
+			return nil, fmt.Errorf("unknown observation signer %d", ao.SignerNodeIndex)
+		}
+		msg, err := observationSigningMessage(s.signObservationPrefix, ao.SignedObservation.GetObservation())
+		if err != nil {
+			return nil, err
+		}
+		if !ed25519.Verify(signer.offchainPublicKey(), msg, ao.SignedObservation.GetSignature()) {
+			return nil, fmt.Errorf("invalid observation signature of node %d", ao.SignerNodeIndex)
+		}
+
+		for _, lu := range ao.SignedObservation.Observation.FixedDestLaneUpdates {
+			if len(lu.Root) != len(cciptypes.Bytes32{}) {
+				return nil, fmt.Errorf("invalid root observed by node %d: %x", ao.SignerNodeIndex, lu.Root)
+			}
+			k := laneKey{
+				chain: cciptypes.ChainSelector(lu.LaneSource.SourceChainSelector),
+				interval: cciptypes.NewSeqNumRange(
+					cciptypes.SeqNum(lu.ClosedInterval.MinMsgNr), cciptypes.SeqNum(lu.ClosedInterval.MaxMsgNr)),
+				root: cciptypes.Bytes32(lu.Root),
+			}
+			if _, ok := votes[k]; !ok {
+				votes[k] = mapset.NewSet[rmntypes.NodeID]()
+			}
+			votes[k].Add(signer.id)
+			onRamps[k.chain] = slicelib.LeftPadBytes(lu.LaneSource.OnrampAddress, 32)
+		}
+	}
+
+	selected := make(map[cciptypes.ChainSelector]laneKey)
+	for k, voters := range votes {
+		if consensus.LtFPlusOne(s.cfg.FObserve, voters.Cardinality()) {
+			continue
+		}
+		if _, exists := selected[k.chain]; exists {
+			return nil, fmt.Errorf("more than one valid root for chain %d", k.chain)
+		}
+		selected[k.chain] = k
+	}
+	if len(selected) == 0 {
+		return nil, errors.New("no root observed by enough nodes")
+	}
+
+	laneUpdates := make([]cciptypes.RMNLaneUpdate, 0, len(selected))
+	for chain, k := range selected {
+		laneUpdates = append(laneUpdates, cciptypes.RMNLaneUpdate{
+			SourceChainSelector: chain,
+			OnRampAddress:       onRamps[chain],
+			MinSeqNr:            k.interval.Start(),
+			MaxSeqNr:            k.interval.End(),
+			MerkleRoot:          k.root,
+		})
+	}
+	sort.Slice(laneUpdates, func(i, j int) bool {
+		return laneUpdates[i].SourceChainSelector < laneUpdates[j].SourceChainSelector
+	})
+	return laneUpdates, nil
+}
+
+func (s *Simulator) root(
+	node simNode,
+	behavior NodeBehavior,
+	sourceChain cciptypes.ChainSelector,
+	interval *rmnpb.ClosedInterval,
+) cciptypes.Bytes32 {
+	root := s.rootFunc(sourceChain,
+		cciptypes.NewSeqNumRange(cciptypes.SeqNum(interval.MinMsgNr), cciptypes.SeqNum(interval.MaxMsgNr)))
+	if behavior.Equivocate {
+		root = sha256.Sum256(binary.BigEndian.AppendUint64(root[:], uint64(node.id)))
+	}
+	return root
+}
+
+func (s *Simulator) sortedNodes() []simNode {
+	nodes := make([]simNode, 0, len(s.nodes))
+	for _, n := range s.nodes {
+		nodes = append(nodes, n)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].id < nodes[j].id })
+	return nodes
+}
+
+// observationSigningMessage returns the message signed by the RMN nodes for an observation,
+// i.e. sha256(prefix|sha256(observation)).
+func observationSigningMessage(prefix string, obs *rmnpb.Observation) ([]byte, error) {
+	observationBytes, err := proto.Marshal(obs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal observation: %w", err)
+	}
+	observationBytesSha256 := sha256.Sum256(observationBytes)
+	msg := sha256.Sum256(append([]byte(prefix), observationBytesSha256[:]...))
+	return msg[:], nil
+}
+
+// DefaultRootFunc derives a deterministic root from the source chain and the interval.
+func DefaultRootFunc(sourceChain cciptypes.ChainSelector, interval cciptypes.SeqNumRange) cciptypes.Bytes32 {
+	return sha256.Sum256([]byte(fmt.Sprintf("%d%s", sourceChain, interval)))
+}
+
+// DefaultReportHasher hashes the json encoding of the report with keccak256.
+// It is not the onchain encoding of the report, which is chain specific.
+func DefaultReportHasher(report cciptypes.RMNReport) (cciptypes.Bytes32, error) {
+	b, err := json.Marshal(report)
+	if err != nil {
+		return cciptypes.Bytes32{}, fmt.Errorf("json marshal report: %w", err)
+	}
+	return cciptypes.Bytes32(crypto.Keccak256Hash(b)), nil
+}
+
+// reportVerifier verifies the report signatures of the simulated nodes by recovering the signer addresses.
+type reportVerifier struct {
+	reportHasher ReportHasher
+}
+
+func (v reportVerifier) VerifyReportSignatures(
+	_ context.Context,
+	sigs []cciptypes.RMNECDSASignature,
+	report cciptypes.RMNReport,
+	signerAddresses []cciptypes.UnknownAddress,
+) error {
+	digest, err := v.reportHasher(report)
+	if err != nil {
+		return fmt.Errorf("hash report: %w", err)
+	}
+
+	signers := mapset.NewSet[string]()
+	for _, addr := range signerAddresses {
+		signers.Add(addr.String())
+	}
+
+	for _, sig := range sigs {
+		if !recoversToSigner(digest, sig, signers) {
+			return fmt.Errorf("no matching signer found for signature %v", sig)
+		}
+	}
+	return nil
+}
+
+// recoversToSigner checks whether the signature recovers to one of the signers with any recovery id,
+// since the recovery id is not part of RMNECDSASignature.
+func recoversToSigner(digest cciptypes.Bytes32, sig cciptypes.RMNECDSASignature, signers mapset.Set[string]) bool {
+	for _, v := range []byte{0, 1} {
+		rawSig := append(append(append(make([]byte, 0, 65), sig.R[:]...), sig.S[:]...), v)
+		pubKey, err := crypto.SigToPub(digest[:], rawSig)
+		if err != nil {
+			continue
+		}
+		addr := cciptypes.UnknownAddress(crypto.PubkeyToAddress(*pubKey).Bytes())
+		if signers.Contains(addr.String()) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,250 @@
+package rmnsim
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	rmnpb "github.com/smartcontractkit/chainlink-protos/rmn/v1.6/go/serialization"
+
+	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn"
+	rmntypes "github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn/types"
+	"github.com/smartcontractkit/chainlink-ccip/internal/libs/slicelib"
+	readerpkg_mock "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+var (
+	sourceChain1 = cciptypes.ChainSelector(chainsel.TEST_90000002.Selector)
+	sourceChain2 = cciptypes.ChainSelector(chainsel.TEST_90000003.Selector)
+	destChain    = cciptypes.ChainSelector(chainsel.TEST_90000004.Selector)
+
+	configDigest = cciptypes.Bytes32{0x1, 0x2, 0x3}
+)
+
+func testConfig() Config {
+	return Config{
+		NumNodes:         4,
+		SourceChains:     []cciptypes.ChainSelector{sourceChain1, sourceChain2},
+		FObserve:         1,
+		FSign:            1,
+		ConfigDigest:     configDigest,
+		RMNRemoteAddress: []byte{0xa, 0xb, 0xc},
+		ReportVersion:    cciptypes.Bytes32{0x9},
+	}
+}
+
+func TestSimulator_ComputeReportSignatures(t *testing.T) {
+	updateRequests := []*rmnpb.FixedDestLaneUpdateRequest{
+		{
+			LaneSource: &rmnpb.LaneSource{
+				SourceChainSelector: uint64(sourceChain1),
+				OnrampAddress:       slicelib.LeftPadBytes([]byte{0x1, 0x1}, 32),
+			},
+			ClosedInterval: &rmnpb.ClosedInterval{MinMsgNr: 10, MaxMsgNr: 20},
+		},
+		{
+			LaneSource: &rmnpb.LaneSource{
+				SourceChainSelector: uint64(sourceChain2),
+				OnrampAddress:       slicelib.LeftPadBytes([]byte{0x2, 0x2}, 32),
+			},
+			ClosedInterval: &rmnpb.ClosedInterval{MinMsgNr: 100, MaxMsgNr: 110},
+		},
+	}
+	laneDest := &rmnpb.LaneDest{
+		DestChainSelector: uint64(destChain),
+		OfframpAddress:    []byte{0xd, 0xe, 0xf},
+	}
+
+	testCases := []struct {
+		name      string
+		behaviors map[rmntypes.NodeID]NodeBehavior
+		expErr    error
+	}{
+		{
+			name: "honest nodes",
+		},
+		{
+			name: "slow nodes",
+			behaviors: map[rmntypes.NodeID]NodeBehavior{
+				1: {Latency: 20 * time.Millisecond},
+				3: {Latency: 50 * time.Millisecond},
+			},
+		},
+		{
+			name:      "f offline nodes",
+			behaviors: map[rmntypes.NodeID]NodeBehavior{2: {Offline: true}},
+		},
+		{
+			name:      "f malformed nodes",
+			behaviors: map[rmntypes.NodeID]NodeBehavior{4: {Malformed: true}},
+		},
+		{
+			name:      "f equivocating nodes",
+			behaviors: map[rmntypes.NodeID]NodeBehavior{1: {Equivocate: true}},
+		},
+		{
+			name: "more than f offline nodes",
+			behaviors: map[rmntypes.NodeID]NodeBehavior{
+				1: {Offline: true},
+				2: {Offline: true},
+				3: {Offline: true},
+			},
+			expErr: rmn.ErrTimeout,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lggr := logger.Test(t)
+			ctx, cancel := context.WithTimeout(tests.Context(t), 5*time.Second)
+			defer cancel()
+
+			opts := make([]Option, 0, len(tc.behaviors))
+			for nodeID, behavior := range tc.behaviors {
+				opts = append(opts, WithNodeBehavior(nodeID, behavior))
+			}
+			sim, err := NewSimulator(lggr, testConfig(), opts...)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, sim.Close()) }()
+
+			rmnHomeMock := readerpkg_mock.NewMockRMNHome(t)
+			rmnHomeMock.On("GetRMNNodesInfo", configDigest).Return(sim.HomeNodes(), nil)
+			rmnHomeMock.On("GetFObserve", configDigest).Return(sim.FObserve(), nil)
+
+			controller := rmn.NewController(
+				lggr,
+				sim.Crypto(),
+				sim.SignObservationPrefix(),
+				sim,
+				rmnHomeMock,
+				10*time.Millisecond,
+				10*time.Millisecond,
+				rmn.NoopMetrics{},
+			)
+			require.NoError(t, controller.InitConnection(ctx, cciptypes.Bytes32{}, configDigest, nil, nil))
+
+			if tc.expErr != nil {
+				ctx, cancel = context.WithTimeout(ctx, 200*time.Millisecond)
+				defer cancel()
+			}
+
+			sigs, err := controller.ComputeReportSignatures(ctx, laneDest, updateRequests, sim.RemoteConfig())
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, sigs.Signatures, int(testConfig().FSign)+1)
+			require.Len(t, sigs.LaneUpdates, len(updateRequests))
+			expRoots := make(map[uint64][]byte, len(updateRequests))
+			for _, req := range updateRequests {
+				root := DefaultRootFunc(cciptypes.ChainSelector(req.LaneSource.SourceChainSelector),
+					cciptypes.NewSeqNumRange(
+						cciptypes.SeqNum(req.ClosedInterval.MinMsgNr), cciptypes.SeqNum(req.ClosedInterval.MaxMsgNr)))
+				expRoots[req.LaneSource.SourceChainSelector] = root[:]
+			}
+			for _, lu := range sigs.LaneUpdates {
+				require.Equal(t, expRoots[lu.LaneSource.SourceChainSelector], lu.Root)
+			}
+		})
+	}
+}
+
+func TestSimulator_SlowNodeIsDeprioritized(t *testing.T) {
+	lggr := logger.Test(t)
+	ctx := tests.Context(t)
+	const slowNodeLatency = 300 * time.Millisecond
+
+	sim, err := NewSimulator(lggr, testConfig(), WithNodeBehavior(2, NodeBehavior{Latency: slowNodeLatency}))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, sim.Close()) }()
+
+	rmnHomeMock := readerpkg_mock.NewMockRMNHome(t)
+	rmnHomeMock.On("GetRMNNodesInfo", configDigest).Return(sim.HomeNodes(), nil)
+	rmnHomeMock.On("GetFObserve", configDigest).Return(sim.FObserve(), nil)
+
+	// the initial request timers never expire, only the node scores keep the slow node away
+	controller := rmn.NewController(
+		lggr, sim.Crypto(), sim.SignObservationPrefix(), sim, rmnHomeMock, time.Minute, time.Minute, rmn.NoopMetrics{})
+	require.NoError(t, controller.InitConnection(ctx, cciptypes.Bytes32{}, configDigest, nil, nil))
+
+	updateRequests := []*rmnpb.FixedDestLaneUpdateRequest{
+		{
+			LaneSource: &rmnpb.LaneSource{
+				SourceChainSelector: uint64(sourceChain1),
+				OnrampAddress:       slicelib.LeftPadBytes([]byte{0x1, 0x1}, 32),
+			},
+			ClosedInterval: &rmnpb.ClosedInterval{MinMsgNr: 10, MaxMsgNr: 20},
+		},
+	}
+	laneDest := &rmnpb.LaneDest{DestChainSelector: uint64(destChain), OfframpAddress: []byte{0xd, 0xe, 0xf}}
+
+	// every node gets requested at least once while the scores are built
+	for i := 0; i < 4; i++ {
+		_, err := controller.ComputeReportSignatures(ctx, laneDest, updateRequests, sim.RemoteConfig())
+		require.NoError(t, err)
+	}
+
+	for i := 0; i < 3; i++ {
+		start := time.Now()
+		_, err := controller.ComputeReportSignatures(ctx, laneDest, updateRequests, sim.RemoteConfig())
+		require.NoError(t, err)
+		require.Less(t, time.Since(start), slowNodeLatency)
+	}
+}
+
+func TestSimulator_Send(t *testing.T) {
+	lggr := logger.Test(t)
+	sim, err := NewSimulator(lggr, testConfig())
+	require.NoError(t, err)
+	node := sim.HomeNodes()[0]
+
+	req, err := proto.Marshal(&rmnpb.Request{
+		RequestId: 1,
+		Request: &rmnpb.Request_ObservationRequest{
+			ObservationRequest: &rmnpb.ObservationRequest{
+				LaneDest: &rmnpb.LaneDest{DestChainSelector: uint64(destChain)},
+				FixedDestLaneUpdateRequests: []*rmnpb.FixedDestLaneUpdateRequest{
+					{
+						LaneSource:     &rmnpb.LaneSource{SourceChainSelector: uint64(sourceChain1)},
+						ClosedInterval: &rmnpb.ClosedInterval{MinMsgNr: 1, MaxMsgNr: 2},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	require.ErrorIs(t, sim.Send(node, req), rmn.ErrNoConn)
+
+	require.NoError(t, sim.InitConnection(tests.Context(t), cciptypes.Bytes32{}, configDigest, nil, nil))
+	defer func() { require.NoError(t, sim.Close()) }()
+
+	require.Error(t, sim.Send(rmntypes.HomeNodeInfo{ID: 100}, req))
+	require.NoError(t, sim.Send(node, req))
+
+	resp := <-sim.Recv()
+	require.Equal(t, node.ID, resp.RMNNodeID)
+
+	parsed := &rmnpb.Response{}
+	require.NoError(t, proto.Unmarshal(resp.Body, parsed))
+	require.Equal(t, uint64(1), parsed.RequestId)
+
+	signedObs := parsed.GetSignedObservation()
+	require.NotNil(t, signedObs)
+	require.Equal(t, configDigest[:], signedObs.Observation.RmnHomeContractConfigDigest)
+	require.Len(t, signedObs.Observation.FixedDestLaneUpdates, 1)
+
+	msg, err := observationSigningMessage(sim.SignObservationPrefix(), signedObs.Observation)
+	require.NoError(t, err)
+	require.True(t, rmn.NewED25519Verifier().Verify(*node.OffchainPublicKey, msg, signedObs.Signature))
+}
@@ -34,6 +34,11 @@ type Observation struct {
 	OffRampNextSeqNums []plugintypes.SeqNumChain        `json:"offRampNextSeqNums"`
 	RMNRemoteConfig    cciptypes.RemoteConfig           `json:"rmnRemoteConfig"`
 	FChain             map[cciptypes.ChainSelector]int  `json:"fChain"`
+	// PausedLanes holds the OffRamp next sequence numbers of the source chains that are cursed and therefore
+	// left out of OffRampNextSeqNums.
+	PausedLanes []plugintypes.SeqNumChain `json:"pausedLanes"`
+	// Timestamp is the time of the observation, it's only observed if the adaptive batching is enabled.
+	Timestamp time.Time `json:"timestamp"`
 }
 
 func (o Observation) Stats() map[string]int {
@@ -51,6 +56,8 @@ func (o Observation) IsEmpty() bool {
 	return len(o.MerkleRoots) == 0 &&
 		len(o.OnRampMaxSeqNums) == 0 &&
 		len(o.OffRampNextSeqNums) == 0 &&
+		len(o.PausedLanes) == 0 &&
+		o.Timestamp.IsZero() &&
 		o.RMNRemoteConfig.IsEmpty() &&
 		len(o.FChain) == 0
 }
@@ -74,6 +81,12 @@ type aggregatedObservation struct {
 
 	// A map from chain selectors to the list of f (failure tolerance) observed for each chain
 	FChain map[cciptypes.ChainSelector][]int
+
+	// A map from chain selectors to the list of OffRamp next sequence numbers observed for each paused chain
+	PausedLanes map[cciptypes.ChainSelector][]cciptypes.SeqNum
+
+	// The observed timestamps
+	Timestamps []time.Time
 }
 
 // aggregateObservations takes a list of observations and produces an MerkleAggregatedObservation
@@ -85,6 +98,8 @@ func aggregateObservations(aos []plugincommon.AttributedObservation[Observation]
 		OffRampNextSeqNums: make(map[cciptypes.ChainSelector][]cciptypes.SeqNum),
 		RMNRemoteConfigs:   make([]cciptypes.RemoteConfig, 0),
 		FChain:             make(map[cciptypes.ChainSelector][]int),
+		PausedLanes:        make(map[cciptypes.ChainSelector][]cciptypes.SeqNum),
+		Timestamps:         make([]time.Time, 0),
 	}
 
 	for _, ao := range aos {
@@ -122,6 +137,17 @@ func aggregateObservations(aos []plugincommon.AttributedObservation[Observation]
 			aggObs.FChain[chainSel] = append(aggObs.FChain[chainSel], f)
 		}
 
+		// PausedLanes
+		for _, seqNumChain := range obs.PausedLanes {
+			aggObs.PausedLanes[seqNumChain.ChainSel] =
+				append(aggObs.PausedLanes[seqNumChain.ChainSel], seqNumChain.SeqNum)
+		}
+
+		// Timestamp
+		if !obs.Timestamp.IsZero() {
+			aggObs.Timestamps = append(aggObs.Timestamps, obs.Timestamp)
+		}
+
 	}
 
 	return aggObs
@@ -146,6 +172,12 @@ type consensusObservation struct {
 
 	// A map from chain selectors to each chain's consensus f (failure tolerance)
 	FChain map[cciptypes.ChainSelector]int
+
+	// A map from chain selectors to each paused chain's consensus OffRamp next sequence number
+	PausedLanes map[cciptypes.ChainSelector]cciptypes.SeqNum
+
+	// The consensus timestamp, zero if there is no consensus
+	Timestamp time.Time
 }
 
 type OutcomeType int
@@ -168,6 +200,41 @@ type Outcome struct {
 	ReportTransmissionCheckAttempts uint                             `json:"reportTransmissionCheckAttempts"`
 	RMNReportSignatures             []cciptypes.RMNECDSASignature    `json:"rmnReportSignatures"`
 	RMNRemoteCfg                    cciptypes.RemoteConfig           `json:"rmnRemoteCfg"`
+	// PausedLanes are carried over across all the outcomes until the paused sequence numbers are committed.
+	PausedLanes []PausedLane `json:"pausedLanes"`
+	// PendingBatches are the source chains with messages waiting to be committed by the adaptive batching.
+	// They are carried over across all the outcomes.
+	PendingBatches []PendingBatch `json:"pendingBatches"`
+}
+
+// PendingBatch tracks since when the pending messages of a source chain are waiting to be committed.
+type PendingBatch struct {
+	ChainSel cciptypes.ChainSelector `json:"chainSel"`
+	// FirstSeqNum is the OffRamp next sequence number, i.e. the oldest pending message.
+	FirstSeqNum cciptypes.SeqNum `json:"firstSeqNum"`
+	// Since is the consensus timestamp of the round in which the oldest pending message was first seen.
+	Since time.Time `json:"since"`
+}
+
+// PausedLane tracks the sequence numbers that could not be committed while the lane was paused (cursed).
+// The lane is tracked until all the messages sent while it was paused are committed.
+type PausedLane struct {
+	ChainSel cciptypes.ChainSelector `json:"chainSel"`
+	// FirstSeqNum is the first sequence number of the lane that is not committed yet.
+	FirstSeqNum cciptypes.SeqNum `json:"firstSeqNum"`
+	// LastSeqNum is the highest OnRamp sequence number observed while the lane was paused.
+	// It's lower than FirstSeqNum if no messages were sent while the lane was paused.
+	LastSeqNum cciptypes.SeqNum `json:"lastSeqNum"`
+	// Resumed is true once the lane is not paused anymore and its pending messages are being committed.
+	Resumed bool `json:"resumed"`
+}
+
+// PendingMessages returns the number of messages of the paused range that are not committed yet.
+func (l PausedLane) PendingMessages() uint64 {
+	if l.LastSeqNum < l.FirstSeqNum {
+		return 0
+	}
+	return uint64(l.LastSeqNum-l.FirstSeqNum) + 1
 }
 
 func (o Outcome) Stats() map[string]int {
@@ -182,10 +249,13 @@ func (o Outcome) Stats() map[string]int {
 	return counts
 }
 
-// Sort all fields of the given Outcome
+// Sort all fields of the given Outcome.
+// Ranges of the resumed lanes come first, so that their backfill is prioritized. Roots are always sorted by
+// chain selector, since that's the order in which the RMN signs them and the reports must carry them.
 func (o *Outcome) Sort() {
+	resumed := resumedLanes(o.PausedLanes)
 	sort.Slice(o.RangesSelectedForReport, func(i, j int) bool {
-		return o.RangesSelectedForReport[i].ChainSel < o.RangesSelectedForReport[j].ChainSel
+		return lessPrioritized(resumed, o.RangesSelectedForReport[i].ChainSel, o.RangesSelectedForReport[j].ChainSel)
 	})
 	sort.Slice(o.RootsToReport, func(i, j int) bool {
 		return o.RootsToReport[i].ChainSel < o.RootsToReport[j].ChainSel
@@ -193,6 +263,31 @@ func (o *Outcome) Sort() {
 	sort.Slice(o.OffRampNextSeqNums, func(i, j int) bool {
 		return o.OffRampNextSeqNums[i].ChainSel < o.OffRampNextSeqNums[j].ChainSel
 	})
+	sort.Slice(o.PausedLanes, func(i, j int) bool {
+		return o.PausedLanes[i].ChainSel < o.PausedLanes[j].ChainSel
+	})
+	sort.Slice(o.PendingBatches, func(i, j int) bool {
+		return o.PendingBatches[i].ChainSel < o.PendingBatches[j].ChainSel
+	})
+}
+
+// resumedLanes returns the set of chains whose paused range is being backfilled.
+func resumedLanes(pausedLanes []PausedLane) map[cciptypes.ChainSelector]bool {
+	resumed := make(map[cciptypes.ChainSelector]bool)
+	for _, lane := range pausedLanes {
+		if lane.Resumed {
+			resumed[lane.ChainSel] = true
+		}
+	}
+	return resumed
+}
+
+// lessPrioritized orders the resumed chains first and then by chain selector.
+func lessPrioritized(resumed map[cciptypes.ChainSelector]bool, a, b cciptypes.ChainSelector) bool {
+	if resumed[a] != resumed[b] {
+		return resumed[a]
+	}
+	return a < b
 }
 
 func (o *Outcome) nextState() processorState {
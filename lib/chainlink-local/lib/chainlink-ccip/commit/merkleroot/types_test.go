@@ -2,6 +2,7 @@ package merkleroot
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -143,6 +144,7 @@ func TestObservation_IsEmpty(t *testing.T) {
 }
 
 func TestAggregateObservations(t *testing.T) {
+	ts := time.Unix(1700000000, 0).UTC()
 	tests := []struct {
 		name         string
 		observations []plugincommon.AttributedObservation[Observation]
@@ -158,6 +160,8 @@ func TestAggregateObservations(t *testing.T) {
 				RMNRemoteConfigs:   make([]cciptypes.RemoteConfig, 0),
 				FChain:             make(map[cciptypes.ChainSelector][]int),
 				RMNEnabledChains:   map[cciptypes.ChainSelector][]bool{},
+				PausedLanes:        map[cciptypes.ChainSelector][]cciptypes.SeqNum{},
+				Timestamps:         []time.Time{},
 			},
 		},
 		{
@@ -180,6 +184,8 @@ func TestAggregateObservations(t *testing.T) {
 				RMNRemoteConfigs:   []cciptypes.RemoteConfig{{RmnReportVersion: cciptypes.Bytes32{1}}},
 				FChain:             map[cciptypes.ChainSelector][]int{1: {1}},
 				RMNEnabledChains:   map[cciptypes.ChainSelector][]bool{},
+				PausedLanes:        map[cciptypes.ChainSelector][]cciptypes.SeqNum{},
+				Timestamps:         []time.Time{},
 			},
 		},
 		{
@@ -201,6 +207,8 @@ func TestAggregateObservations(t *testing.T) {
 						OffRampNextSeqNums: []plugintypes.SeqNumChain{{ChainSel: 2, SeqNum: 2}},
 						RMNRemoteConfig:    cciptypes.RemoteConfig{RmnReportVersion: cciptypes.Bytes32{2}},
 						FChain:             map[cciptypes.ChainSelector]int{2: 2},
+						PausedLanes:        []plugintypes.SeqNumChain{{ChainSel: 3, SeqNum: 7}},
+						Timestamp:          ts,
 					},
 				},
 			},
@@ -214,6 +222,8 @@ func TestAggregateObservations(t *testing.T) {
 				},
 				RMNEnabledChains: map[cciptypes.ChainSelector][]bool{},
 				FChain:           map[cciptypes.ChainSelector][]int{1: {1}, 2: {2}},
+				PausedLanes:      map[cciptypes.ChainSelector][]cciptypes.SeqNum{3: {7}},
+				Timestamps:       []time.Time{ts},
 			},
 		},
 	}
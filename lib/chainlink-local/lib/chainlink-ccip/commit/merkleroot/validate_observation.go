@@ -47,6 +47,11 @@ func (p *Processor) ValidateObservation(
 		return fmt.Errorf("validate OffRampNextSeqNums: %w", err)
 	}
 
+	err = validateObservedPausedLanes(obs.PausedLanes, obs.OffRampNextSeqNums, ao.OracleID, supportsDestChain)
+	if err != nil {
+		return fmt.Errorf("validate PausedLanes: %w", err)
+	}
+
 	if err := validateRMNRemoteConfig(ao.OracleID, supportsDestChain, obs.RMNRemoteConfig); err != nil {
 		p.lggr.Errorw("validate RMNRemoteConfig failed", "err", err, "cfg", obs.RMNRemoteConfig)
 		return fmt.Errorf("validate RMNRemoteConfig: %w", err)
@@ -162,6 +167,32 @@ func validateObservedOffRampMaxSeqNums(
 	return nil
 }
 
+// validateObservedPausedLanes checks the paused lanes in the same way as the offRamp next seq nums,
+// a paused chain must not be observed as active at the same time.
+func validateObservedPausedLanes(
+	pausedLanes []plugintypes.SeqNumChain,
+	offRampNextSeqNums []plugintypes.SeqNumChain,
+	observer commontypes.OracleID,
+	supportsDestChain bool,
+) error {
+	if err := validateObservedOffRampMaxSeqNums(pausedLanes, observer, supportsDestChain); err != nil {
+		return err
+	}
+
+	activeChains := mapset.NewSet[cciptypes.ChainSelector]()
+	for _, seqNumChain := range offRampNextSeqNums {
+		activeChains.Add(seqNumChain.ChainSel)
+	}
+
+	for _, seqNumChain := range pausedLanes {
+		if activeChains.Contains(seqNumChain.ChainSel) {
+			return fmt.Errorf("chain %d is observed as both paused and active", seqNumChain.ChainSel)
+		}
+	}
+
+	return nil
+}
+
 func validateRMNRemoteConfig(
 	observer commontypes.OracleID,
 	supportsDestChain bool,
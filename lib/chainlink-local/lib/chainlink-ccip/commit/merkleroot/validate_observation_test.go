@@ -234,6 +234,16 @@ func Test_validateObservedOffRampMaxSeqNums(t *testing.T) {
 	}
 }
 
+func Test_validateObservedPausedLanes(t *testing.T) {
+	pausedLanes := []plugintypes.SeqNumChain{{ChainSel: 1, SeqNum: 10}}
+
+	assert.NoError(t, validateObservedPausedLanes(pausedLanes, []plugintypes.SeqNumChain{{ChainSel: 2, SeqNum: 20}},
+		10, true))
+	assert.Error(t, validateObservedPausedLanes(pausedLanes, nil, 10, false))
+	assert.Error(t, validateObservedPausedLanes(pausedLanes, []plugintypes.SeqNumChain{{ChainSel: 1, SeqNum: 10}},
+		10, true))
+}
+
 func Test_validateRMNRemoteConfig(t *testing.T) {
 	testCases := []struct {
 		name              string
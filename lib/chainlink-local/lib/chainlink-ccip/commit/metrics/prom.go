@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -11,6 +12,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
 	"github.com/smartcontractkit/chainlink-ccip/commit/committypes"
+	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
@@ -61,6 +63,14 @@ var (
 		},
 		[]string{"chainID", "sourceChain", "method"},
 	)
+	promPausedLaneMessages = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ccip_commit_paused_lane_messages",
+			Help: "This metric tracks the number of messages of paused (or resumed but not backfilled yet) lanes " +
+				"that are not committed",
+		},
+		[]string{"chainID", "sourceChain", "state"},
+	)
 	promMerkleProcessorRmnReportLatency = promauto.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name:    "ccip_commit_merkle_processor_rmn_report_latency_ms",
@@ -77,6 +87,22 @@ var (
 		},
 		[]string{"method", "nodeID", "error"},
 	)
+	promContractAddressChanges = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ccip_commit_discovery_contract_address_changes",
+			Help: "This metric tracks the changes of the agreed contract addresses, including the ones refused " +
+				"by the allowlist",
+		},
+		[]string{"chainID", "contract", "contractChain", "kind"},
+	)
+	promRmnControllerRmnNodeScore = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ccip_commit_rmn_controller_rmn_node_score",
+			Help: "This metric tracks the health score (from 0 to 1) of an RMN node computed from its latency and " +
+				"the ratio of valid responses",
+		},
+		[]string{"nodeID"},
+	)
 )
 
 type PromReporter struct {
@@ -85,10 +111,18 @@ type PromReporter struct {
 	// Prometheus components
 	merkleProcessorRmnReportHistogram *prometheus.HistogramVec
 	rmnControllerRmnRequestHistogram  *prometheus.HistogramVec
+	rmnControllerRmnNodeScore         *prometheus.GaugeVec
 	processorLatencyHistogram         *prometheus.HistogramVec
 	processorOutputCounter            *prometheus.CounterVec
 	processorErrors                   *prometheus.CounterVec
 	sequenceNumbers                   *prometheus.GaugeVec
+	pausedLaneMessages                *prometheus.GaugeVec
+	contractAddressChanges            *prometheus.CounterVec
+
+	// pausedLanes holds the state label of the paused lanes reported by the previous outcome,
+	// it's used to remove the lanes that are not paused anymore.
+	pausedLanesMu sync.Mutex
+	pausedLanes   map[string]string
 }
 
 func NewPromReporter(lggr logger.Logger, selector cciptypes.ChainSelector) (*PromReporter, error) {
@@ -103,12 +137,17 @@ func NewPromReporter(lggr logger.Logger, selector cciptypes.ChainSelector) (*Pro
 
 		merkleProcessorRmnReportHistogram: promMerkleProcessorRmnReportLatency,
 		rmnControllerRmnRequestHistogram:  promRmnControllerRmnRequestLatency,
+		rmnControllerRmnNodeScore:         promRmnControllerRmnNodeScore,
 
-		sequenceNumbers: promSequenceNumbers,
+		sequenceNumbers:    promSequenceNumbers,
+		pausedLaneMessages: promPausedLaneMessages,
+		pausedLanes:        make(map[string]string),
 
 		processorLatencyHistogram: promProcessorLatencyHistogram,
 		processorOutputCounter:    promProcessorOutputCounter,
 		processorErrors:           promProcessorErrors,
+
+		contractAddressChanges: promContractAddressChanges,
 	}, nil
 }
 
@@ -128,6 +167,39 @@ func (p *PromReporter) TrackOutcome(outcome committypes.Outcome) {
 
 		p.trackMaxSequenceNumber(sourceChainSelector, maxSeqNr, plugincommon.OutcomeMethod)
 	}
+
+	p.trackPausedLanes(outcome.MerkleRootOutcome.PausedLanes)
+}
+
+func (p *PromReporter) trackPausedLanes(pausedLanes []merkleroot.PausedLane) {
+	p.pausedLanesMu.Lock()
+	defer p.pausedLanesMu.Unlock()
+
+	current := make(map[string]string, len(pausedLanes))
+	for _, lane := range pausedLanes {
+		sourceChain, err := sel.GetChainIDFromSelector(uint64(lane.ChainSel))
+		if err != nil {
+			p.lggr.Errorw("failed to get chain ID from selector", "err", err)
+			continue
+		}
+
+		state := "paused"
+		if lane.Resumed {
+			state = "resumed"
+		}
+		current[sourceChain] = state
+
+		p.pausedLaneMessages.
+			WithLabelValues(p.chainID, sourceChain, state).
+			Set(float64(lane.PendingMessages()))
+	}
+
+	for sourceChain, state := range p.pausedLanes {
+		if current[sourceChain] != state {
+			p.pausedLaneMessages.DeleteLabelValues(p.chainID, sourceChain, state)
+		}
+	}
+	p.pausedLanes = current
 }
 
 func (p *PromReporter) trackMaxSequenceNumber(
@@ -168,6 +240,11 @@ func (p *PromReporter) TrackRmnRequest(method string, latency float64, nodeID ui
 	p.rmnControllerRmnRequestHistogram.WithLabelValues(method, nodeIDStr, err).Observe(latency)
 }
 
+func (p *PromReporter) TrackRmnNodeScore(nodeID uint64, score float64) {
+	nodeIDStr := strconv.FormatUint(nodeID, 10)
+	p.rmnControllerRmnNodeScore.WithLabelValues(nodeIDStr).Set(score)
+}
+
 func (p *PromReporter) TrackProcessorLatency(
 	processor string,
 	method plugincommon.MethodType,
@@ -197,3 +274,15 @@ func (p *PromReporter) TrackProcessorOutput(
 			Add(float64(val))
 	}
 }
+
+func (p *PromReporter) TrackContractAddressChange(contract string, chain cciptypes.ChainSelector, kind string) {
+	contractChain, err := sel.GetChainIDFromSelector(uint64(chain))
+	if err != nil {
+		p.lggr.Errorw("failed to get chain ID from selector", "err", err)
+		return
+	}
+
+	p.contractAddressChanges.
+		WithLabelValues(p.chainID, contract, contractChain, kind).
+		Inc()
+}
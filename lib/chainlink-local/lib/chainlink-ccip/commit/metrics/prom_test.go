@@ -542,6 +542,56 @@ func Test_SequenceNumbers(t *testing.T) {
 	}
 }
 
+func Test_PausedLanes(t *testing.T) {
+	chain1 := "2337"
+	selector1 := cciptypes.ChainSelector(12922642891491394802)
+	chain2 := "3337"
+	selector2 := cciptypes.ChainSelector(4793464827907405086)
+
+	reporter, err := NewPromReporter(logger.Test(t), selector)
+	require.NoError(t, err)
+	t.Cleanup(func() { reporter.pausedLaneMessages.Reset() })
+
+	reporter.TrackOutcome(committypes.Outcome{
+		MerkleRootOutcome: merkleroot.Outcome{
+			PausedLanes: []merkleroot.PausedLane{
+				{ChainSel: selector1, FirstSeqNum: 10, LastSeqNum: 14},
+				{ChainSel: selector2, FirstSeqNum: 5, LastSeqNum: 4},
+			},
+		},
+	})
+	require.Equal(t, 5.0, testutil.ToFloat64(reporter.pausedLaneMessages.WithLabelValues(chainID, chain1, "paused")))
+	require.Equal(t, 0.0, testutil.ToFloat64(reporter.pausedLaneMessages.WithLabelValues(chainID, chain2, "paused")))
+
+	// chain1 is resumed and chain2 was backfilled
+	reporter.TrackOutcome(committypes.Outcome{
+		MerkleRootOutcome: merkleroot.Outcome{
+			PausedLanes: []merkleroot.PausedLane{
+				{ChainSel: selector1, FirstSeqNum: 12, LastSeqNum: 14, Resumed: true},
+			},
+		},
+	})
+	require.Equal(t, 3.0, testutil.ToFloat64(reporter.pausedLaneMessages.WithLabelValues(chainID, chain1, "resumed")))
+	require.Equal(t, 1, testutil.CollectAndCount(reporter.pausedLaneMessages))
+
+	reporter.TrackOutcome(committypes.Outcome{})
+	require.Equal(t, 0, testutil.CollectAndCount(reporter.pausedLaneMessages))
+}
+
+func Test_RmnNodeScores(t *testing.T) {
+	reporter, err := NewPromReporter(logger.Test(t), selector)
+	require.NoError(t, err)
+	t.Cleanup(func() { reporter.rmnControllerRmnNodeScore.Reset() })
+
+	reporter.TrackRmnNodeScore(1, 0.9)
+	reporter.TrackRmnNodeScore(2, 0.5)
+	reporter.TrackRmnNodeScore(1, 0.7)
+
+	require.Equal(t, 2, testutil.CollectAndCount(reporter.rmnControllerRmnNodeScore))
+	require.Equal(t, 0.7, testutil.ToFloat64(reporter.rmnControllerRmnNodeScore.WithLabelValues("1")))
+	require.Equal(t, 0.5, testutil.ToFloat64(reporter.rmnControllerRmnNodeScore.WithLabelValues("2")))
+}
+
 func cleanupMetrics(reporter *PromReporter) func() {
 	return func() {
 		reporter.processorErrors.Reset()
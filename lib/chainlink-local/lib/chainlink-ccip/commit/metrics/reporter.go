@@ -6,7 +6,9 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/commit/committypes"
 	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon/discovery"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
 // Reporter is a simple interface used for tracking observations and outcomes of the commit plugin.
@@ -15,6 +17,7 @@ import (
 // That gives us more flexibility and granularity in tracking the performance of the commit plugin.
 // Processors have a dedicated sub-interfaces covering only the relevant methods for reporting, please see:
 // - merkleroot.MetricsReporter
+// - discovery.MetricsReporter
 // - CommitPluginReporter
 // This split is required to define the reporting logic in one place but inject only relevant dependencies to
 // plugins/processors. Also, it solves the problem of cyclic dependencies between the plugins/processors.
@@ -24,9 +27,12 @@ type Reporter interface {
 
 	TrackRmnReport(latency float64, success bool)
 	TrackRmnRequest(method string, latency float64, nodeID uint64, err string)
+	TrackRmnNodeScore(nodeID uint64, score float64)
 
 	TrackProcessorLatency(processor string, method plugincommon.MethodType, latency time.Duration, err error)
 	TrackProcessorOutput(processor string, method plugincommon.MethodType, obs plugintypes.Trackable)
+
+	TrackContractAddressChange(contract string, chain cciptypes.ChainSelector, kind string)
 }
 
 type CommitPluginReporter interface {
@@ -44,10 +50,15 @@ func (n *Noop) TrackRmnReport(float64, bool) {}
 
 func (n *Noop) TrackRmnRequest(string, float64, uint64, string) {}
 
+func (n *Noop) TrackRmnNodeScore(uint64, float64) {}
+
 func (n *Noop) TrackProcessorLatency(string, plugincommon.MethodType, time.Duration, error) {}
 
 func (n *Noop) TrackProcessorOutput(string, plugincommon.MethodType, plugintypes.Trackable) {}
 
+func (n *Noop) TrackContractAddressChange(string, cciptypes.ChainSelector, string) {}
+
 var _ Reporter = &PromReporter{}
 var _ CommitPluginReporter = &PromReporter{}
 var _ merkleroot.MetricsReporter = &PromReporter{}
+var _ discovery.MetricsReporter = &PromReporter{}
@@ -32,6 +32,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/internal/reader"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
 	ocrtypecodec "github.com/smartcontractkit/chainlink-ccip/pkg/ocrtypecodec/v1"
+	ocrtypecodecv2 "github.com/smartcontractkit/chainlink-ccip/pkg/ocrtypecodec/v2"
 	readerpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
@@ -151,6 +152,7 @@ func NewPlugin(
 		reportingCfg.F,
 		oracleIDToP2pID,
 		reporter,
+		discovery.WithAddressAllowlist(offchainCfg.ContractAddressAllowlist),
 	)
 
 	chainFeeProcessr := chainfee.NewProcessor(
@@ -183,7 +185,7 @@ func NewPlugin(
 		chainFeeProcessor:   chainFeeProcessr,
 		discoveryProcessor:  discoveryProcessor,
 		metricsReporter:     reporter,
-		ocrTypeCodec:        ocrtypecodec.DefaultCommitCodec,
+		ocrTypeCodec:        ocrtypecodecv2.NewCommitCodec(ocrtypecodecv2.Version(offchainCfg.OCRCodecVersion)),
 		reportBuilder:       reportBuilder,
 	}
 }
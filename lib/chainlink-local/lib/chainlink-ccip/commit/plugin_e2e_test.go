@@ -33,6 +33,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/commit/internal/builder"
 	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot"
 	"github.com/smartcontractkit/chainlink-ccip/commit/metrics"
+	"github.com/smartcontractkit/chainlink-ccip/commit/replay"
 	"github.com/smartcontractkit/chainlink-ccip/commit/tokenprice"
 	"github.com/smartcontractkit/chainlink-ccip/internal"
 	"github.com/smartcontractkit/chainlink-ccip/internal/libs/testhelpers"
@@ -710,6 +711,62 @@ func TestPlugin_E2E_AllNodesAgree_ChainFee(t *testing.T) {
 	}
 }
 
+func TestPlugin_E2E_RecordAndReplay(t *testing.T) {
+	params := defaultNodeParams(t)
+	dir := t.TempDir()
+
+	nodes := make([]ocr3types.ReportingPlugin[[]byte], len(oracleIDs))
+	plugins := make([]*Plugin, len(oracleIDs))
+	for i := range oracleIDs {
+		paramsCp := params
+		paramsCp.reportingCfg.OracleID = oracleIDs[i]
+		n := setupNode(paramsCp)
+		prepareCcipReaderMock(n.ccipReader, false, false, true)
+		preparePriceReaderMock(n.priceReader)
+		nodes[i] = n.node
+		plugins[i] = n.node
+	}
+
+	recorder, err := replay.NewRecorder(params.lggr, unclosedPlugin{nodes[0]}, replay.RecorderConfig{Dir: dir})
+	require.NoError(t, err)
+	nodes[0] = recorder
+
+	// the ranges are selected in the first round and reported in the second one.
+	encodedPrevOutcome, err := ocrTypCodec.EncodeOutcome(committypes.Outcome{})
+	require.NoError(t, err)
+	runner := testhelpers.NewOCR3Runner(nodes, oracleIDs, encodedPrevOutcome)
+	var numReports int
+	for range 3 {
+		res, err := runner.RunRound(params.ctx)
+		require.NoError(t, err)
+		numReports += len(res.Transmitted)
+	}
+	require.Positive(t, numReports)
+	require.NoError(t, recorder.Close())
+
+	rounds, err := replay.ReadRounds(dir)
+	require.NoError(t, err)
+	require.Len(t, rounds, 3)
+	var numRecordedReports int
+	for _, round := range rounds {
+		require.Len(t, round.Observations, len(oracleIDs))
+		numRecordedReports += len(round.Reports)
+	}
+	require.Equal(t, numReports, numRecordedReports)
+
+	// the rounds are reproduced by another oracle of the same DON.
+	diffs, err := replay.NewReplayer(params.lggr, plugins[1], ocrTypCodec).Replay(params.ctx, rounds)
+	require.NoError(t, err)
+	require.Empty(t, diffs)
+}
+
+// unclosedPlugin prevents the recorder from closing the plugin, the readers of the test nodes are not closable.
+type unclosedPlugin struct {
+	ocr3types.ReportingPlugin[[]byte]
+}
+
+func (unclosedPlugin) Close() error { return nil }
+
 // normalizeOutcome converts empty slices to nil or nil slices to empty where needed.
 func normalizeOutcome(o committypes.Outcome) committypes.Outcome {
 	if len(o.MerkleRootOutcome.RMNRemoteCfg.ContractAddress) == 0 {
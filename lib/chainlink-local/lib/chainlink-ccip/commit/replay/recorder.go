@@ -0,0 +1,257 @@
+package replay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+)
+
+const (
+	// maxPendingRounds is the number of rounds whose reports are awaited, older rounds are stored without reports.
+	maxPendingRounds = 16
+
+	// writeQueueSize is the number of rounds waiting to be written, rounds are dropped when the queue is full.
+	writeQueueSize = 64
+
+	// defaultMaxRounds is the number of rounds kept on disk if RecorderConfig.MaxRounds is not set.
+	defaultMaxRounds = 10_000
+)
+
+// RecorderConfig configures the recording of the rounds of a plugin.
+type RecorderConfig struct {
+	// Dir is the directory where the rounds are stored, it is created if needed.
+	Dir string `json:"dir"`
+
+	// MaxRounds is the number of most recent rounds that are kept in Dir, older rounds are deleted.
+	// Defaults to 10000 if not set.
+	MaxRounds int `json:"maxRounds"`
+}
+
+func (c RecorderConfig) Validate() error {
+	if c.Dir == "" {
+		return errors.New("recording dir is not set")
+	}
+	if c.MaxRounds < 0 {
+		return fmt.Errorf("maxRounds cannot be negative: %d", c.MaxRounds)
+	}
+	return nil
+}
+
+// Recorder wraps a reporting plugin and stores the encoded artifacts of every round it takes part in.
+// The rounds are written in the background so that the disk never slows down the wrapped plugin,
+// recording failures are logged and never affect the wrapped plugin.
+type Recorder struct {
+	ocr3types.ReportingPlugin[[]byte]
+	lggr      logger.Logger
+	dir       string
+	maxRounds int
+
+	mu sync.Mutex
+	// pending holds the recorded rounds whose reports were not generated yet.
+	pending map[uint64]Round
+
+	writes    chan Round
+	stop      chan struct{}
+	stopped   chan struct{}
+	closeOnce sync.Once
+
+	// stored are the sequence numbers of the rounds in dir, sorted. Only accessed by the writer goroutine.
+	stored []uint64
+}
+
+// NewRecorder creates a recorder that stores the rounds of the plugin according to cfg.
+// The rounds already stored in the directory count towards the retention limit.
+func NewRecorder(
+	lggr logger.Logger,
+	plugin ocr3types.ReportingPlugin[[]byte],
+	cfg RecorderConfig,
+) (*Recorder, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid recorder config: %w", err)
+	}
+	if err := os.MkdirAll(cfg.Dir, 0o700); err != nil {
+		return nil, fmt.Errorf("create recording dir %s: %w", cfg.Dir, err)
+	}
+	stored, err := storedSeqNrs(cfg.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	maxRounds := cfg.MaxRounds
+	if maxRounds == 0 {
+		maxRounds = defaultMaxRounds
+	}
+
+	r := &Recorder{
+		ReportingPlugin: plugin,
+		lggr:            logger.Named(lggr, "Recorder"),
+		dir:             cfg.Dir,
+		maxRounds:       maxRounds,
+		pending:         make(map[uint64]Round),
+		writes:          make(chan Round, writeQueueSize),
+		stop:            make(chan struct{}),
+		stopped:         make(chan struct{}),
+		stored:          stored,
+	}
+	go r.run()
+	return r, nil
+}
+
+func (r *Recorder) Outcome(
+	ctx context.Context, outctx ocr3types.OutcomeContext, query types.Query, aos []types.AttributedObservation,
+) (ocr3types.Outcome, error) {
+	outcome, err := r.ReportingPlugin.Outcome(ctx, outctx, query, aos)
+	if err != nil {
+		return outcome, err
+	}
+
+	observations := make([]AttributedObservation, 0, len(aos))
+	for _, ao := range aos {
+		observations = append(observations, AttributedObservation{Observer: ao.Observer, Observation: ao.Observation})
+	}
+	round := Round{
+		SeqNr:           outctx.SeqNr,
+		PreviousOutcome: outctx.PreviousOutcome,
+		Query:           query,
+		Observations:    observations,
+		Outcome:         outcome,
+	}
+
+	r.mu.Lock()
+	for seqNr := range r.pending {
+		if seqNr+maxPendingRounds <= round.SeqNr {
+			delete(r.pending, seqNr)
+		}
+	}
+	r.pending[round.SeqNr] = round
+	r.mu.Unlock()
+
+	r.enqueue(round)
+	return outcome, nil
+}
+
+func (r *Recorder) Reports(
+	ctx context.Context, seqNr uint64, outcome ocr3types.Outcome,
+) ([]ocr3types.ReportPlus[[]byte], error) {
+	reports, err := r.ReportingPlugin.Reports(ctx, seqNr, outcome)
+	if err != nil {
+		return reports, err
+	}
+
+	r.mu.Lock()
+	round, exists := r.pending[seqNr]
+	delete(r.pending, seqNr)
+	r.mu.Unlock()
+	if !exists {
+		r.lggr.Warnw("reports of a round without a recorded outcome are not recorded", "seqNr", seqNr)
+		return reports, nil
+	}
+
+	round.Reports = make([]Report, 0, len(reports))
+	for _, report := range reports {
+		round.Reports = append(round.Reports, Report{
+			Report: report.ReportWithInfo.Report,
+			Info:   report.ReportWithInfo.Info,
+		})
+	}
+	r.enqueue(round)
+
+	return reports, nil
+}
+
+// Close closes the wrapped plugin and writes the rounds that are still queued.
+func (r *Recorder) Close() error {
+	err := r.ReportingPlugin.Close()
+	r.closeOnce.Do(func() {
+		close(r.stop)
+		<-r.stopped
+	})
+	return err
+}
+
+// enqueue schedules the round to be written without blocking, the round is dropped if the queue is full.
+func (r *Recorder) enqueue(round Round) {
+	select {
+	case r.writes <- round:
+	default:
+		r.lggr.Warnw("recording queue is full, round is not recorded", "seqNr", round.SeqNr)
+	}
+}
+
+func (r *Recorder) run() {
+	defer close(r.stopped)
+	for {
+		select {
+		case <-r.stop:
+			// write what is left in the queue before stopping.
+			for {
+				select {
+				case round := <-r.writes:
+					r.write(round)
+				default:
+					return
+				}
+			}
+		case round := <-r.writes:
+			r.write(round)
+		}
+	}
+}
+
+func (r *Recorder) write(round Round) {
+	if err := WriteRound(r.dir, round); err != nil {
+		r.lggr.Errorw("failed to record round", "seqNr", round.SeqNr, "err", err)
+		return
+	}
+
+	i := sort.Search(len(r.stored), func(i int) bool { return r.stored[i] >= round.SeqNr })
+	if i < len(r.stored) && r.stored[i] == round.SeqNr {
+		return
+	}
+	r.stored = append(r.stored, 0)
+	copy(r.stored[i+1:], r.stored[i:])
+	r.stored[i] = round.SeqNr
+
+	for len(r.stored) > r.maxRounds {
+		oldest := r.stored[0]
+		if err := os.Remove(filepath.Join(r.dir, roundFileName(oldest))); err != nil && !os.IsNotExist(err) {
+			r.lggr.Errorw("failed to delete recorded round", "seqNr", oldest, "err", err)
+			return
+		}
+		r.stored = r.stored[1:]
+	}
+}
+
+// storedSeqNrs returns the sorted sequence numbers of the rounds stored in dir.
+func storedSeqNrs(dir string) ([]uint64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read dir %s: %w", dir, err)
+	}
+
+	var seqNrs []uint64
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, roundFilePrefix) || !strings.HasSuffix(name, roundFileSuffix) {
+			continue
+		}
+		seqNr, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(name, roundFilePrefix), roundFileSuffix),
+			10, 64)
+		if err != nil {
+			continue
+		}
+		seqNrs = append(seqNrs, seqNr)
+	}
+	sort.Slice(seqNrs, func(i, j int) bool { return seqNrs[i] < seqNrs[j] })
+	return seqNrs, nil
+}
@@ -0,0 +1,165 @@
+package replay
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-ccip/commit/committypes"
+	ocrtypecodec "github.com/smartcontractkit/chainlink-ccip/pkg/ocrtypecodec/v1"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// fakePlugin produces an outcome with one gas price per observation, the gas price is the observation
+// length plus the offset. Its reports carry the number of gas prices of the outcome.
+type fakePlugin struct {
+	ocr3types.ReportingPlugin[[]byte]
+	t      *testing.T
+	offset int64
+}
+
+func (p *fakePlugin) Outcome(
+	_ context.Context, _ ocr3types.OutcomeContext, _ types.Query, aos []types.AttributedObservation,
+) (ocr3types.Outcome, error) {
+	var outcome committypes.Outcome
+	for _, ao := range aos {
+		outcome.ChainFeeOutcome.GasPrices = append(outcome.ChainFeeOutcome.GasPrices, cciptypes.GasPriceChain{
+			ChainSel: cciptypes.ChainSelector(ao.Observer),
+			GasPrice: cciptypes.NewBigIntFromInt64(int64(len(ao.Observation)) + p.offset),
+		})
+	}
+	return ocrtypecodec.DefaultCommitCodec.EncodeOutcome(outcome)
+}
+
+func (p *fakePlugin) Close() error {
+	return nil
+}
+
+func (p *fakePlugin) Reports(
+	_ context.Context, _ uint64, outcomeBytes ocr3types.Outcome,
+) ([]ocr3types.ReportPlus[[]byte], error) {
+	outcome, err := ocrtypecodec.DefaultCommitCodec.DecodeOutcome(outcomeBytes)
+	require.NoError(p.t, err)
+
+	info, err := cciptypes.CommitReportInfo{
+		PriceUpdates: cciptypes.PriceUpdates{GasPriceUpdates: outcome.ChainFeeOutcome.GasPrices},
+	}.Encode()
+	require.NoError(p.t, err)
+
+	return []ocr3types.ReportPlus[[]byte]{{
+		ReportWithInfo: ocr3types.ReportWithInfo[[]byte]{
+			Report: []byte{byte(len(outcome.ChainFeeOutcome.GasPrices) + int(p.offset))},
+			Info:   info,
+		},
+	}}, nil
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	ctx := tests.Context(t)
+	lggr := logger.Test(t)
+	dir := t.TempDir()
+
+	recorder, err := NewRecorder(lggr, &fakePlugin{t: t}, RecorderConfig{Dir: dir})
+	require.NoError(t, err)
+
+	var previousOutcome ocr3types.Outcome
+	for seqNr := uint64(1); seqNr <= 3; seqNr++ {
+		outctx := ocr3types.OutcomeContext{SeqNr: seqNr, PreviousOutcome: previousOutcome}
+		aos := []types.AttributedObservation{
+			{Observer: 1, Observation: []byte{1}},
+			{Observer: 2, Observation: make([]byte, seqNr)},
+		}
+		outcome, err := recorder.Outcome(ctx, outctx, types.Query{byte(seqNr)}, aos)
+		require.NoError(t, err)
+
+		// the reports of the last round are not generated.
+		if seqNr < 3 {
+			_, err = recorder.Reports(ctx, seqNr, outcome)
+			require.NoError(t, err)
+		}
+		previousOutcome = outcome
+	}
+	// the rounds are written in the background, closing waits for the queued ones.
+	require.NoError(t, recorder.Close())
+
+	rounds, err := ReadRounds(dir)
+	require.NoError(t, err)
+	require.Len(t, rounds, 3)
+	for i, round := range rounds {
+		require.Equal(t, uint64(i+1), round.SeqNr)
+		require.Equal(t, []byte{byte(i + 1)}, round.Query)
+		require.Len(t, round.Observations, 2)
+		require.NotEmpty(t, round.Outcome)
+	}
+	require.Len(t, rounds[0].Reports, 1)
+	require.Len(t, rounds[1].Reports, 1)
+	require.Nil(t, rounds[2].Reports)
+	require.Equal(t, rounds[0].Outcome, rounds[1].PreviousOutcome)
+
+	t.Run("reproduced", func(t *testing.T) {
+		replayer := NewReplayer(lggr, &fakePlugin{t: t}, ocrtypecodec.DefaultCommitCodec)
+		diffs, err := replayer.Replay(ctx, rounds)
+		require.NoError(t, err)
+		require.Empty(t, diffs)
+	})
+
+	t.Run("diverged", func(t *testing.T) {
+		replayer := NewReplayer(lggr, &fakePlugin{t: t, offset: 1}, ocrtypecodec.DefaultCommitCodec)
+		diffs, err := replayer.Replay(ctx, rounds)
+		require.NoError(t, err)
+		require.Len(t, diffs, 3)
+
+		require.Equal(t, uint64(1), diffs[0].SeqNr)
+		require.Contains(t, diffs[0].OutcomeDiff, "-")
+		require.Contains(t, diffs[0].OutcomeDiff, "+")
+		// reports are built from the recorded outcome so only the report bytes differ.
+		require.Len(t, diffs[0].ReportDiffs, 1)
+		require.Contains(t, diffs[0].String(), "report 0:")
+		// the last round has no recorded reports.
+		require.Empty(t, diffs[2].ReportDiffs)
+	})
+}
+
+func TestRecorder_MaxRounds(t *testing.T) {
+	ctx := tests.Context(t)
+	lggr := logger.Test(t)
+	dir := t.TempDir()
+
+	// a round stored by a previous recorder counts towards the limit.
+	require.NoError(t, WriteRound(dir, Round{SeqNr: 1}))
+
+	recorder, err := NewRecorder(lggr, &fakePlugin{t: t}, RecorderConfig{Dir: dir, MaxRounds: 2})
+	require.NoError(t, err)
+	for seqNr := uint64(2); seqNr <= 4; seqNr++ {
+		outctx := ocr3types.OutcomeContext{SeqNr: seqNr}
+		outcome, err := recorder.Outcome(ctx, outctx, nil, []types.AttributedObservation{{Observer: 1}})
+		require.NoError(t, err)
+		_, err = recorder.Reports(ctx, seqNr, outcome)
+		require.NoError(t, err)
+	}
+	require.NoError(t, recorder.Close())
+	require.NoError(t, recorder.Close())
+
+	rounds, err := ReadRounds(dir)
+	require.NoError(t, err)
+	require.Len(t, rounds, 2)
+	require.Equal(t, uint64(3), rounds[0].SeqNr)
+	require.Equal(t, uint64(4), rounds[1].SeqNr)
+	require.Len(t, rounds[1].Reports, 1)
+
+	_, err = NewRecorder(lggr, &fakePlugin{t: t}, RecorderConfig{})
+	require.ErrorContains(t, err, "recording dir is not set")
+}
+
+func Test_lineDiff(t *testing.T) {
+	require.Empty(t, lineDiff([]string{"a", "b"}, []string{"a", "b"}))
+	require.Equal(t, "-b\n+c\n", lineDiff([]string{"a", "b", "d"}, []string{"a", "c", "d"}))
+	require.Equal(t, "+b\n", lineDiff([]string{"a"}, []string{"a", "b"}))
+	require.Equal(t, "-a\n", lineDiff([]string{"a"}, nil))
+}
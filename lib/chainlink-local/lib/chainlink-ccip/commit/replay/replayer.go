@@ -0,0 +1,220 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	ocrtypecodec "github.com/smartcontractkit/chainlink-ccip/pkg/ocrtypecodec/v1"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// RoundDiff is the difference between a recorded round and its replay, empty diffs mean that the round
+// was reproduced.
+type RoundDiff struct {
+	SeqNr uint64
+	// OutcomeDiff is a line diff of the decoded outcomes, lines prefixed with "-" are recorded
+	// and lines prefixed with "+" are replayed.
+	OutcomeDiff string
+	// ReportDiffs are the differences of the reports, in the same format as OutcomeDiff.
+	ReportDiffs []string
+}
+
+func (d RoundDiff) IsEmpty() bool {
+	return d.OutcomeDiff == "" && len(d.ReportDiffs) == 0
+}
+
+func (d RoundDiff) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "round %d\n", d.SeqNr)
+	if d.OutcomeDiff != "" {
+		fmt.Fprintf(&sb, "outcome:\n%s", d.OutcomeDiff)
+	}
+	for _, reportDiff := range d.ReportDiffs {
+		fmt.Fprintf(&sb, "%s", reportDiff)
+	}
+	return sb.String()
+}
+
+// Replayer feeds recorded rounds through a commit plugin and diffs the produced outcomes and reports
+// against the recorded ones.
+//
+// The plugin should be a fresh instance created with the offchain config, the oracle setup and the home chain
+// state of the recording, the outcome and reports phases do not read from the other chains.
+type Replayer struct {
+	lggr   logger.Logger
+	plugin ocr3types.ReportingPlugin[[]byte]
+	codec  ocrtypecodec.CommitCodec
+}
+
+func NewReplayer(
+	lggr logger.Logger,
+	plugin ocr3types.ReportingPlugin[[]byte],
+	codec ocrtypecodec.CommitCodec,
+) *Replayer {
+	return &Replayer{
+		lggr:   logger.Named(lggr, "Replayer"),
+		plugin: plugin,
+		codec:  codec,
+	}
+}
+
+// Replay replays the rounds in order and returns the diffs of the rounds that were not reproduced.
+func (r *Replayer) Replay(ctx context.Context, rounds []Round) ([]RoundDiff, error) {
+	var diffs []RoundDiff
+	for _, round := range rounds {
+		diff, err := r.ReplayRound(ctx, round)
+		if err != nil {
+			return nil, fmt.Errorf("replay round %d: %w", round.SeqNr, err)
+		}
+		if !diff.IsEmpty() {
+			r.lggr.Warnw("replayed round differs from the recording", "seqNr", round.SeqNr)
+			diffs = append(diffs, diff)
+		}
+	}
+	return diffs, nil
+}
+
+// ReplayRound replays a single round. The reports are built from the recorded outcome so that
+// a diverging outcome does not hide report building issues.
+func (r *Replayer) ReplayRound(ctx context.Context, round Round) (RoundDiff, error) {
+	aos := make([]types.AttributedObservation, 0, len(round.Observations))
+	for _, obs := range round.Observations {
+		aos = append(aos, types.AttributedObservation{Observer: obs.Observer, Observation: obs.Observation})
+	}
+
+	outctx := ocr3types.OutcomeContext{SeqNr: round.SeqNr, PreviousOutcome: round.PreviousOutcome}
+	outcome, err := r.plugin.Outcome(ctx, outctx, round.Query, aos)
+	if err != nil {
+		return RoundDiff{}, fmt.Errorf("outcome: %w", err)
+	}
+
+	diff := RoundDiff{SeqNr: round.SeqNr}
+	diff.OutcomeDiff, err = r.diffOutcomes(round.Outcome, outcome)
+	if err != nil {
+		return RoundDiff{}, err
+	}
+
+	// reports are only recorded once they are generated.
+	if round.Reports == nil {
+		return diff, nil
+	}
+
+	reports, err := r.plugin.Reports(ctx, round.SeqNr, round.Outcome)
+	if err != nil {
+		return RoundDiff{}, fmt.Errorf("reports: %w", err)
+	}
+	if len(reports) != len(round.Reports) {
+		diff.ReportDiffs = append(diff.ReportDiffs,
+			fmt.Sprintf("number of reports:\n-%d\n+%d\n", len(round.Reports), len(reports)))
+	}
+	for i := 0; i < min(len(reports), len(round.Reports)); i++ {
+		reportDiff, err := diffReports(round.Reports[i], reports[i].ReportWithInfo)
+		if err != nil {
+			return RoundDiff{}, fmt.Errorf("diff report %d: %w", i, err)
+		}
+		if reportDiff != "" {
+			diff.ReportDiffs = append(diff.ReportDiffs, fmt.Sprintf("report %d:\n%s", i, reportDiff))
+		}
+	}
+
+	return diff, nil
+}
+
+// diffOutcomes compares the decoded outcomes since the encoding is not guaranteed to be deterministic.
+func (r *Replayer) diffOutcomes(recorded, replayed []byte) (string, error) {
+	recordedOutcome, err := r.codec.DecodeOutcome(recorded)
+	if err != nil {
+		return "", fmt.Errorf("decode recorded outcome: %w", err)
+	}
+	replayedOutcome, err := r.codec.DecodeOutcome(replayed)
+	if err != nil {
+		return "", fmt.Errorf("decode replayed outcome: %w", err)
+	}
+	return diffJSON(recordedOutcome, replayedOutcome)
+}
+
+func diffReports(recorded Report, replayed ocr3types.ReportWithInfo[[]byte]) (string, error) {
+	var sb strings.Builder
+	if !bytes.Equal(recorded.Report, replayed.Report) {
+		sb.WriteString(lineDiff(
+			strings.Split(hex.Dump(recorded.Report), "\n"),
+			strings.Split(hex.Dump(replayed.Report), "\n"),
+		))
+	}
+
+	recordedInfo, err := cciptypes.DecodeCommitReportInfo(recorded.Info)
+	if err != nil {
+		return "", fmt.Errorf("decode recorded report info: %w", err)
+	}
+	replayedInfo, err := cciptypes.DecodeCommitReportInfo(replayed.Info)
+	if err != nil {
+		return "", fmt.Errorf("decode replayed report info: %w", err)
+	}
+	infoDiff, err := diffJSON(recordedInfo, replayedInfo)
+	if err != nil {
+		return "", err
+	}
+	sb.WriteString(infoDiff)
+
+	return sb.String(), nil
+}
+
+// diffJSON returns the line diff of the indented JSON representations of the values.
+func diffJSON(recorded, replayed any) (string, error) {
+	recordedJSON, err := json.MarshalIndent(recorded, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal recorded: %w", err)
+	}
+	replayedJSON, err := json.MarshalIndent(replayed, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal replayed: %w", err)
+	}
+	if bytes.Equal(recordedJSON, replayedJSON) {
+		return "", nil
+	}
+	return lineDiff(strings.Split(string(recordedJSON), "\n"), strings.Split(string(replayedJSON), "\n")), nil
+}
+
+// lineDiff returns the lines that are not part of the longest common subsequence of a and b,
+// prefixed with "-" if they are only in a and with "+" if they are only in b.
+func lineDiff(a, b []string) string {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var sb strings.Builder
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			fmt.Fprintf(&sb, "-%s\n", a[i])
+			i++
+		default:
+			fmt.Fprintf(&sb, "+%s\n", b[j])
+			j++
+		}
+	}
+	return sb.String()
+}
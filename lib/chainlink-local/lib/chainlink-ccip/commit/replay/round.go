@@ -0,0 +1,96 @@
+// Package replay records the OCR rounds of the commit plugin and replays them through a fresh plugin instance,
+// so that consensus issues observed in production can be reproduced deterministically.
+//
+// Every round is stored as a JSON file in the recording directory. The artifacts are kept in their encoded form,
+// exactly as they were exchanged between the oracles.
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+)
+
+const (
+	roundFilePrefix = "round-"
+	roundFileSuffix = ".json"
+)
+
+// Round holds the encoded artifacts of an OCR round as seen by the recording oracle.
+type Round struct {
+	SeqNr           uint64                  `json:"seqNr"`
+	PreviousOutcome []byte                  `json:"previousOutcome"`
+	Query           []byte                  `json:"query"`
+	Observations    []AttributedObservation `json:"observations"`
+	Outcome         []byte                  `json:"outcome"`
+	Reports         []Report                `json:"reports"`
+}
+
+type AttributedObservation struct {
+	Observer    commontypes.OracleID `json:"observer"`
+	Observation []byte               `json:"observation"`
+}
+
+type Report struct {
+	Report []byte `json:"report"`
+	Info   []byte `json:"info"`
+}
+
+// WriteRound stores the round in dir, a previously stored version of the same round is replaced.
+func WriteRound(dir string, round Round) error {
+	data, err := json.Marshal(round)
+	if err != nil {
+		return fmt.Errorf("marshal round %d: %w", round.SeqNr, err)
+	}
+
+	// write to a temporary file first so that a crash never leaves a partially written round behind.
+	path := filepath.Join(dir, roundFileName(round.SeqNr))
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return fmt.Errorf("write round %d: %w", round.SeqNr, err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("rename round %d: %w", round.SeqNr, err)
+	}
+	return nil
+}
+
+// ReadRounds reads all the rounds stored in dir, sorted by sequence number.
+func ReadRounds(dir string) ([]Round, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read dir %s: %w", dir, err)
+	}
+
+	rounds := make([]Round, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, roundFilePrefix) || !strings.HasSuffix(name, roundFileSuffix) {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, fmt.Errorf("read round file %s: %w", name, err)
+		}
+
+		var round Round
+		if err := json.Unmarshal(data, &round); err != nil {
+			return nil, fmt.Errorf("unmarshal round file %s: %w", name, err)
+		}
+		rounds = append(rounds, round)
+	}
+
+	sort.Slice(rounds, func(i, j int) bool { return rounds[i].SeqNr < rounds[j].SeqNr })
+	return rounds, nil
+}
+
+// roundFileName is zero padded so that the files are listed in the order of the rounds.
+func roundFileName(seqNr uint64) string {
+	return fmt.Sprintf("%s%020d%s", roundFilePrefix, seqNr, roundFileSuffix)
+}
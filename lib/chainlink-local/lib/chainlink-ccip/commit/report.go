@@ -109,6 +109,9 @@ func (p *Plugin) Reports(
 			"err", err)
 	}
 
+	// the prices reports are transmitted once the first transmitter of the roots reports had its turn.
+	sequenceAfterRoots(reports, encodedReports, p.offchainCfg.TransmissionDelayMultiplier)
+
 	lggr.Infow(fmt.Sprintf("Report building complete: built %d reports", len(reports)),
 		"numReport", len(reports),
 	)
@@ -178,6 +181,11 @@ func (p *Plugin) validateReport(
 		seen[sig] = struct{}{}
 	}
 
+	if err := p.validateSplitReport(decodedReport, reportInfo); err != nil {
+		lggr.Warnw("invalid split report", "split", reportInfo.Split, "err", err)
+		return cciptypes.CommitPluginReport{}, err
+	}
+
 	if p.offchainCfg.RMNEnabled &&
 		len(decodedReport.BlessedMerkleRoots) > 0 &&
 		consensus.LtFPlusOne(int(reportInfo.RemoteF), len(decodedReport.RMNSignatures)) {
@@ -339,6 +347,18 @@ func (p *Plugin) ShouldTransmitAcceptedReport(
 		return false, fmt.Errorf("validating report: %w", err)
 	}
 
+	// the report info was already decoded while validating the report.
+	reportInfo, _ := cciptypes.DecodeCommitReportInfo(r.Info)
+	err = p.checkRootsCommitted(ctx, lggr, reportInfo)
+	if errors.Is(err, plugincommon.ErrInvalidReport) {
+		lggr.Infow("report sequenced after roots that are not committed, not transmitting", "err", err)
+		return false, nil
+	}
+	if err != nil {
+		lggr.Infow("roots committed check error", "err", err)
+		return false, fmt.Errorf("checking roots committed: %w", err)
+	}
+
 	lggr.Infow("ShouldTransmitAcceptedReport passed checks",
 		"seqNr", seqNr,
 		"timestamp", time.Now().UTC(),
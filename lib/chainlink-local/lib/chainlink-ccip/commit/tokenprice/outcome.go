@@ -6,6 +6,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
+	"github.com/smartcontractkit/chainlink-ccip/commit/internal/pricebudget"
 	"github.com/smartcontractkit/chainlink-ccip/internal/libs/mathslib"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon/consensus"
@@ -43,15 +44,29 @@ func (p *processor) getConsensusObservation(
 			fmt.Errorf("no consensus value for f for FeedChain: %d", p.offChainCfg.PriceFeedChainSelector)
 	}
 
-	feedPricesConsensus := consensus.GetConsensusMapAggregator(
-		lggr,
-		"FeedTokenPrices",
-		aggObs.FeedTokenPrices,
-		consensus.MakeConstantThreshold[cciptypes.UnknownEncodedAddress](consensus.TwoFPlus1(fFeedChain)),
-		func(vals []cciptypes.TokenPrice) cciptypes.TokenPrice {
-			return consensus.Median(vals, consensus.TokenPriceComparator)
-		},
-	)
+	feedThresh := consensus.MakeConstantThreshold[cciptypes.UnknownEncodedAddress](consensus.TwoFPlus1(fFeedChain))
+	var feedPricesConsensus map[cciptypes.UnknownEncodedAddress]cciptypes.TokenPrice
+	if priceConsensus := p.offChainCfg.PriceConsensus; priceConsensus != nil {
+		weights := consensus.OracleWeights(priceConsensus.OracleWeights)
+		feedPricesConsensus = consensus.GetWeightedConsensusMapAggregator(
+			lggr,
+			"FeedTokenPrices",
+			aggregateWeightedFeedTokenPrices(aos, weights),
+			feedThresh,
+			weights.QuorumWeight(),
+			consensus.TokenPriceWeightedAggregator(priceConsensus.OutlierMADMultiplier),
+		)
+	} else {
+		feedPricesConsensus = consensus.GetConsensusMapAggregator(
+			lggr,
+			"FeedTokenPrices",
+			aggObs.FeedTokenPrices,
+			feedThresh,
+			func(vals []cciptypes.TokenPrice) cciptypes.TokenPrice {
+				return consensus.Median(vals, consensus.TokenPriceComparator)
+			},
+		)
+	}
 
 	feeQuoterUpdatesConsensus := consensus.GetConsensusMapAggregator(
 		lggr,
@@ -78,11 +93,13 @@ func (p *processor) getConsensusObservation(
 // a token is selected for update if it meets one of 2 conditions:
 // 1. if time passed since the last update is greater than the stale threshold
 // 2. if deviation between the fee quoter and feed exceeds token's configured threshold
+// if a price update budget is configured, the deviating tokens that do not fit in the budget are suppressed
 func (p *processor) selectTokensForUpdate(
 	lggr logger.Logger,
 	obs ConsensusObservation,
 ) cciptypes.TokenPriceMap {
 	tokenPrices := make(cciptypes.TokenPriceMap)
+	candidates := make([]pricebudget.Candidate[cciptypes.UnknownEncodedAddress], 0, len(obs.FeedTokenPrices))
 	cfg := p.offChainCfg
 	tokenInfo := cfg.TokenInfo
 
@@ -97,6 +114,8 @@ func (p *processor) selectTokensForUpdate(
 		if !exists {
 			lggr.Infow("token price update needed: no previous update exists")
 			tokenPrices[token] = cciptypes.NewBigInt(feedPrice.Price.Int)
+			candidates = append(candidates,
+				pricebudget.Candidate[cciptypes.UnknownEncodedAddress]{Key: token, Mandatory: true})
 			continue
 		}
 
@@ -115,17 +134,64 @@ func (p *processor) selectTokensForUpdate(
 				"nextUpdateTime", nextUpdateTime,
 				"heartbeatInterval", cfg.TokenPriceBatchWriteFrequency)
 			tokenPrices[token] = cciptypes.NewBigInt(feedPrice.Price.Int)
+			candidates = append(candidates,
+				pricebudget.Candidate[cciptypes.UnknownEncodedAddress]{Key: token, Mandatory: true})
 		} else if priceDeviates {
 			lggr.Infow("token price update needed: deviation threshold exceeded",
 				"deviationPPB", ti.DeviationPPB)
 			tokenPrices[token] = cciptypes.NewBigInt(feedPrice.Price.Int)
+			candidates = append(candidates, pricebudget.Candidate[cciptypes.UnknownEncodedAddress]{
+				Key:          token,
+				DeviationPPB: mathslib.DeviationPPB(feedPrice.Price.Int, lastUpdate.Value.Int),
+			})
 		} else {
 			lggr.Debugw("token price update not needed: within deviation threshold",
 				"deviationPPB", ti.DeviationPPB)
 		}
 	}
 
-	return tokenPrices
+	return p.selectWithinBudget(lggr, obs, tokenPrices, candidates)
+}
+
+// selectWithinBudget removes the token prices that do not fit in the price update budget.
+// All the token prices are kept if the budget of the token price updates is not configured.
+func (p *processor) selectWithinBudget(
+	lggr logger.Logger,
+	obs ConsensusObservation,
+	tokenPrices cciptypes.TokenPriceMap,
+	candidates []pricebudget.Candidate[cciptypes.UnknownEncodedAddress],
+) cciptypes.TokenPriceMap {
+	budgetCfg := p.offChainCfg.PriceUpdateBudget
+	if budgetCfg == nil {
+		return tokenPrices
+	}
+
+	budget := pricebudget.NewBudget(
+		budgetCfg.Window.Duration(), budgetCfg.TokenPriceUpdatesMaxGas, budgetCfg.GasPerTokenPriceUpdate)
+	if !budget.Enabled() {
+		return tokenPrices
+	}
+
+	lastUpdates := make([]time.Time, 0, len(obs.FeeQuoterTokenUpdates))
+	for _, update := range obs.FeeQuoterTokenUpdates {
+		lastUpdates = append(lastUpdates, update.Timestamp)
+	}
+	remaining := budget.Remaining(obs.Timestamp, lastUpdates)
+
+	selected := pricebudget.Select(candidates, remaining)
+	if len(selected) == len(candidates) {
+		return tokenPrices
+	}
+
+	lggr.Infow("token price updates suppressed by the price update budget",
+		"remainingUpdates", remaining,
+		"candidates", len(candidates),
+		"selected", selected)
+	selectedPrices := make(cciptypes.TokenPriceMap, len(selected))
+	for _, token := range selected {
+		selectedPrices[token] = tokenPrices[token]
+	}
+	return selectedPrices
 }
 
 // aggregateObservations takes a list of observations and produces an AggregateObservation
@@ -163,3 +229,22 @@ func aggregateObservations(aos []plugincommon.AttributedObservation[Observation]
 
 	return aggObs
 }
+
+// aggregateWeightedFeedTokenPrices groups the observed feed token prices by token, each price is weighted by the
+// weight of the oracle that observed it.
+func aggregateWeightedFeedTokenPrices(
+	aos []plugincommon.AttributedObservation[Observation],
+	weights consensus.OracleWeights,
+) map[cciptypes.UnknownEncodedAddress][]consensus.Weighted[cciptypes.TokenPrice] {
+	feedTokenPrices := make(map[cciptypes.UnknownEncodedAddress][]consensus.Weighted[cciptypes.TokenPrice])
+	for _, ao := range aos {
+		weight := weights.Weight(ao.OracleID)
+		for tokenID, price := range ao.Observation.FeedTokenPrices {
+			feedTokenPrices[tokenID] = append(feedTokenPrices[tokenID], consensus.Weighted[cciptypes.TokenPrice]{
+				Value:  cciptypes.NewTokenPrice(tokenID, price.Int),
+				Weight: weight,
+			})
+		}
+	}
+	return feedTokenPrices
+}
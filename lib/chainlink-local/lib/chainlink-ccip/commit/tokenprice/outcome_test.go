@@ -106,6 +106,54 @@ func TestGetConsensusObservation(t *testing.T) {
 	assert.Len(t, consensusObs.FeedTokenPrices, 4)
 }
 
+func TestGetConsensusObservation_PriceConsensus(t *testing.T) {
+	lggr := logger.Test(t)
+	cfg := offChainCfg
+	cfg.PriceConsensus = &pluginconfig.PriceConsensusConfig{
+		// the light oracles 1-5 don't reach the weighted quorum on their own.
+		OracleWeights:        map[commontypes.OracleID]uint64{1: 1, 2: 1, 3: 1, 4: 1, 5: 1, 6: 10, 7: 10, 8: 10},
+		OutlierMADMultiplier: 3,
+	}
+	p := &processor{
+		lggr:        lggr,
+		destChain:   destChainSel,
+		offChainCfg: cfg,
+		fRoleDON:    1,
+	}
+
+	lightObs := obs
+	lightObs.FeedTokenPrices = cciptypes.TokenPriceMap{
+		tokenA: cciptypes.NewBigIntFromInt64(1_000_000),
+		tokenB: feedTokenPrices[tokenB],
+	}
+	heavyObs := obs
+	heavyObs.FeedTokenPrices = cciptypes.TokenPriceMap{
+		tokenA: feedTokenPrices[tokenA],
+	}
+
+	var aos []plugincommon.AttributedObservation[Observation]
+	for oracleID := commontypes.OracleID(1); oracleID <= 8; oracleID++ {
+		observation := lightObs
+		if oracleID > 5 {
+			observation = heavyObs
+		}
+		aos = append(aos, plugincommon.AttributedObservation[Observation]{OracleID: oracleID, Observation: observation})
+	}
+
+	consensusObs, err := p.getConsensusObservation(lggr, aos)
+	assert.NoError(t, err)
+	// the majority of the oracles observed an extreme price but the weighted median is kept.
+	assert.Len(t, consensusObs.FeedTokenPrices, 1)
+	assert.Equal(t, feedTokenPrices[tokenA], consensusObs.FeedTokenPrices[tokenA].Price)
+
+	// without weights every oracle counts the same.
+	p.offChainCfg = offChainCfg
+	consensusObs, err = p.getConsensusObservation(lggr, aos)
+	assert.NoError(t, err)
+	assert.Len(t, consensusObs.FeedTokenPrices, 2)
+	assert.Equal(t, int64(1_000_000), consensusObs.FeedTokenPrices[tokenA].Price.Int64())
+}
+
 func TestSelectTokensForUpdate(t *testing.T) {
 	lggr := logger.Test(t)
 	p := &processor{
@@ -132,6 +180,60 @@ func TestSelectTokensForUpdate(t *testing.T) {
 	assert.Equal(t, conObs.FeedTokenPrices[tokenC].Price, tokenPrices[tokenC])
 }
 
+func TestSelectTokensForUpdate_PriceUpdateBudget(t *testing.T) {
+	lggr := logger.Test(t)
+	conObs := ConsensusObservation{
+		FeedTokenPrices:       feedTokenPricesMap,
+		FeeQuoterTokenUpdates: feeQuoterUpdates,
+		Timestamp:             ts,
+	}
+
+	testCases := []struct {
+		name      string
+		maxGas    uint64
+		expTokens []cciptypes.UnknownEncodedAddress
+	}{
+		{
+			name:      "deviating token fits in the budget",
+			maxGas:    600, // 3 updates within the window, 3 remaining for the 2 mandatory and 1 deviating updates
+			expTokens: []cciptypes.UnknownEncodedAddress{tokenA, tokenB, tokenC},
+		},
+		{
+			name:      "deviating token is suppressed",
+			maxGas:    500, // 3 updates within the window, 2 remaining consumed by the mandatory updates
+			expTokens: []cciptypes.UnknownEncodedAddress{tokenA, tokenC},
+		},
+		{
+			name:      "token budget not configured",
+			maxGas:    0,
+			expTokens: []cciptypes.UnknownEncodedAddress{tokenA, tokenB, tokenC},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := offChainCfg
+			cfg.PriceUpdateBudget = &pluginconfig.PriceUpdateBudgetConfig{
+				Window:                  *commonconfig.MustNewDuration(time.Hour),
+				TokenPriceUpdatesMaxGas: tc.maxGas,
+				GasPerTokenPriceUpdate:  100,
+			}
+			p := &processor{
+				lggr:        lggr,
+				destChain:   destChainSel,
+				offChainCfg: cfg,
+				fRoleDON:    1,
+			}
+
+			tokenPrices := p.selectTokensForUpdate(lggr, conObs)
+			assert.Len(t, tokenPrices, len(tc.expTokens))
+			for _, token := range tc.expTokens {
+				assert.Equal(t, conObs.FeedTokenPrices[token].Price, tokenPrices[token])
+			}
+		})
+	}
+}
+
 // Test Plugin Outcome method returns the correct token prices
 func TestOutcome(t *testing.T) {
 	ctx := tests.Context(t)
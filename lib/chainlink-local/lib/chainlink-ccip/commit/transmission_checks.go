@@ -0,0 +1,139 @@
+package commit
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+
+	"github.com/smartcontractkit/chainlink-ccip/commit/internal/builder"
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// validateSplitReport checks that a report which is part of a split outcome only contains what its split info
+// declares, so that it can be accepted independently of the other reports of the round.
+//
+// Reports without split info are not checked.
+func (p *Plugin) validateSplitReport(
+	report cciptypes.CommitPluginReport,
+	reportInfo cciptypes.CommitReportInfo,
+) error {
+	split := reportInfo.Split
+	if split == nil {
+		return nil
+	}
+
+	if p.offchainCfg.ReportSplitting == nil {
+		return plugincommon.NewErrInvalidReport("split report while report splitting is not configured")
+	}
+
+	if split.Count <= 0 || split.Index < 0 || split.Index >= split.Count {
+		return plugincommon.NewErrInvalidReport(
+			fmt.Sprintf("invalid split report position %d/%d", split.Index, split.Count))
+	}
+
+	numRoots := len(report.BlessedMerkleRoots) + len(report.UnblessedMerkleRoots)
+	if numRoots != len(reportInfo.MerkleRoots) {
+		return plugincommon.NewErrInvalidReport(
+			fmt.Sprintf("report has %d merkle roots but report info has %d", numRoots, len(reportInfo.MerkleRoots)))
+	}
+
+	hasPrices := len(report.PriceUpdates.TokenPriceUpdates) > 0 || len(report.PriceUpdates.GasPriceUpdates) > 0
+	switch split.Kind {
+	case cciptypes.CommitReportKindBlessedRoots:
+		if len(report.BlessedMerkleRoots) == 0 || len(report.UnblessedMerkleRoots) > 0 || hasPrices {
+			return plugincommon.NewErrInvalidReport("blessed roots report must only contain blessed merkle roots")
+		}
+		// blessed roots can't be split since the RMN signatures cover all of them.
+		return nil
+	case cciptypes.CommitReportKindUnblessedRoots:
+		if len(report.UnblessedMerkleRoots) == 0 || len(report.BlessedMerkleRoots) > 0 ||
+			len(report.RMNSignatures) > 0 || hasPrices {
+			return plugincommon.NewErrInvalidReport("unblessed roots report must only contain unblessed merkle roots")
+		}
+	case cciptypes.CommitReportKindPrices:
+		if !hasPrices || numRoots > 0 || len(report.RMNSignatures) > 0 {
+			return plugincommon.NewErrInvalidReport("prices report must only contain price updates")
+		}
+	default:
+		return plugincommon.NewErrInvalidReport(fmt.Sprintf("unknown split report kind %q", split.Kind))
+	}
+
+	// A report with a single item is allowed to exceed the limits since it can't be split any further.
+	numItems := numRoots + len(report.PriceUpdates.TokenPriceUpdates) + len(report.PriceUpdates.GasPriceUpdates)
+	estimateProvider := builder.NewConfigEstimateProvider(*p.offchainCfg.ReportSplitting)
+	if numItems > 1 && !builder.WithinLimits(*p.offchainCfg.ReportSplitting, estimateProvider, report) {
+		return plugincommon.NewErrInvalidReport("split report exceeds the report limits")
+	}
+
+	return nil
+}
+
+// checkRootsCommitted checks that the merkle roots a prices report is sequenced after are committed on the
+// destination, i.e. that the offRamp expects a sequence number above the last one of the roots.
+//
+// Reports that are not sequenced after any roots are not checked.
+func (p *Plugin) checkRootsCommitted(
+	ctx context.Context,
+	lggr logger.Logger,
+	reportInfo cciptypes.CommitReportInfo,
+) error {
+	if reportInfo.Split == nil || len(reportInfo.Split.AfterRoots) == 0 {
+		return nil
+	}
+
+	chains := maps.Keys(reportInfo.Split.AfterRoots)
+	sort.Slice(chains, func(i, j int) bool { return chains[i] < chains[j] })
+
+	offRampNextSeqNums, err := p.ccipReader.NextSeqNum(ctx, chains)
+	if err != nil {
+		return plugincommon.NewErrValidatingReport(fmt.Errorf("get offRamp next seq nums: %w", err))
+	}
+
+	for _, chain := range chains {
+		lastSeqNum := reportInfo.Split.AfterRoots[chain]
+		if nextSeqNum, ok := offRampNextSeqNums[chain]; !ok || nextSeqNum <= lastSeqNum {
+			lggr.Infow("merkle roots of the round are not committed yet",
+				"chain", chain, "lastSeqNum", lastSeqNum, "offRampNextSeqNum", nextSeqNum)
+			return plugincommon.NewErrInvalidReport(
+				fmt.Sprintf("merkle roots of chain %d up to %d are not committed", chain, lastSeqNum))
+		}
+	}
+
+	return nil
+}
+
+// sequenceAfterRoots delays the transmission schedule of the prices reports that are sequenced after the
+// roots of the round by the given delay, so that the roots are transmitted first.
+func sequenceAfterRoots(
+	reports []builder.Report,
+	encodedReports []ocr3types.ReportPlus[[]byte],
+	delay time.Duration,
+) {
+	if len(reports) != len(encodedReports) {
+		return
+	}
+
+	for i, report := range reports {
+		split := report.ReportInfo.Split
+		schedule := encodedReports[i].TransmissionScheduleOverride
+		if split == nil || len(split.AfterRoots) == 0 || schedule == nil {
+			continue
+		}
+
+		delayed := &ocr3types.TransmissionSchedule{
+			Transmitters:       schedule.Transmitters,
+			TransmissionDelays: make([]time.Duration, len(schedule.TransmissionDelays)),
+		}
+		for j, d := range schedule.TransmissionDelays {
+			delayed.TransmissionDelays[j] = d + delay
+		}
+		encodedReports[i].TransmissionScheduleOverride = delayed
+	}
+}
@@ -0,0 +1,278 @@
+package commit
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-ccip/commit/internal/builder"
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
+	readerpkg_mock "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+func TestPlugin_validateSplitReport(t *testing.T) {
+	root := cciptypes.MerkleRootChain{
+		ChainSel:     1,
+		SeqNumsRange: cciptypes.NewSeqNumRange(1, 10),
+		MerkleRoot:   cciptypes.Bytes32{1},
+	}
+	priceUpdates := cciptypes.PriceUpdates{
+		GasPriceUpdates: []cciptypes.GasPriceChain{{ChainSel: 1, GasPrice: cciptypes.NewBigIntFromInt64(1)}},
+	}
+	splitCfg := &pluginconfig.ReportSplittingConfig{
+		MaxReportGas:           250,
+		GasPerMerkleRoot:       100,
+		GasPerTokenPriceUpdate: 100,
+		GasPerGasPriceUpdate:   100,
+	}
+
+	testCases := []struct {
+		name       string
+		splitCfg   *pluginconfig.ReportSplittingConfig
+		report     cciptypes.CommitPluginReport
+		reportInfo cciptypes.CommitReportInfo
+		expErr     string
+	}{
+		{
+			name:   "not a split report",
+			report: cciptypes.CommitPluginReport{UnblessedMerkleRoots: []cciptypes.MerkleRootChain{root}},
+		},
+		{
+			name:     "unblessed roots report",
+			splitCfg: splitCfg,
+			report:   cciptypes.CommitPluginReport{UnblessedMerkleRoots: []cciptypes.MerkleRootChain{root}},
+			reportInfo: cciptypes.CommitReportInfo{
+				MerkleRoots: []cciptypes.MerkleRootChain{root},
+				Split:       &cciptypes.CommitReportSplit{Kind: cciptypes.CommitReportKindUnblessedRoots, Count: 2},
+			},
+		},
+		{
+			name:     "prices report",
+			splitCfg: splitCfg,
+			report:   cciptypes.CommitPluginReport{PriceUpdates: priceUpdates},
+			reportInfo: cciptypes.CommitReportInfo{
+				Split: &cciptypes.CommitReportSplit{Kind: cciptypes.CommitReportKindPrices, Index: 1, Count: 2},
+			},
+		},
+		{
+			name:     "blessed roots report above the limits",
+			splitCfg: splitCfg,
+			report: cciptypes.CommitPluginReport{
+				BlessedMerkleRoots: []cciptypes.MerkleRootChain{root, root, root},
+				RMNSignatures:      []cciptypes.RMNECDSASignature{{R: [32]byte{1}}},
+			},
+			reportInfo: cciptypes.CommitReportInfo{
+				MerkleRoots: []cciptypes.MerkleRootChain{root, root, root},
+				Split:       &cciptypes.CommitReportSplit{Kind: cciptypes.CommitReportKindBlessedRoots, Count: 1},
+			},
+		},
+		{
+			name:   "split report while splitting is not configured",
+			report: cciptypes.CommitPluginReport{PriceUpdates: priceUpdates},
+			reportInfo: cciptypes.CommitReportInfo{
+				Split: &cciptypes.CommitReportSplit{Kind: cciptypes.CommitReportKindPrices, Count: 1},
+			},
+			expErr: "report splitting is not configured",
+		},
+		{
+			name:     "invalid position",
+			splitCfg: splitCfg,
+			report:   cciptypes.CommitPluginReport{PriceUpdates: priceUpdates},
+			reportInfo: cciptypes.CommitReportInfo{
+				Split: &cciptypes.CommitReportSplit{Kind: cciptypes.CommitReportKindPrices, Index: 2, Count: 2},
+			},
+			expErr: "invalid split report position",
+		},
+		{
+			name:     "prices in a roots report",
+			splitCfg: splitCfg,
+			report: cciptypes.CommitPluginReport{
+				UnblessedMerkleRoots: []cciptypes.MerkleRootChain{root},
+				PriceUpdates:         priceUpdates,
+			},
+			reportInfo: cciptypes.CommitReportInfo{
+				MerkleRoots: []cciptypes.MerkleRootChain{root},
+				Split:       &cciptypes.CommitReportSplit{Kind: cciptypes.CommitReportKindUnblessedRoots, Count: 1},
+			},
+			expErr: "unblessed roots report must only contain unblessed merkle roots",
+		},
+		{
+			name:     "roots in a prices report",
+			splitCfg: splitCfg,
+			report: cciptypes.CommitPluginReport{
+				UnblessedMerkleRoots: []cciptypes.MerkleRootChain{root},
+				PriceUpdates:         priceUpdates,
+			},
+			reportInfo: cciptypes.CommitReportInfo{
+				MerkleRoots: []cciptypes.MerkleRootChain{root},
+				Split:       &cciptypes.CommitReportSplit{Kind: cciptypes.CommitReportKindPrices, Count: 1},
+			},
+			expErr: "prices report must only contain price updates",
+		},
+		{
+			name:     "report info roots mismatch",
+			splitCfg: splitCfg,
+			report:   cciptypes.CommitPluginReport{UnblessedMerkleRoots: []cciptypes.MerkleRootChain{root}},
+			reportInfo: cciptypes.CommitReportInfo{
+				Split: &cciptypes.CommitReportSplit{Kind: cciptypes.CommitReportKindUnblessedRoots, Count: 1},
+			},
+			expErr: "report has 1 merkle roots but report info has 0",
+		},
+		{
+			name:     "unblessed roots report above the limits",
+			splitCfg: splitCfg,
+			report: cciptypes.CommitPluginReport{
+				UnblessedMerkleRoots: []cciptypes.MerkleRootChain{root, root, root},
+			},
+			reportInfo: cciptypes.CommitReportInfo{
+				MerkleRoots: []cciptypes.MerkleRootChain{root, root, root},
+				Split:       &cciptypes.CommitReportSplit{Kind: cciptypes.CommitReportKindUnblessedRoots, Count: 1},
+			},
+			expErr: "split report exceeds the report limits",
+		},
+		{
+			name:     "unknown kind",
+			splitCfg: splitCfg,
+			report:   cciptypes.CommitPluginReport{PriceUpdates: priceUpdates},
+			reportInfo: cciptypes.CommitReportInfo{
+				Split: &cciptypes.CommitReportSplit{Kind: "unknown", Count: 1},
+			},
+			expErr: "unknown split report kind",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &Plugin{offchainCfg: pluginconfig.CommitOffchainConfig{ReportSplitting: tc.splitCfg}}
+			err := p.validateSplitReport(tc.report, tc.reportInfo)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, plugincommon.ErrInvalidReport)
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestPlugin_checkRootsCommitted(t *testing.T) {
+	pricesInfo := cciptypes.CommitReportInfo{
+		Split: &cciptypes.CommitReportSplit{
+			Kind:       cciptypes.CommitReportKindPrices,
+			Index:      1,
+			Count:      2,
+			AfterRoots: map[cciptypes.ChainSelector]cciptypes.SeqNum{1: 10, 2: 20},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		reportInfo cciptypes.CommitReportInfo
+		nextSeqNum map[cciptypes.ChainSelector]cciptypes.SeqNum
+		readerErr  error
+		expErr     string
+		expInvalid bool
+	}{
+		{
+			name:       "not a split report",
+			reportInfo: cciptypes.CommitReportInfo{},
+		},
+		{
+			name: "prices report not sequenced after roots",
+			reportInfo: cciptypes.CommitReportInfo{
+				Split: &cciptypes.CommitReportSplit{Kind: cciptypes.CommitReportKindPrices, Count: 1},
+			},
+		},
+		{
+			name:       "roots committed",
+			reportInfo: pricesInfo,
+			nextSeqNum: map[cciptypes.ChainSelector]cciptypes.SeqNum{1: 11, 2: 25},
+		},
+		{
+			name:       "roots of a chain not committed",
+			reportInfo: pricesInfo,
+			nextSeqNum: map[cciptypes.ChainSelector]cciptypes.SeqNum{1: 11, 2: 20},
+			expErr:     "merkle roots of chain 2 up to 20 are not committed",
+			expInvalid: true,
+		},
+		{
+			name:       "chain missing from the offRamp",
+			reportInfo: pricesInfo,
+			nextSeqNum: map[cciptypes.ChainSelector]cciptypes.SeqNum{2: 21},
+			expErr:     "merkle roots of chain 1 up to 10 are not committed",
+			expInvalid: true,
+		},
+		{
+			name:       "reader error",
+			reportInfo: pricesInfo,
+			readerErr:  errors.New("some error"),
+			expErr:     "some error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ccipReader := readerpkg_mock.NewMockCCIPReader(t)
+			if tc.nextSeqNum != nil || tc.readerErr != nil {
+				ccipReader.EXPECT().
+					NextSeqNum(mock.Anything, []cciptypes.ChainSelector{1, 2}).
+					Return(tc.nextSeqNum, tc.readerErr)
+			}
+
+			p := &Plugin{ccipReader: ccipReader}
+			err := p.checkRootsCommitted(tests.Context(t), logger.Test(t), tc.reportInfo)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expErr)
+			require.Equal(t, tc.expInvalid, errors.Is(err, plugincommon.ErrInvalidReport))
+		})
+	}
+}
+
+func Test_sequenceAfterRoots(t *testing.T) {
+	schedule := &ocr3types.TransmissionSchedule{
+		Transmitters:       []commontypes.OracleID{1, 2},
+		TransmissionDelays: []time.Duration{0, 10 * time.Second},
+	}
+	reports := []builder.Report{
+		{ReportInfo: cciptypes.CommitReportInfo{
+			Split: &cciptypes.CommitReportSplit{Kind: cciptypes.CommitReportKindUnblessedRoots},
+		}},
+		{ReportInfo: cciptypes.CommitReportInfo{
+			Split: &cciptypes.CommitReportSplit{Kind: cciptypes.CommitReportKindPrices},
+		}},
+		{ReportInfo: cciptypes.CommitReportInfo{
+			Split: &cciptypes.CommitReportSplit{
+				Kind:       cciptypes.CommitReportKindPrices,
+				AfterRoots: map[cciptypes.ChainSelector]cciptypes.SeqNum{1: 10},
+			},
+		}},
+	}
+	encodedReports := make([]ocr3types.ReportPlus[[]byte], len(reports))
+	for i := range encodedReports {
+		encodedReports[i].TransmissionScheduleOverride = schedule
+	}
+
+	sequenceAfterRoots(reports, encodedReports, 10*time.Second)
+
+	require.Equal(t, schedule, encodedReports[0].TransmissionScheduleOverride)
+	require.Equal(t, schedule, encodedReports[1].TransmissionScheduleOverride)
+	require.Equal(t, []commontypes.OracleID{1, 2}, encodedReports[2].TransmissionScheduleOverride.Transmitters)
+	require.Equal(t, []time.Duration{10 * time.Second, 20 * time.Second},
+		encodedReports[2].TransmissionScheduleOverride.TransmissionDelays)
+	// the shared schedule is not modified.
+	require.Equal(t, []time.Duration{0, 10 * time.Second}, schedule.TransmissionDelays)
+}
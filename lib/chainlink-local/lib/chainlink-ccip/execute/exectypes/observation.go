@@ -96,6 +96,10 @@ func GetEncodedMsgAndTokenDataSizes(mo MessageObservations, tds TokenDataObserva
 // must be encoding according to the destination chain requirements with typeconv.AddressBytesToString.
 type NonceObservations map[cciptypes.ChainSelector]map[string]uint64
 
+// SimulatedRevertObservations contain the sequence numbers of the messages, organized by source chain selector,
+// whose dry-run execution on the destination chain reverts.
+type SimulatedRevertObservations map[cciptypes.ChainSelector][]cciptypes.SeqNum
+
 // TokenDataObservations contain token data for messages organized by source chain selector and sequence number.
 // There could be multiple tokens per a single message, so MessageTokenData is a slice of TokenData.
 // TokenDataObservations are populated during the Observation phase and depend on previously fetched
@@ -130,6 +134,10 @@ type Observation struct {
 	// It contains the nonces of senders who are being considered for the final report.
 	Nonces NonceObservations `json:"nonces"`
 
+	// SimulatedReverts are determined during the third phase of execute, along with the Nonces.
+	// They are observed only by the oracles provided with a message simulator.
+	SimulatedReverts SimulatedRevertObservations `json:"simulatedReverts"`
+
 	// Contracts are part of the initial discovery phase which runs to initialize the CCIP Reader.
 	Contracts dt.Observation `json:"contracts"`
 
@@ -146,13 +154,14 @@ func (o Observation) ToLogFormat() Observation {
 		}
 	}
 	cleanedObs := Observation{
-		CommitReports: o.CommitReports,
-		Hashes:        o.Hashes,
-		TokenData:     o.TokenData,
-		Nonces:        o.Nonces,
-		FChain:        o.FChain,
-		Messages:      msgsWithEmptyData,
-		Contracts:     dt.Observation{},
+		CommitReports:    o.CommitReports,
+		Hashes:           o.Hashes,
+		TokenData:        o.TokenData,
+		Nonces:           o.Nonces,
+		SimulatedReverts: o.SimulatedReverts,
+		FChain:           o.FChain,
+		Messages:         msgsWithEmptyData,
+		Contracts:        dt.Observation{},
 	}
 
 	return cleanedObs
@@ -15,7 +15,9 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/metrics"
+	"github.com/smartcontractkit/chainlink-ccip/execute/report"
 	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/observer"
+	"github.com/smartcontractkit/chainlink-ccip/execute/trace"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal/reader"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
@@ -80,6 +82,8 @@ type PluginFactory struct {
 	tokenDataEncoder cciptypes.TokenDataEncoder
 	contractReaders  map[cciptypes.ChainSelector]types.ContractReader
 	chainWriters     map[cciptypes.ChainSelector]types.ContractWriter
+	messageSimulator report.MessageSimulator
+	traceExporter    trace.Exporter
 }
 
 type PluginFactoryParams struct {
@@ -94,6 +98,12 @@ type PluginFactoryParams struct {
 	EstimateProvider cciptypes.EstimateProvider
 	ContractReaders  map[cciptypes.ChainSelector]types.ContractReader
 	ContractWriters  map[cciptypes.ChainSelector]types.ContractWriter
+	// MessageSimulator is optional, messages are dry-run in the Filter round only if it's set and message
+	// simulation is enabled in the offchain config. evmsimulator provides the EVM implementation.
+	MessageSimulator report.MessageSimulator
+	// TraceExporter is optional, it receives per-message traces of every round selecting messages for execution,
+	// see trace.NewFileExporter.
+	TraceExporter trace.Exporter
 }
 
 // NewExecutePluginFactory creates a new PluginFactory instance. For execute plugin, oracle instances are not managed by
@@ -111,6 +121,8 @@ func NewExecutePluginFactory(params PluginFactoryParams) *PluginFactory {
 		tokenDataEncoder: params.TokenDataEncoder,
 		contractReaders:  params.ContractReaders,
 		chainWriters:     params.ContractWriters,
+		messageSimulator: params.MessageSimulator,
+		traceExporter:    params.TraceExporter,
 	}
 }
 
@@ -128,6 +140,17 @@ func (p PluginFactory) NewReportingPlugin(
 		return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to validate exec offchain config: %w", err)
 	}
 
+	if offchainConfig.SourceChainSharding != nil {
+		fChain, err1 := p.homeChainReader.GetFChain()
+		if err1 != nil {
+			return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to get FChain: %w", err1)
+		}
+		err = offchainConfig.SourceChainSharding.ValidateFChain(fChain, p.ocrConfig.Config.ChainSelector)
+		if err != nil {
+			return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("invalid source chain sharding: %w", err)
+		}
+	}
+
 	var oracleIDToP2PID = make(map[commontypes.OracleID]ragep2ptypes.PeerID)
 	for oracleID, node := range p.ocrConfig.Config.Nodes {
 		oracleIDToP2PID[commontypes.OracleID(oracleID)] = node.P2pID
@@ -189,6 +212,8 @@ func (p PluginFactory) NewReportingPlugin(
 			lggr,
 			metricsReporter,
 			p.addrCodec,
+			p.messageSimulator,
+			p.traceExporter,
 		), ocr3types.ReportingPluginInfo{
 			Name: "CCIPRoleExecute",
 			Limits: ocr3types.ReportingPluginLimits{
@@ -85,6 +85,14 @@ var (
 		},
 		[]string{"chainID", "sourceChain", "method"},
 	)
+	PromContractAddressChanges = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ccip_exec_discovery_contract_address_changes",
+			Help: "This metric tracks the changes of the agreed contract addresses, including the ones refused " +
+				"by the allowlist",
+		},
+		[]string{"chainID", "contract", "contractChain", "kind"},
+	)
 )
 
 type PromReporter struct {
@@ -98,6 +106,7 @@ type PromReporter struct {
 	sequenceNumbers           *prometheus.GaugeVec
 	processorLatencyHistogram *prometheus.HistogramVec
 	processorErrors           *prometheus.CounterVec
+	contractAddressChanges    *prometheus.CounterVec
 }
 
 func NewPromReporter(lggr logger.Logger, selector cciptypes.ChainSelector) (*PromReporter, error) {
@@ -116,6 +125,7 @@ func NewPromReporter(lggr logger.Logger, selector cciptypes.ChainSelector) (*Pro
 		sequenceNumbers:           PromSequenceNumbers,
 		processorLatencyHistogram: PromExecProcessorLatencyHistogram,
 		processorErrors:           PromExecProcessorErrors,
+		contractAddressChanges:    PromContractAddressChanges,
 	}, nil
 }
 
@@ -180,6 +190,18 @@ func (p *PromReporter) TrackProcessorOutput(
 	// noop
 }
 
+func (p *PromReporter) TrackContractAddressChange(contract string, chain cciptypes.ChainSelector, kind string) {
+	contractChain, err := sel.GetChainIDFromSelector(uint64(chain))
+	if err != nil {
+		p.lggr.Errorw("failed to get chain ID from selector", "err", err)
+		return
+	}
+
+	p.contractAddressChanges.
+		WithLabelValues(p.chainID, contract, contractChain, kind).
+		Inc()
+}
+
 func (p *PromReporter) trackMaxSequenceNumber(
 	sourceChainSelector cciptypes.ChainSelector,
 	maxSeqNr int,
@@ -5,7 +5,9 @@ import (
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon/discovery"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
 // Reporter is a simple interface used for tracking observations and outcomes of the execution plugin.
@@ -19,6 +21,7 @@ type Reporter interface {
 	TrackLatency(state exectypes.PluginState, method plugincommon.MethodType, latency time.Duration, err error)
 	TrackProcessorOutput(string, plugincommon.MethodType, plugintypes.Trackable)
 	TrackProcessorLatency(processor string, method plugincommon.MethodType, latency time.Duration, err error)
+	TrackContractAddressChange(contract string, chain cciptypes.ChainSelector, kind string)
 }
 
 type Noop struct{}
@@ -33,5 +36,8 @@ func (n *Noop) TrackProcessorOutput(string, plugincommon.MethodType, plugintypes
 
 func (n *Noop) TrackProcessorLatency(string, plugincommon.MethodType, time.Duration, error) {}
 
+func (n *Noop) TrackContractAddressChange(string, cciptypes.ChainSelector, string) {}
+
 var _ Reporter = &Noop{}
 var _ Reporter = &PromReporter{}
+var _ discovery.MetricsReporter = &PromReporter{}
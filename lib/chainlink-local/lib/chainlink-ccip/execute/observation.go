@@ -7,12 +7,15 @@ import (
 	"sort"
 	"time"
 
+	mapset "github.com/deckarep/golang-set/v2"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
 	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
 	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/observer"
 	dt "github.com/smartcontractkit/chainlink-ccip/internal/plugincommon/discovery/discoverytypes"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
@@ -90,7 +93,7 @@ func (p *Plugin) Observation(
 	lggr.Debugw("Execute plugin performing observation", "state", state)
 	switch state {
 	case exectypes.GetCommitReports:
-		observation, err = p.getCommitReportsObservation(ctx, lggr, observation)
+		observation, err = p.getCommitReportsObservation(ctx, lggr, previousOutcome, observation)
 		if err != nil {
 			return nil, fmt.Errorf("getCommitReportsObservation: %w", err)
 		}
@@ -165,6 +168,7 @@ func (p *Plugin) getCurseInfo(ctx context.Context, lggr logger.Logger) (reader.C
 func (p *Plugin) getCommitReportsObservation(
 	ctx context.Context,
 	lggr logger.Logger,
+	previousOutcome exectypes.Outcome,
 	observation exectypes.Observation,
 ) (exectypes.Observation, error) {
 	// Get the optimized timestamp using the cache
@@ -192,6 +196,7 @@ func (p *Plugin) getCommitReportsObservation(
 		// The error is logged by getCurseInfo.
 		return observation, nil
 	}
+	p.invalidateCursedTokenData(ctx, lggr, ci, previousOutcome)
 	if ci.GlobalCurse || ci.CursedDestination {
 		lggr.Warnw("nothing to observe: rmn curse", "curseInfo", ci)
 		return observation, nil
@@ -236,6 +241,39 @@ func (p *Plugin) getCommitReportsObservation(
 	return observation, nil
 }
 
+// invalidateCursedTokenData drops the cached token data of the previous outcome messages from the cursed source
+// chains. A curse signals e.g. a finality violation on the source chain, the token data (attestations) observed
+// before might not be valid anymore, so they are fetched again once the curse is lifted.
+func (p *Plugin) invalidateCursedTokenData(
+	ctx context.Context,
+	lggr logger.Logger,
+	ci reader.CurseInfo,
+	previousOutcome exectypes.Outcome,
+) {
+	invalidator, ok := p.tokenDataObserver.(observer.TokenDataCacheInvalidator)
+	if !ok {
+		return
+	}
+
+	var msgIDs []cciptypes.Bytes32
+	for _, report := range previousOutcome.CommitReports {
+		if !ci.GlobalCurse && !ci.CursedSourceChains[report.SourceChain] {
+			continue
+		}
+		for _, msg := range report.Messages {
+			msgIDs = append(msgIDs, msg.Header.MessageID)
+		}
+	}
+	if len(msgIDs) == 0 {
+		return
+	}
+
+	lggr.Infow("invalidating token data of cursed source chains messages", "numMsgs", len(msgIDs))
+	if err := invalidator.Invalidate(ctx, msgIDs...); err != nil {
+		lggr.Warnw("failed to invalidate token data", "err", err)
+	}
+}
+
 // buildCombinedReports creates a combined map for updating the earliest unexecuted root
 func buildCombinedReports(
 	groupedCommits map[cciptypes.ChainSelector][]exectypes.CommitData,
@@ -369,12 +407,28 @@ func (p *Plugin) getMessagesObservation(
 		return exectypes.LessThan(commitData[i], commitData[j])
 	})
 
+	// With sharding, messages and token data are read only for the source chains of the oracle's shards.
+	var msgsReadingChains mapset.Set[cciptypes.ChainSelector]
+	if p.offchainCfg.SourceChainSharding != nil {
+		supportedChains, err := p.supportedChains(p.reportingCfg.OracleID)
+		if err != nil {
+			return exectypes.Observation{}, fmt.Errorf("unable to get supported chains: %w", err)
+		}
+		msgsReadingChains, err = p.msgsReadingChains(p.reportingCfg.OracleID, supportedChains)
+		if err != nil {
+			return exectypes.Observation{}, fmt.Errorf("unable to get message reading chains: %w", err)
+		}
+	}
+
 	stop := false
 
 	totalMsgs := 0
 	encodedObsSize := 0
 	for _, report := range commitData {
 		srcChain := report.SourceChain
+		if msgsReadingChains != nil && !msgsReadingChains.Contains(srcChain) {
+			continue
+		}
 
 		// Read messages for this report's sequence number range
 		msgs, err := p.readMessagesForReport(ctx, lggr, srcChain, report)
@@ -534,5 +588,55 @@ func (p *Plugin) getFilterObservation(
 
 		observation.Nonces = nonceObservations
 	}
+
+	if p.offchainCfg.EnableMessageSimulation && p.simulationCache != nil {
+		observation.SimulatedReverts = p.observeSimulatedReverts(ctx, lggr, previousOutcome.CommitReports)
+	}
 	return observation, nil
 }
+
+// observeSimulatedReverts dry-runs the execution of the pending messages and returns the sequence numbers of the
+// messages whose execution reverts. Messages which can't be simulated are not reported, the decision whether to
+// skip a message is made by the consensus of the oracles in the outcome.
+func (p *Plugin) observeSimulatedReverts(
+	ctx context.Context,
+	lggr logger.Logger,
+	commitReports []exectypes.CommitData,
+) exectypes.SimulatedRevertObservations {
+	simulatedReverts := make(exectypes.SimulatedRevertObservations)
+	for _, report := range commitReports {
+		if len(report.Hashes) != len(report.Messages) || len(report.MessageTokenData) != len(report.Messages) {
+			lggr.Errorw("unable to simulate messages, hashes or token data missing",
+				"sourceChain", report.SourceChain, "merkleRoot", report.MerkleRoot)
+			continue
+		}
+
+		for i, msg := range report.Messages {
+			// Pseudo-deleted messages and messages without token data are not executed in this round anyway.
+			if msg.IsPseudoDeleted() || !report.MessageTokenData[i].IsReady() {
+				continue
+			}
+
+			result, err := p.simulationCache.Simulate(
+				ctx, report.Hashes[i], msg, report.MessageTokenData[i].ToByteSlice())
+			if err != nil {
+				lggr.Warnw("unable to simulate message execution",
+					"messageID", msg.Header.MessageID,
+					"sourceChain", report.SourceChain,
+					"seqNum", msg.Header.SequenceNumber,
+					"err", err)
+				continue
+			}
+			if result.Reverted {
+				lggr.Infow("simulated message execution reverts",
+					"messageID", msg.Header.MessageID,
+					"sourceChain", report.SourceChain,
+					"seqNum", msg.Header.SequenceNumber,
+					"revertReason", result.RevertReason)
+				simulatedReverts[report.SourceChain] = append(simulatedReverts[report.SourceChain],
+					msg.Header.SequenceNumber)
+			}
+		}
+	}
+	return simulatedReverts
+}
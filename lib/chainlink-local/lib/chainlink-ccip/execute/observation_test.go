@@ -22,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/mocks/internal_/reader"
 	codec_mock "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/ocrtypecodec/v1"
 	readerpkg_mock "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/reader"
+	readerpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
@@ -671,3 +672,60 @@ func Test_getMessagesObservation(t *testing.T) {
 		})
 	}
 }
+
+// invalidatingTokenDataObserver records the invalidated messages.
+type invalidatingTokenDataObserver struct {
+	observer.NoopTokenDataObserver
+	invalidated []cciptypes.Bytes32
+}
+
+func (o *invalidatingTokenDataObserver) Invalidate(_ context.Context, msgIDs ...cciptypes.Bytes32) error {
+	o.invalidated = append(o.invalidated, msgIDs...)
+	return nil
+}
+
+func Test_invalidateCursedTokenData(t *testing.T) {
+	msgWithID := func(id byte) cciptypes.Message {
+		var msg cciptypes.Message
+		msg.Header.MessageID = cciptypes.Bytes32{id}
+		return msg
+	}
+	previousOutcome := exectypes.Outcome{
+		State: exectypes.Filter,
+		CommitReports: []exectypes.CommitData{
+			{SourceChain: 1, Messages: []cciptypes.Message{msgWithID(1), msgWithID(2)}},
+			{SourceChain: 2, Messages: []cciptypes.Message{msgWithID(3)}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		ci       readerpkg.CurseInfo
+		expected []cciptypes.Bytes32
+	}{
+		{
+			name: "no curse",
+			ci:   readerpkg.CurseInfo{CursedSourceChains: map[cciptypes.ChainSelector]bool{}},
+		},
+		{
+			name:     "cursed source chain",
+			ci:       readerpkg.CurseInfo{CursedSourceChains: map[cciptypes.ChainSelector]bool{2: true}},
+			expected: []cciptypes.Bytes32{{3}},
+		},
+		{
+			name:     "global curse",
+			ci:       readerpkg.CurseInfo{GlobalCurse: true},
+			expected: []cciptypes.Bytes32{{1}, {2}, {3}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokenDataObserver := &invalidatingTokenDataObserver{}
+			plugin := &Plugin{lggr: mocks.NullLogger, tokenDataObserver: tokenDataObserver}
+
+			plugin.invalidateCursedTokenData(context.Background(), plugin.lggr, tt.ci, previousOutcome)
+			require.Equal(t, tt.expected, tokenDataObserver.invalidated)
+		})
+	}
+}
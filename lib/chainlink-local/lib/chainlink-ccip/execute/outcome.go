@@ -14,6 +14,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
 	"github.com/smartcontractkit/chainlink-ccip/execute/report"
+	"github.com/smartcontractkit/chainlink-ccip/execute/trace"
 	"github.com/smartcontractkit/chainlink-ccip/internal/libs/slicelib"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	dt "github.com/smartcontractkit/chainlink-ccip/internal/plugincommon/discovery/discoverytypes"
@@ -78,6 +79,16 @@ func (p *Plugin) Outcome(
 		outcome = p.getCommitReportsOutcome(observation)
 	case exectypes.GetMessages:
 		outcome = p.getMessagesOutcome(lggr, observation)
+		if p.offchainCfg.EnableFastPath && !p.offchainCfg.EnableMessageSimulation && canSkipFilter(outcome) {
+			// Nothing to observe in the Filter round, the outcome becomes the Filter outcome with the report.
+			lggr.Infow("no nonce or token data dependencies, skipping the filter round")
+			state = exectypes.Filter
+			outcome, err = p.getFilterOutcome(ctx, lggr, observation, outcome)
+			if err != nil {
+				lggr.Errorw("get filter outcome", "err", err)
+				return nil, nil
+			}
+		}
 	case exectypes.Filter:
 		outcome, err = p.getFilterOutcome(ctx, lggr, observation, previousOutcome)
 		if err != nil {
@@ -175,13 +186,12 @@ func (p *Plugin) getFilterOutcome(
 ) (exectypes.Outcome, error) {
 	commitReports := previousOutcome.CommitReports
 
-	builder := report.NewBuilder(
-		lggr,
-		p.msgHasher,
-		p.reportCodec,
-		p.estimateProvider,
-		p.destChain,
-		p.addrCodec,
+	selectionStrategy, err := report.NewSelectionStrategy(p.offchainCfg, p.addrCodec)
+	if err != nil {
+		return exectypes.Outcome{}, fmt.Errorf("unable to create message selection strategy: %w", err)
+	}
+
+	builderOpts := []report.Option{
 		report.WithMaxReportSizeBytes(maxReportLength),
 		report.WithMaxGas(p.offchainCfg.BatchGasLimit),
 		report.WithExtraMessageCheck(report.CheckNonces(observation.Nonces, p.addrCodec)),
@@ -189,6 +199,27 @@ func (p *Plugin) getFilterOutcome(
 		report.WithExtraMessageCheck(report.CheckIfInflight(p.inflightMessageCache.IsInflight)),
 		report.WithMaxMessages(p.offchainCfg.MaxReportMessages),
 		report.WithMaxSingleChainReports(p.offchainCfg.MaxSingleChainReports),
+		report.WithSelectionStrategy(selectionStrategy),
+	}
+	if p.offchainCfg.EnableMessageSimulation {
+		builderOpts = append(builderOpts,
+			report.WithExtraMessageCheck(report.CheckSimulatedReverts(observation.SimulatedReverts)))
+	}
+
+	var traceRound *trace.Round
+	if p.traceExporter != nil {
+		traceRound = trace.NewRound(logutil.GetSeqNr(ctx))
+		builderOpts = append(builderOpts, report.WithTrace(traceRound))
+	}
+
+	builder := report.NewBuilder(
+		lggr,
+		p.msgHasher,
+		p.reportCodec,
+		p.estimateProvider,
+		p.destChain,
+		p.addrCodec,
+		builderOpts...,
 	)
 
 	outcomeReports, selectedCommitReports, err := selectReport(
@@ -196,6 +227,11 @@ func (p *Plugin) getFilterOutcome(
 		lggr,
 		commitReports,
 		builder)
+	if traceRound != nil {
+		if errExport := p.traceExporter.Export(ctx, traceRound.Trace()); errExport != nil {
+			lggr.Warnw("unable to export message traces", "err", errExport)
+		}
+	}
 	if err != nil {
 		return exectypes.Outcome{}, fmt.Errorf("unable to select report: %w", err)
 	}
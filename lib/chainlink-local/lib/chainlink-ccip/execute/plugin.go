@@ -32,6 +32,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/execute/metrics"
 	"github.com/smartcontractkit/chainlink-ccip/execute/report"
 	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/observer"
+	"github.com/smartcontractkit/chainlink-ccip/execute/trace"
 	"github.com/smartcontractkit/chainlink-ccip/internal/libs/slicelib"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon/discovery"
@@ -41,6 +42,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
 	ocrtypecodec "github.com/smartcontractkit/chainlink-ccip/pkg/ocrtypecodec/v1"
+	ocrtypecodecv2 "github.com/smartcontractkit/chainlink-ccip/pkg/ocrtypecodec/v2"
 	readerpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
@@ -88,6 +90,10 @@ type Plugin struct {
 	commitRootsCache cache.CommitsRootsCache
 	// inflightMessageCache prevents duplicate reports from being sent for the same message.
 	inflightMessageCache inflightMessageCache
+	// simulationCache dry-runs the messages observed in the Filter round, nil if no message simulator is provided.
+	simulationCache *report.SimulationCache
+	// traceExporter receives the per-message traces of every Filter round, nil if tracing is disabled.
+	traceExporter trace.Exporter
 }
 
 func NewPlugin(
@@ -105,10 +111,12 @@ func NewPlugin(
 	lggr logger.Logger,
 	metricsReporter metrics.Reporter,
 	addrCodec cciptypes.AddressCodec,
+	messageSimulator report.MessageSimulator,
+	traceExporter trace.Exporter,
 ) ocr3types.ReportingPlugin[[]byte] {
 	lggr.Infow("creating new plugin instance", "p2pID", oracleIDToP2pID[reportingCfg.OracleID])
 
-	ocrTypCodec := ocrtypecodec.DefaultExecCodec
+	ocrTypCodec := ocrtypecodecv2.NewExecCodec(ocrtypecodecv2.Version(offchainCfg.OCRCodecVersion))
 	p := &Plugin{
 		donID:             donID,
 		reportingCfg:      reportingCfg,
@@ -130,6 +138,7 @@ func NewPlugin(
 			reportingCfg.F,
 			oracleIDToP2pID,
 			metricsReporter,
+			discovery.WithAddressAllowlist(offchainCfg.ContractAddressAllowlist),
 		),
 		chainSupport: plugincommon.NewChainSupport(
 			logutil.WithComponent(lggr, "ChainSupport"),
@@ -147,6 +156,11 @@ func NewPlugin(
 		inflightMessageCache: cache.NewInflightMessageCache(offchainCfg.InflightCacheExpiry.Duration()),
 		ocrTypeCodec:         ocrTypCodec,
 		addrCodec:            addrCodec,
+		traceExporter:        traceExporter,
+	}
+	if messageSimulator != nil {
+		p.simulationCache = report.NewSimulationCache(
+			messageSimulator, offchainCfg.MessageSimulationCacheExpiry.Duration())
 	}
 	return NewTrackedPlugin(p, lggr, metricsReporter, ocrTypCodec)
 }
@@ -379,9 +393,18 @@ func (p *Plugin) ValidateObservation(
 		return err
 	}
 
+	err = validateSimulatedReverts(nextState, supportedChains, p.destChain, decodedObservation.SimulatedReverts)
+	if err != nil {
+		return fmt.Errorf("validate simulated reverts: %w", err)
+	}
+
 	// check message related validations when states can contain messages
 	if nextState == exectypes.GetMessages || nextState == exectypes.Filter {
-		if err = validateMsgsReadingEligibility(supportedChains, decodedObservation.Messages); err != nil {
+		msgsReadingChains, err := p.msgsReadingChains(ao.Observer, supportedChains)
+		if err != nil {
+			return fmt.Errorf("error finding message reading chains by node: %w", err)
+		}
+		if err = validateMsgsReadingEligibility(msgsReadingChains, decodedObservation.Messages); err != nil {
 			return fmt.Errorf("validate observer reading eligibility: %w", err)
 		}
 
@@ -450,6 +473,26 @@ func validateNoMessageRelatedObservations(
 	return nil
 }
 
+// validateSimulatedReverts checks that the message simulations are observed only in the Filter state and only by
+// the oracles supporting the destination chain, where the messages are simulated.
+func validateSimulatedReverts(
+	state exectypes.PluginState,
+	supportedChains mapset.Set[cciptypes.ChainSelector],
+	destChain cciptypes.ChainSelector,
+	simulatedReverts exectypes.SimulatedRevertObservations,
+) error {
+	if len(simulatedReverts) == 0 {
+		return nil
+	}
+	if state != exectypes.Filter {
+		return fmt.Errorf("simulated reverts are not expected in %s state", state)
+	}
+	if !supportedChains.Contains(destChain) {
+		return fmt.Errorf("simulated reverts observed by an oracle not supporting the destination chain %d", destChain)
+	}
+	return nil
+}
+
 func validateMessagesRelatedObservations(
 	commitReports exectypes.CommitObservations,
 	messages exectypes.MessageObservations,
@@ -516,7 +559,7 @@ func selectReport(
 		}
 	}
 
-	execReports, selectedReports, err := builder.Build()
+	execReports, selectedReports, err := builder.Build(ctx)
 
 	lggr.Debugw("selected report to be executed", "reports", selectedReports)
 	lggr.Infow(
@@ -64,6 +64,100 @@ func TestPlugin(t *testing.T) {
 	require.ElementsMatch(t, sequenceNumbers, []cciptypes.SeqNum{102, 103, 104, 105})
 }
 
+func TestPlugin_FastPath(t *testing.T) {
+	ctx := tests.Context(t)
+
+	srcSelector := cciptypes.ChainSelector(1)
+	dstSelector := cciptypes.ChainSelector(2)
+
+	messages := []inmem.MessagesWithMetadata{
+		makeMsgWithMetadata(100, srcSelector, dstSelector, true),
+		makeMsgWithMetadata(101, srcSelector, dstSelector, false),
+		makeMsgWithMetadata(102, srcSelector, dstSelector, false),
+	}
+	orderedMessages := []inmem.MessagesWithMetadata{
+		makeMsgWithMetadata(200, srcSelector, dstSelector, false, withNonce(1)),
+	}
+
+	intTest := SetupSimpleTest(t, logger.Test(t), []cciptypes.ChainSelector{srcSelector}, dstSelector)
+	intTest.WithMessages(messages, 1000, time.Now().Add(-4*time.Hour), 1, srcSelector)
+	intTest.WithFastPath()
+	runner := intTest.Start()
+	defer intTest.Close()
+
+	// Contract Discovery round.
+	outcome := runRoundAndGetOutcome(ctx, ocrTypeCodec, t, runner)
+	require.Equal(t, exectypes.Initialized, outcome.State)
+
+	// Round 1 - Get Commit Reports
+	outcome = runRoundAndGetOutcome(ctx, ocrTypeCodec, t, runner)
+	require.Equal(t, exectypes.GetCommitReports, outcome.State)
+	require.Len(t, outcome.CommitReports, 1)
+
+	// Round 2 - Get Messages
+	// There are no ordered messages and no tokens, so the report is built without the Filter round.
+	outcome = runRoundAndGetOutcome(ctx, ocrTypeCodec, t, runner)
+	require.Equal(t, exectypes.Filter, outcome.State)
+	require.Len(t, outcome.Report.ChainReports, 1)
+	sequenceNumbers := extractSequenceNumbers(outcome.Report.ChainReports[0].Messages)
+	require.ElementsMatch(t, sequenceNumbers, []cciptypes.SeqNum{101, 102})
+
+	// Ordered message needs the Filter round to observe the sender nonce.
+	intTest.WithMessages(orderedMessages, 1001, time.Now().Add(-3*time.Hour), 1, srcSelector)
+
+	// Round 3 - Get Commit Reports
+	// Messages of the first report are inflight, only the new report is pending.
+	outcome = runRoundAndGetOutcome(ctx, ocrTypeCodec, t, runner)
+	require.Equal(t, exectypes.GetCommitReports, outcome.State)
+
+	// Round 4 - Get Messages
+	outcome = runRoundAndGetOutcome(ctx, ocrTypeCodec, t, runner)
+	require.Equal(t, exectypes.GetMessages, outcome.State)
+	require.Len(t, outcome.Report.ChainReports, 0)
+}
+
+func TestPlugin_SourceChainSharding(t *testing.T) {
+	ctx := tests.Context(t)
+
+	srcSelectors := []cciptypes.ChainSelector{1, 2, 3}
+	dstSelector := cciptypes.ChainSelector(10)
+
+	intTest := SetupSimpleTest(t, logger.Test(t), srcSelectors, dstSelector)
+	for i, src := range srcSelectors {
+		messages := []inmem.MessagesWithMetadata{
+			makeMsgWithMetadata(100, src, dstSelector, false),
+			makeMsgWithMetadata(101, src, dstSelector, false),
+		}
+		intTest.WithMessages(messages, uint64(1000+i), time.Now().Add(-4*time.Hour), 1, src)
+	}
+	// 7 oracles, messages of every source chain are read by 3 of them.
+	intTest.WithSourceChainSharding(3, 7)
+	runner := intTest.Start()
+	defer intTest.Close()
+
+	// Contract Discovery round.
+	outcome := runRoundAndGetOutcome(ctx, ocrTypeCodec, t, runner)
+	require.Equal(t, exectypes.Initialized, outcome.State)
+
+	// Round 1 - Get Commit Reports
+	// Commit reports are observed by all the oracles.
+	outcome = runRoundAndGetOutcome(ctx, ocrTypeCodec, t, runner)
+	require.Len(t, outcome.CommitReports, len(srcSelectors))
+
+	// Round 2 - Get Messages
+	// Messages are observed by the shards only, observations of every oracle are valid.
+	outcome = runRoundAndGetOutcome(ctx, ocrTypeCodec, t, runner)
+	require.Len(t, outcome.CommitReports, len(srcSelectors))
+
+	// Round 3 - Filter
+	// Messages of all the source chains reach the consensus and are executed.
+	outcome = runRoundAndGetOutcome(ctx, ocrTypeCodec, t, runner)
+	require.Len(t, outcome.Report.ChainReports, len(srcSelectors))
+	for _, chainReport := range outcome.Report.ChainReports {
+		require.ElementsMatch(t, []cciptypes.SeqNum{100, 101}, extractSequenceNumbers(chainReport.Messages))
+	}
+}
+
 // Testing first scenario from the diagram:
 // TODO: add diagram in github instead of using external link
 // https://app.excalidraw.com/l/AdjkJ3DaenS/84EpHxkgbND
@@ -756,6 +756,52 @@ func computeNoncesConsensus(
 	return consensusNonces
 }
 
+// computeSimulatedRevertsConsensus returns the messages whose simulated execution reverts according to more than
+// fChainDest oracles, i.e. according to at least one honest oracle.
+func computeSimulatedRevertsConsensus(
+	lggr logger.Logger,
+	observations []plugincommon.AttributedObservation[exectypes.Observation],
+	fChainDest int,
+) exectypes.SimulatedRevertObservations {
+	type chainSeqNumPair struct {
+		chain  cciptypes.ChainSelector
+		seqNum cciptypes.SeqNum
+	}
+
+	revertCounts := make(map[chainSeqNumPair]int)
+	for _, obs := range observations {
+		// an oracle is counted once per message, even if the message is observed multiple times.
+		observed := make(map[chainSeqNumPair]struct{})
+		for chain, seqNums := range obs.Observation.SimulatedReverts {
+			for _, seqNum := range seqNums {
+				observed[chainSeqNumPair{chain: chain, seqNum: seqNum}] = struct{}{}
+			}
+		}
+		for pair := range observed {
+			revertCounts[pair]++
+		}
+	}
+
+	var consensusReverts exectypes.SimulatedRevertObservations
+	for pair, count := range revertCounts {
+		if count <= fChainDest {
+			lggr.Debugw("no consensus on simulated revert",
+				"chain", pair.chain, "seqNum", pair.seqNum, "count", count)
+			continue
+		}
+		if consensusReverts == nil {
+			consensusReverts = make(exectypes.SimulatedRevertObservations)
+		}
+		consensusReverts[pair.chain] = append(consensusReverts[pair.chain], pair.seqNum)
+	}
+
+	for _, seqNums := range consensusReverts {
+		sort.Slice(seqNums, func(i, j int) bool { return seqNums[i] < seqNums[j] })
+	}
+
+	return consensusReverts
+}
+
 // computeConsensusObservation aggregates multiple attributed observations to produce a single consensus observation.
 // The provided f is required for computing the consensus on fChain prior to computing the observation consensus.
 func computeConsensusObservation(
@@ -779,6 +825,7 @@ func computeConsensusObservation(
 		dt.Observation{},
 		computeMessageHashesConsensus(lggr, observations, fChain),
 	)
+	consensusObservation.SimulatedReverts = computeSimulatedRevertsConsensus(lggr, observations, destFChain)
 
 	lggr.Debugw("computeConsensusObservation has finished computing the consensus observation",
 		"fChain", fChain,
@@ -810,3 +857,26 @@ func getConsensusFChain(
 
 	return fChain
 }
+
+// canSkipFilter returns true if the messages of the GetMessages outcome don't depend on any data observed in
+// the Filter round, so the report can be built right away. Ordered messages (non-zero nonce) need the sender nonces
+// and messages with tokens need their token data ready.
+func canSkipFilter(outcome exectypes.Outcome) bool {
+	if len(outcome.CommitReports) == 0 {
+		return false
+	}
+	for _, report := range outcome.CommitReports {
+		for i, msg := range report.Messages {
+			if msg.IsPseudoDeleted() {
+				continue
+			}
+			if msg.Header.Nonce != 0 {
+				return false
+			}
+			if len(msg.TokenAmounts) > 0 && (i >= len(report.MessageTokenData) || !report.MessageTokenData[i].IsReady()) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -1699,6 +1699,82 @@ func Test_computeNoncesConsensus(t *testing.T) {
 	}
 }
 
+func Test_computeSimulatedRevertsConsensus(t *testing.T) {
+	lggr := logger.Test(t)
+
+	testCases := []struct {
+		name                string
+		allRevertObs        []exectypes.SimulatedRevertObservations
+		fChain              int
+		expRevertsConsensus exectypes.SimulatedRevertObservations
+	}{
+		{
+			name:                "empty",
+			allRevertObs:        []exectypes.SimulatedRevertObservations{},
+			fChain:              1,
+			expRevertsConsensus: nil,
+		},
+		{
+			name: "one observation does not reach threshold",
+			allRevertObs: []exectypes.SimulatedRevertObservations{
+				{1: {10}},
+				{},
+				{},
+			},
+			fChain:              1,
+			expRevertsConsensus: nil,
+		},
+		{
+			name: "duplicate seqNums are counted once per oracle",
+			allRevertObs: []exectypes.SimulatedRevertObservations{
+				{1: {10, 10}},
+				{},
+			},
+			fChain:              1,
+			expRevertsConsensus: nil,
+		},
+		{
+			name: "reverts reaching threshold are sorted",
+			allRevertObs: []exectypes.SimulatedRevertObservations{
+				{1: {12, 10, 11}, 2: {20}},
+				{1: {10, 12}, 2: {20}},
+				{1: {12}},
+			},
+			fChain: 1,
+			expRevertsConsensus: exectypes.SimulatedRevertObservations{
+				1: {10, 12},
+				2: {20},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			observations := make([]plugincommon.AttributedObservation[exectypes.Observation], len(tc.allRevertObs))
+			for i, obs := range tc.allRevertObs {
+				observations[i] = plugincommon.AttributedObservation[exectypes.Observation]{
+					Observation: exectypes.Observation{SimulatedReverts: obs},
+					OracleID:    commontypes.OracleID(i),
+				}
+			}
+			obs := computeSimulatedRevertsConsensus(lggr, observations, tc.fChain)
+			assert.Equal(t, tc.expRevertsConsensus, obs)
+		})
+	}
+}
+
+func Test_validateSimulatedReverts(t *testing.T) {
+	destChain := cciptypes.ChainSelector(1)
+	supportsDest := mapset.NewSet(destChain)
+	noDest := mapset.NewSet[cciptypes.ChainSelector](2)
+	reverts := exectypes.SimulatedRevertObservations{2: {10}}
+
+	assert.NoError(t, validateSimulatedReverts(exectypes.GetMessages, noDest, destChain, nil))
+	assert.NoError(t, validateSimulatedReverts(exectypes.Filter, supportsDest, destChain, reverts))
+	assert.Error(t, validateSimulatedReverts(exectypes.GetMessages, supportsDest, destChain, reverts))
+	assert.Error(t, validateSimulatedReverts(exectypes.Filter, noDest, destChain, reverts))
+}
+
 func Test_computeMessageHashesConsensus(t *testing.T) {
 	testCases := []struct {
 		name           string
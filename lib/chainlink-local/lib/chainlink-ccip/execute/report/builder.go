@@ -8,6 +8,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/execute/trace"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
@@ -15,7 +16,7 @@ var _ ExecReportBuilder = &execReportBuilder{}
 
 type ExecReportBuilder interface {
 	Add(ctx context.Context, report exectypes.CommitData) (exectypes.CommitData, error)
-	Build() ([]cciptypes.ExecutePluginReportSingleChain, []exectypes.CommitData, error)
+	Build(ctx context.Context) ([]cciptypes.ExecutePluginReportSingleChain, []exectypes.CommitData, error)
 }
 
 // Option that can be passed to the builder.
@@ -49,6 +50,14 @@ func WithMaxSingleChainReports(maxSingleChainReports uint64) Option {
 	}
 }
 
+// WithSelectionStrategy configures the order in which the ready messages of all the added commit reports are packed
+// into the report. By default, messages are packed report by report in the order the reports were added.
+func WithSelectionStrategy(strategy SelectionStrategy) Option {
+	return func(erb *execReportBuilder) {
+		erb.strategy = strategy
+	}
+}
+
 // WithExtraMessageCheck adds additional message checks to the default ones.
 func WithExtraMessageCheck(check Check) Option {
 	return func(erb *execReportBuilder) {
@@ -56,6 +65,13 @@ func WithExtraMessageCheck(check Check) Option {
 	}
 }
 
+// WithTrace records the status of every checked message and whether it was included in the report.
+func WithTrace(round *trace.Round) Option {
+	return func(erb *execReportBuilder) {
+		erb.trace = round
+	}
+}
+
 func newBuilderInternal(
 	logger logger.Logger,
 	hasher cciptypes.MessageHasher,
@@ -132,9 +148,13 @@ type execReportBuilder struct {
 	maxGas                uint64
 	maxMessages           uint64
 	maxSingleChainReports uint64
+	strategy              SelectionStrategy
+	trace                 *trace.Round
 
 	// State
 	accumulated validationMetadata
+	// pending commit reports with ready messages, used only with the selection strategy
+	pending []pendingReport
 
 	// Result
 	execReports   []cciptypes.ExecutePluginReportSingleChain
@@ -148,6 +168,11 @@ func (b *execReportBuilder) Add(
 	ctx context.Context,
 	commitReport exectypes.CommitData,
 ) (exectypes.CommitData, error) {
+	if b.strategy != nil {
+		// messages are selected across all the reports when building
+		return commitReport, b.addPending(ctx, commitReport)
+	}
+
 	execReport, updatedReport, err := b.buildSingleChainReport(ctx, commitReport)
 
 	// No messages fit into the report, move to next report
@@ -164,9 +189,15 @@ func (b *execReportBuilder) Add(
 	return updatedReport, nil
 }
 
-func (b *execReportBuilder) Build() (
+func (b *execReportBuilder) Build(ctx context.Context) (
 	[]cciptypes.ExecutePluginReportSingleChain, []exectypes.CommitData, error,
 ) {
+	if b.strategy != nil {
+		if err := b.buildWithStrategy(ctx); err != nil {
+			return nil, nil, fmt.Errorf("unable to select messages: %w", err)
+		}
+	}
+
 	if len(b.execReports) != len(b.commitReports) {
 		return nil, nil, fmt.Errorf(
 			"expected the same number of exec and commit reports, got %d and %d",
@@ -186,6 +217,14 @@ func (b *execReportBuilder) Build() (
 		b.commitReports = b.commitReports[:b.maxSingleChainReports]
 	}
 
+	if b.trace != nil {
+		for _, execReport := range b.execReports {
+			for _, msg := range execReport.Messages {
+				b.trace.MessageIncluded(execReport.SourceChainSelector, msg.Header.SequenceNumber)
+			}
+		}
+	}
+
 	b.lggr.Infow(
 		"selected commit reports for execution report",
 		"numReports", len(b.execReports),
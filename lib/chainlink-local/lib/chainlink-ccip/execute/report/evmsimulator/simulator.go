@@ -0,0 +1,159 @@
+// Package evmsimulator provides a report.MessageSimulator for EVM destination chains.
+//
+// Messages are simulated by calling OffRamp.executeSingleMessage with the OffRamp as the sender, which is the call
+// the OffRamp makes to itself for every message of an execution report. The call reverts with the receiver or token
+// pool error if the message execution would fail, without the merkle proof and the execution state checks.
+package evmsimulator
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/latest/offramp"
+	"github.com/smartcontractkit/chainlink-ccip/execute/report"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+const (
+	methodExecuteSingleMessage = "executeSingleMessage"
+	revertedErrSubstring       = "execution reverted"
+
+	// extraArgsTagLen is the length of the tag prefixing the EVM extra args, the gas limit is the first abi encoded
+	// word after the tag in all the EVMExtraArgs versions.
+	extraArgsTagLen = 4
+	abiWordLen      = 32
+)
+
+// SimulateTxFunc dry-runs a transaction on the destination chain and returns its error, e.g. the
+// "execution reverted" error of eth_estimateGas. With the chainlink-evm client it's backed by
+// client.Client.CheckTxValidity, the *SendError must be converted to a nil error when there is no error:
+//
+//	func(ctx context.Context, from, to common.Address, data []byte) error {
+//		if err := evmClient.CheckTxValidity(ctx, from, to, data); err != nil {
+//			return err
+//		}
+//		return nil
+//	}
+type SimulateTxFunc func(ctx context.Context, from, to common.Address, data []byte) error
+
+// MessageSimulator simulates the execution of messages through the OffRamp of an EVM destination chain.
+type MessageSimulator struct {
+	offRamp    common.Address
+	offRampABI *abi.ABI
+	simulateTx SimulateTxFunc
+}
+
+var _ report.MessageSimulator = (*MessageSimulator)(nil)
+
+// NewMessageSimulator creates a MessageSimulator for the given OffRamp address.
+func NewMessageSimulator(offRamp common.Address, simulateTx SimulateTxFunc) (*MessageSimulator, error) {
+	if simulateTx == nil {
+		return nil, errors.New("simulateTx is required")
+	}
+	offRampABI, err := offramp.OffRampMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("get OffRamp ABI: %w", err)
+	}
+	return &MessageSimulator{
+		offRamp:    offRamp,
+		offRampABI: offRampABI,
+		simulateTx: simulateTx,
+	}, nil
+}
+
+// SimulateMessage implements report.MessageSimulator.
+func (s *MessageSimulator) SimulateMessage(
+	ctx context.Context, msg cciptypes.Message, offchainTokenData [][]byte,
+) (report.SimulationResult, error) {
+	evmMsg, err := toAny2EVMMessage(msg)
+	if err != nil {
+		return report.SimulationResult{}, fmt.Errorf("convert message %s: %w", msg.Header.MessageID, err)
+	}
+	if offchainTokenData == nil {
+		offchainTokenData = make([][]byte, len(msg.TokenAmounts))
+	}
+
+	data, err := s.offRampABI.Pack(methodExecuteSingleMessage, evmMsg, offchainTokenData, []uint32{})
+	if err != nil {
+		return report.SimulationResult{}, fmt.Errorf("pack %s: %w", methodExecuteSingleMessage, err)
+	}
+
+	err = s.simulateTx(ctx, s.offRamp, s.offRamp, data)
+	if err == nil {
+		return report.SimulationResult{}, nil
+	}
+	if strings.Contains(strings.ToLower(err.Error()), revertedErrSubstring) {
+		return report.SimulationResult{Reverted: true, RevertReason: err.Error()}, nil
+	}
+	return report.SimulationResult{}, fmt.Errorf("simulate message %s: %w", msg.Header.MessageID, err)
+}
+
+func toAny2EVMMessage(msg cciptypes.Message) (offramp.InternalAny2EVMRampMessage, error) {
+	if len(msg.Receiver) != common.AddressLength {
+		return offramp.InternalAny2EVMRampMessage{}, fmt.Errorf("invalid receiver length %d", len(msg.Receiver))
+	}
+	gasLimit, err := decodeGasLimit(msg.ExtraArgs)
+	if err != nil {
+		return offramp.InternalAny2EVMRampMessage{}, err
+	}
+
+	tokenAmounts := make([]offramp.InternalAny2EVMTokenTransfer, 0, len(msg.TokenAmounts))
+	for _, tokenAmount := range msg.TokenAmounts {
+		if len(tokenAmount.DestTokenAddress) != common.AddressLength {
+			return offramp.InternalAny2EVMRampMessage{}, fmt.Errorf(
+				"invalid dest token address length %d", len(tokenAmount.DestTokenAddress))
+		}
+		if tokenAmount.Amount.Int == nil {
+			return offramp.InternalAny2EVMRampMessage{}, errors.New("token amount is not set")
+		}
+		destGasAmount, errDecode := decodeDestGasAmount(tokenAmount.DestExecData)
+		if errDecode != nil {
+			return offramp.InternalAny2EVMRampMessage{}, errDecode
+		}
+		tokenAmounts = append(tokenAmounts, offramp.InternalAny2EVMTokenTransfer{
+			SourcePoolAddress: tokenAmount.SourcePoolAddress,
+			DestTokenAddress:  common.BytesToAddress(tokenAmount.DestTokenAddress),
+			DestGasAmount:     destGasAmount,
+			ExtraData:         tokenAmount.ExtraData,
+			Amount:            tokenAmount.Amount.Int,
+		})
+	}
+
+	return offramp.InternalAny2EVMRampMessage{
+		Header: offramp.InternalRampMessageHeader{
+			MessageId:           msg.Header.MessageID,
+			SourceChainSelector: uint64(msg.Header.SourceChainSelector),
+			DestChainSelector:   uint64(msg.Header.DestChainSelector),
+			SequenceNumber:      uint64(msg.Header.SequenceNumber),
+			Nonce:               msg.Header.Nonce,
+		},
+		Sender:       msg.Sender,
+		Data:         msg.Data,
+		Receiver:     common.BytesToAddress(msg.Receiver),
+		GasLimit:     gasLimit,
+		TokenAmounts: tokenAmounts,
+	}, nil
+}
+
+// decodeGasLimit returns the gas limit of the EVM extra args, the first word after the version tag.
+func decodeGasLimit(extraArgs []byte) (*big.Int, error) {
+	if len(extraArgs) < extraArgsTagLen+abiWordLen {
+		return nil, fmt.Errorf("invalid extra args length %d", len(extraArgs))
+	}
+	return new(big.Int).SetBytes(extraArgs[extraArgsTagLen : extraArgsTagLen+abiWordLen]), nil
+}
+
+// decodeDestGasAmount returns the abi encoded uint32 dest gas amount of the token transfer dest exec data.
+func decodeDestGasAmount(destExecData []byte) (uint32, error) {
+	if len(destExecData) != abiWordLen {
+		return 0, fmt.Errorf("invalid dest exec data length %d", len(destExecData))
+	}
+	return binary.BigEndian.Uint32(destExecData[abiWordLen-4:]), nil
+}
@@ -0,0 +1,86 @@
+package evmsimulator
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+func TestMessageSimulator_SimulateMessage(t *testing.T) {
+	ctx := context.Background()
+	offRamp := common.HexToAddress("0x01")
+
+	extraArgs := make([]byte, extraArgsTagLen+2*abiWordLen)
+	extraArgs[extraArgsTagLen+abiWordLen-1] = 200
+	destExecData := make([]byte, abiWordLen)
+	destExecData[abiWordLen-1] = 50
+
+	msg := cciptypes.Message{
+		Header: cciptypes.RampMessageHeader{
+			MessageID:           cciptypes.Bytes32{1},
+			SourceChainSelector: 10,
+			DestChainSelector:   20,
+			SequenceNumber:      5,
+		},
+		Sender:    cciptypes.UnknownAddress{0xa},
+		Data:      cciptypes.Bytes{0xb},
+		Receiver:  common.HexToAddress("0x02").Bytes(),
+		ExtraArgs: extraArgs,
+		TokenAmounts: []cciptypes.RampTokenAmount{
+			{
+				SourcePoolAddress: cciptypes.UnknownAddress{0xc},
+				DestTokenAddress:  common.HexToAddress("0x03").Bytes(),
+				Amount:            cciptypes.NewBigInt(big.NewInt(100)),
+				DestExecData:      destExecData,
+			},
+		},
+	}
+
+	var simulatedData []byte
+	var simulateErr error
+	simulator, err := NewMessageSimulator(offRamp, func(_ context.Context, from, to common.Address, data []byte) error {
+		require.Equal(t, offRamp, from)
+		require.Equal(t, offRamp, to)
+		simulatedData = data
+		return simulateErr
+	})
+	require.NoError(t, err)
+
+	result, err := simulator.SimulateMessage(ctx, msg, nil)
+	require.NoError(t, err)
+	require.False(t, result.Reverted)
+
+	// the message is packed as the OffRamp executes it
+	method, err := simulator.offRampABI.MethodById(simulatedData[:4])
+	require.NoError(t, err)
+	require.Equal(t, methodExecuteSingleMessage, method.Name)
+	args, err := method.Inputs.Unpack(simulatedData[4:])
+	require.NoError(t, err)
+	require.Len(t, args, 3)
+	require.Equal(t, [][]byte{{}}, args[1])
+
+	evmMsg, err := toAny2EVMMessage(msg)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(200), evmMsg.GasLimit)
+	require.Equal(t, uint32(50), evmMsg.TokenAmounts[0].DestGasAmount)
+
+	simulateErr = errors.New("execution reverted: ReceiverError(0x)")
+	result, err = simulator.SimulateMessage(ctx, msg, nil)
+	require.NoError(t, err)
+	require.True(t, result.Reverted)
+	require.Equal(t, simulateErr.Error(), result.RevertReason)
+
+	simulateErr = errors.New("connection refused")
+	_, err = simulator.SimulateMessage(ctx, msg, nil)
+	require.ErrorContains(t, err, "connection refused")
+
+	msg.ExtraArgs = nil
+	_, err = simulator.SimulateMessage(ctx, msg, nil)
+	require.ErrorContains(t, err, "invalid extra args length")
+}
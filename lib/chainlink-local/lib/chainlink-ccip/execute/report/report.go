@@ -134,6 +134,7 @@ const (
 	MissingNoncesForChain         messageStatus = "missing_nonces_for_chain"
 	MissingNonce                  messageStatus = "missing_nonce"
 	InvalidNonce                  messageStatus = "invalid_nonce"
+	SimulatedRevert               messageStatus = "simulated_revert"
 	/*
 		SenderAlreadySkipped                 messageStatus = "sender_already_skipped"
 		MessageMaxGasCalcError               messageStatus = "message_max_gas_calc_error"
@@ -330,6 +331,13 @@ func (b *execReportBuilder) checkMessages(ctx context.Context, report exectypes.
 		if status == ReadyToExecute {
 			readyMessages[i] = struct{}{}
 		}
+		if b.trace != nil {
+			var tokenData exectypes.MessageTokenData
+			if i < len(report.MessageTokenData) {
+				tokenData = report.MessageTokenData[i]
+			}
+			b.trace.MessageChecked(report.Messages[i], string(status), tokenData)
+		}
 	}
 	return readyMessages, nil
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/merklemulti"
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/execute/trace"
 	"github.com/smartcontractkit/chainlink-ccip/internal/libs/slicelib"
 	testhelpersrand "github.com/smartcontractkit/chainlink-ccip/internal/libs/testhelpers/rand"
 	"github.com/smartcontractkit/chainlink-ccip/internal/mocks"
@@ -950,7 +951,7 @@ func Test_Builder_Build(t *testing.T) {
 			if foundError {
 				return
 			}
-			execReports, commitReports, err := builder.Build()
+			execReports, commitReports, err := builder.Build(ctx)
 			if tt.wantErr != "" {
 				assert.Contains(t, err.Error(), tt.wantErr)
 				return
@@ -1571,3 +1572,53 @@ func Test_checkSkippedNonces(t *testing.T) {
 		})
 	}
 }
+
+func Test_Builder_WithTrace(t *testing.T) {
+	ctx := context.Background()
+	hasher := mocks.NewMessageHasher()
+	addrCodec := internal.NewMockAddressCodecHex(t)
+	sender, err := cciptypes.NewUnknownAddressFromHex(randomAddress())
+	require.NoError(t, err)
+
+	ep := gasmock.NewMockEstimateProvider(t)
+	ep.EXPECT().CalculateMessageMaxGas(mock.Anything).Return(uint64(1)).Maybe()
+	ep.EXPECT().CalculateMerkleTreeGas(mock.Anything).Return(uint64(0)).Maybe()
+
+	round := trace.NewRound(10)
+	builder := NewBuilder(
+		logger.Test(t),
+		hasher,
+		mocks.NewExecutePluginJSONReportCodec(),
+		ep,
+		1, // destChainSelector
+		addrCodec,
+		WithMaxReportSizeBytes(100000),
+		WithMaxGas(2),
+		WithTrace(round),
+	)
+
+	// first message is already executed, only two of the remaining fit into the report
+	commitReport := makeTestCommitReport(hasher, 4, 1, 100, 999, 10101010101,
+		sender, cciptypes.Bytes32{}, []cciptypes.SeqNum{100}, true)
+	_, err = builder.Add(ctx, commitReport)
+	require.NoError(t, err)
+	_, _, err = builder.Build(ctx)
+	require.NoError(t, err)
+
+	roundTrace := round.Trace()
+	require.Equal(t, uint64(10), roundTrace.SeqNr)
+	require.Len(t, roundTrace.Messages, 4)
+	statuses := make(map[cciptypes.SeqNum]string)
+	included := make(map[cciptypes.SeqNum]bool)
+	for _, mt := range roundTrace.Messages {
+		statuses[mt.SeqNum] = mt.Status
+		included[mt.SeqNum] = mt.Included
+	}
+	require.Equal(t, map[cciptypes.SeqNum]string{
+		100: string(AlreadyExecuted),
+		101: string(ReadyToExecute),
+		102: string(ReadyToExecute),
+		103: string(ReadyToExecute),
+	}, statuses)
+	require.Equal(t, map[cciptypes.SeqNum]bool{100: false, 101: true, 102: true, 103: false}, included)
+}
@@ -0,0 +1,275 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+// Candidate is a message ready for execution competing for the space in the execution report.
+type Candidate struct {
+	// ReportIndex is the index of the commit report in the order the reports were added to the builder.
+	ReportIndex int
+	// MessageIndex is the index of the message within the commit report.
+	MessageIndex int
+	// CommitTimestamp is the timestamp of the block that contains the commit report.
+	CommitTimestamp time.Time
+	SourceChain     ccipocr3.ChainSelector
+	Message         ccipocr3.Message
+}
+
+// SelectionStrategy decides in which order the ready messages of all the commit reports are packed into the execution
+// report. The builder tries candidates in the returned order and skips the ones exceeding the report limits.
+// Candidates are provided in the order of the commit reports and the messages within them.
+//
+// Strategy must be deterministic, because every oracle must select the same messages. It must also keep
+// the relative order of the messages of the same sender on the same source chain, otherwise messages
+// executed in nonce order are rejected by the nonce continuity check.
+type SelectionStrategy interface {
+	Order(candidates []Candidate) []Candidate
+}
+
+// SelectionStrategyFunc is a function adapter of the SelectionStrategy.
+type SelectionStrategyFunc func(candidates []Candidate) []Candidate
+
+func (f SelectionStrategyFunc) Order(candidates []Candidate) []Candidate {
+	return f(candidates)
+}
+
+// NewSelectionStrategy returns the SelectionStrategy configured in the ExecuteOffchainConfig.
+// Nil is returned for the sequential strategy, builder then keeps packing messages report by report.
+func NewSelectionStrategy(
+	cfg pluginconfig.ExecuteOffchainConfig,
+	addressCodec ccipocr3.AddressCodec,
+) (SelectionStrategy, error) {
+	switch cfg.MessageSelectionStrategy {
+	case "", pluginconfig.MessageSelectionSequential:
+		return nil, nil
+	case pluginconfig.MessageSelectionFairness:
+		return FairnessStrategy(), nil
+	case pluginconfig.MessageSelectionOldestFirst:
+		return OldestFirstStrategy(), nil
+	case pluginconfig.MessageSelectionPrioritySenders:
+		return PrioritySendersStrategy(addressCodec, cfg.PrioritySenders), nil
+	default:
+		return nil, fmt.Errorf("unknown message selection strategy: %q", cfg.MessageSelectionStrategy)
+	}
+}
+
+// FairnessStrategy interleaves source chains, so a single busy lane can't starve the others. Messages are picked
+// round-robin, one message per source chain (ordered by chain selector) in every turn.
+func FairnessStrategy() SelectionStrategy {
+	return SelectionStrategyFunc(func(candidates []Candidate) []Candidate {
+		byChain := make(map[ccipocr3.ChainSelector][]Candidate)
+		chains := make([]ccipocr3.ChainSelector, 0)
+		for _, c := range candidates {
+			if _, ok := byChain[c.SourceChain]; !ok {
+				chains = append(chains, c.SourceChain)
+			}
+			byChain[c.SourceChain] = append(byChain[c.SourceChain], c)
+		}
+		slices.Sort(chains)
+
+		out := make([]Candidate, 0, len(candidates))
+		for turn := 0; len(out) < len(candidates); turn++ {
+			for _, chain := range chains {
+				if turn < len(byChain[chain]) {
+					out = append(out, byChain[chain][turn])
+				}
+			}
+		}
+		return out
+	})
+}
+
+// OldestFirstStrategy orders messages across all the lanes by the time they were committed. Ties are broken
+// by the source chain selector and the sequence number.
+func OldestFirstStrategy() SelectionStrategy {
+	return SelectionStrategyFunc(func(candidates []Candidate) []Candidate {
+		out := slices.Clone(candidates)
+		sort.SliceStable(out, func(i, j int) bool {
+			if !out[i].CommitTimestamp.Equal(out[j].CommitTimestamp) {
+				return out[i].CommitTimestamp.Before(out[j].CommitTimestamp)
+			}
+			if out[i].SourceChain != out[j].SourceChain {
+				return out[i].SourceChain < out[j].SourceChain
+			}
+			return out[i].Message.Header.SequenceNumber < out[j].Message.Header.SequenceNumber
+		})
+		return out
+	})
+}
+
+// PrioritySendersStrategy moves messages of the given senders in front of all the other messages. Relative
+// order of the messages within both groups is preserved. Senders are compared in their string form
+// (see ccipocr3.AddressCodec) case-insensitively.
+func PrioritySendersStrategy(addressCodec ccipocr3.AddressCodec, senders []string) SelectionStrategy {
+	priority := make(map[string]struct{}, len(senders))
+	for _, s := range senders {
+		priority[strings.ToLower(s)] = struct{}{}
+	}
+
+	isPriority := func(c Candidate) bool {
+		sender, err := addressCodec.AddressBytesToString(c.Message.Sender, c.SourceChain)
+		if err != nil {
+			return false
+		}
+		_, ok := priority[strings.ToLower(sender)]
+		return ok
+	}
+
+	return SelectionStrategyFunc(func(candidates []Candidate) []Candidate {
+		out := make([]Candidate, 0, len(candidates))
+		rest := make([]Candidate, 0, len(candidates))
+		for _, c := range candidates {
+			if isPriority(c) {
+				out = append(out, c)
+			} else {
+				rest = append(rest, c)
+			}
+		}
+		return append(out, rest...)
+	})
+}
+
+// pendingReport is a commit report waiting for the selection of its ready messages.
+type pendingReport struct {
+	commitData    exectypes.CommitData
+	readyMessages map[int]struct{}
+}
+
+// addPending checks the messages of the commit report and keeps it for the selection in Build.
+func (b *execReportBuilder) addPending(ctx context.Context, commitData exectypes.CommitData) error {
+	readyMessages, err := b.checkMessages(ctx, commitData)
+	if err != nil {
+		return fmt.Errorf("unable to add a single chain report: %w", err)
+	}
+	if len(readyMessages) == 0 {
+		return nil
+	}
+	b.pending = append(b.pending, pendingReport{commitData: commitData, readyMessages: readyMessages})
+	return nil
+}
+
+// buildWithStrategy packs the ready messages of all the pending reports in the order given by the selection
+// strategy. Every message is added only if all the in-progress reports together still fit into the limits.
+// Resulting reports keep the order in which the commit reports were added.
+func (b *execReportBuilder) buildWithStrategy(ctx context.Context) error {
+	candidates := make([]Candidate, 0)
+	for ri, p := range b.pending {
+		for mi := range p.commitData.Messages {
+			if _, ok := p.readyMessages[mi]; !ok {
+				continue
+			}
+			candidates = append(candidates, Candidate{
+				ReportIndex:     ri,
+				MessageIndex:    mi,
+				CommitTimestamp: p.commitData.Timestamp,
+				SourceChain:     p.commitData.SourceChain,
+				Message:         p.commitData.Messages[mi],
+			})
+		}
+	}
+
+	selected := make([]map[int]struct{}, len(b.pending))
+	execReports := make([]ccipocr3.ExecutePluginReportSingleChain, len(b.pending))
+	metas := make([]validationMetadata, len(b.pending))
+	numReports := uint64(0)
+	// once an ordered message is skipped, later messages of the same sender would break the nonce sequence
+	type senderKey struct {
+		chain  ccipocr3.ChainSelector
+		sender string
+	}
+	blockedSenders := make(map[senderKey]struct{})
+
+	for _, c := range b.strategy.Order(candidates) {
+		key := senderKey{chain: c.SourceChain, sender: string(c.Message.Sender)}
+		skip := func() {
+			if c.Message.Header.Nonce != 0 {
+				blockedSenders[key] = struct{}{}
+			}
+		}
+		if _, blocked := blockedSenders[key]; blocked && c.Message.Header.Nonce != 0 {
+			continue
+		}
+
+		msgs := selected[c.ReportIndex]
+		if len(msgs) == 0 && b.maxSingleChainReports != 0 && numReports >= b.maxSingleChainReports {
+			skip()
+			continue
+		}
+		if b.maxMessages > 0 && uint64(len(msgs)) >= b.maxMessages {
+			skip()
+			continue
+		}
+
+		withCandidate := maps.Clone(msgs)
+		if withCandidate == nil {
+			withCandidate = make(map[int]struct{})
+		}
+		withCandidate[c.MessageIndex] = struct{}{}
+
+		commitData := b.pending[c.ReportIndex].commitData
+		execReport, err := buildSingleChainReportHelper(b.lggr, commitData, withCandidate)
+		if err != nil {
+			return fmt.Errorf("unable to build a single chain report (messages %d): %w", len(withCandidate), err)
+		}
+
+		// limits are shared by all the reports, so verify against everything selected from the other reports
+		b.accumulated = validationMetadata{}
+		for ri, meta := range metas {
+			if ri != c.ReportIndex {
+				b.accumulated = b.accumulated.accumulate(meta)
+			}
+		}
+		validReport, meta, err := b.verifyReport(ctx, execReport)
+		if err != nil {
+			return fmt.Errorf("unable to verify report: %w", err)
+		}
+		if !validReport {
+			b.lggr.Debugw("message did not fit in report, skipping",
+				"sourceChain", c.SourceChain,
+				"messageID", c.Message.Header.MessageID,
+				"seqNum", c.Message.Header.SequenceNumber,
+			)
+			skip()
+			continue
+		}
+
+		if len(msgs) == 0 {
+			numReports++
+		}
+		selected[c.ReportIndex] = withCandidate
+		execReports[c.ReportIndex] = execReport
+		metas[c.ReportIndex] = meta
+		b.lggr.Infow("message added to report",
+			"sourceChain", c.SourceChain,
+			"seqNum", c.Message.Header.SequenceNumber,
+			"messageID", c.Message.Header.MessageID,
+			"nonce", c.Message.Header.Nonce,
+			"sender", c.Message.Sender.String(),
+			"reportSizeBytes", meta.encodedSizeBytes,
+			"reportGas", meta.gas,
+		)
+	}
+
+	b.accumulated = validationMetadata{}
+	for ri, p := range b.pending {
+		if len(selected[ri]) == 0 {
+			continue
+		}
+		b.accumulated = b.accumulated.accumulate(metas[ri])
+		b.execReports = append(b.execReports, execReports[ri])
+		b.commitReports = append(b.commitReports, markNewMessagesExecuted(execReports[ri], p.commitData))
+	}
+	b.pending = nil
+	return nil
+}
@@ -0,0 +1,193 @@
+package report
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/internal"
+	"github.com/smartcontractkit/chainlink-ccip/internal/mocks"
+	gasmock "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/types/ccipocr3"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+func makeCandidate(
+	reportIdx int, src cciptypes.ChainSelector, seqNum cciptypes.SeqNum, ts int64, sender cciptypes.UnknownAddress,
+) Candidate {
+	return Candidate{
+		ReportIndex:     reportIdx,
+		MessageIndex:    int(seqNum),
+		CommitTimestamp: time.UnixMilli(ts),
+		SourceChain:     src,
+		Message:         makeMessageWithSender(src, seqNum, 0, sender),
+	}
+}
+
+func seqNumsOf(candidates []Candidate) []cciptypes.SeqNum {
+	seqNums := make([]cciptypes.SeqNum, len(candidates))
+	for i, c := range candidates {
+		seqNums[i] = c.Message.Header.SequenceNumber
+	}
+	return seqNums
+}
+
+func Test_SelectionStrategies(t *testing.T) {
+	sender1, err := cciptypes.NewUnknownAddressFromHex(randomAddress())
+	require.NoError(t, err)
+	sender2, err := cciptypes.NewUnknownAddressFromHex(randomAddress())
+	require.NoError(t, err)
+
+	// chain 2 is committed later but has a lower selector than chain 3
+	candidates := []Candidate{
+		makeCandidate(0, 3, 1, 100, sender1),
+		makeCandidate(0, 3, 2, 100, sender2),
+		makeCandidate(0, 3, 3, 100, sender1),
+		makeCandidate(1, 2, 11, 200, sender1),
+		makeCandidate(1, 2, 12, 200, sender2),
+		makeCandidate(2, 3, 4, 50, sender2),
+	}
+
+	tests := []struct {
+		name     string
+		strategy SelectionStrategy
+		want     []cciptypes.SeqNum
+	}{
+		{
+			name:     "fairness",
+			strategy: FairnessStrategy(),
+			want:     []cciptypes.SeqNum{11, 1, 12, 2, 3, 4},
+		},
+		{
+			name:     "oldest first",
+			strategy: OldestFirstStrategy(),
+			want:     []cciptypes.SeqNum{4, 1, 2, 3, 11, 12},
+		},
+		{
+			name:     "priority senders",
+			strategy: PrioritySendersStrategy(internal.NewMockAddressCodecHex(t), []string{sender2.String()}),
+			want:     []cciptypes.SeqNum{2, 12, 4, 1, 3, 11},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, seqNumsOf(tt.strategy.Order(candidates)))
+			// strategies are deterministic
+			require.Equal(t, tt.want, seqNumsOf(tt.strategy.Order(candidates)))
+		})
+	}
+}
+
+func Test_NewSelectionStrategy(t *testing.T) {
+	addrCodec := internal.NewMockAddressCodecHex(t)
+
+	strategy, err := NewSelectionStrategy(pluginconfig.ExecuteOffchainConfig{}, addrCodec)
+	require.NoError(t, err)
+	require.Nil(t, strategy)
+
+	strategy, err = NewSelectionStrategy(
+		pluginconfig.ExecuteOffchainConfig{MessageSelectionStrategy: pluginconfig.MessageSelectionFairness}, addrCodec)
+	require.NoError(t, err)
+	require.NotNil(t, strategy)
+
+	_, err = NewSelectionStrategy(pluginconfig.ExecuteOffchainConfig{MessageSelectionStrategy: "random"}, addrCodec)
+	require.ErrorContains(t, err, "unknown message selection strategy")
+}
+
+func Test_Builder_BuildWithSelectionStrategy(t *testing.T) {
+	hasher := mocks.NewMessageHasher()
+	codec := mocks.NewExecutePluginJSONReportCodec()
+	lggr := logger.Test(t)
+	addrCodec := internal.NewMockAddressCodecHex(t)
+	sender, err := cciptypes.NewUnknownAddressFromHex(randomAddress())
+	require.NoError(t, err)
+	nonces := map[cciptypes.ChainSelector]map[string]uint64{
+		1: {sender.String(): 0},
+		2: {sender.String(): 0},
+	}
+
+	// report of the chain 2 is committed earlier, but added after the busy chain 1
+	reports := []exectypes.CommitData{
+		makeTestCommitReport(hasher, 10, 1, 100, 999, 20202020202, sender, cciptypes.Bytes32{}, nil, true),
+		makeTestCommitReport(hasher, 10, 2, 100, 999, 10101010101, sender, cciptypes.Bytes32{}, nil, false),
+	}
+
+	tests := []struct {
+		name        string
+		strategy    SelectionStrategy
+		maxReports  uint64
+		expectedMsg map[cciptypes.ChainSelector][]cciptypes.SeqNum
+	}{
+		{
+			name:     "fairness",
+			strategy: FairnessStrategy(),
+			expectedMsg: map[cciptypes.ChainSelector][]cciptypes.SeqNum{
+				1: {100, 101},
+				2: {100, 101},
+			},
+		},
+		{
+			name:     "oldest first",
+			strategy: OldestFirstStrategy(),
+			expectedMsg: map[cciptypes.ChainSelector][]cciptypes.SeqNum{
+				2: {100, 101, 102, 103},
+			},
+		},
+		{
+			name:       "fairness limited to one report",
+			strategy:   FairnessStrategy(),
+			maxReports: 1,
+			expectedMsg: map[cciptypes.ChainSelector][]cciptypes.SeqNum{
+				1: {100, 101, 102, 103},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			// every message costs one gas, so only 4 messages fit in total
+			ep := gasmock.NewMockEstimateProvider(t)
+			ep.EXPECT().CalculateMessageMaxGas(mock.Anything).Return(uint64(1)).Maybe()
+			ep.EXPECT().CalculateMerkleTreeGas(mock.Anything).Return(uint64(0)).Maybe()
+
+			builder := NewBuilder(
+				lggr,
+				hasher,
+				codec,
+				ep,
+				1, // destChainSelector
+				addrCodec,
+				WithMaxReportSizeBytes(100000),
+				WithMaxGas(4),
+				WithMaxSingleChainReports(tt.maxReports),
+				WithExtraMessageCheck(CheckNonces(nonces, addrCodec)),
+				WithSelectionStrategy(tt.strategy),
+			)
+			for _, report := range reports {
+				_, err := builder.Add(ctx, report)
+				require.NoError(t, err)
+			}
+
+			execReports, commitReports, err := builder.Build(ctx)
+			require.NoError(t, err)
+			require.Len(t, commitReports, len(tt.expectedMsg))
+
+			got := make(map[cciptypes.ChainSelector][]cciptypes.SeqNum)
+			for i, execReport := range execReports {
+				for _, msg := range execReport.Messages {
+					got[execReport.SourceChainSelector] = append(
+						got[execReport.SourceChainSelector], msg.Header.SequenceNumber)
+				}
+				require.Equal(t, got[execReport.SourceChainSelector], commitReports[i].ExecutedMessages)
+			}
+			require.Equal(t, tt.expectedMsg, got)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// SimulationResult is the outcome of the dry-run execution of a single message.
+type SimulationResult struct {
+	// Reverted is true when the message execution (e.g. the receiver call) would revert on the destination chain.
+	Reverted bool
+	// RevertReason is the decoded revert reason (or raw revert data), informational only.
+	RevertReason string
+}
+
+// MessageSimulator dry-runs the execution of a message on the destination chain without sending a transaction,
+// e.g. through the EVM client tx simulator. It's implemented per destination chain family, see evmsimulator.
+type MessageSimulator interface {
+	// SimulateMessage simulates the execution of the message with its offchain token data. Error is returned only
+	// if the simulation itself failed (e.g. RPC error), a reverting message is reported in the SimulationResult.
+	SimulateMessage(ctx context.Context, msg ccipocr3.Message, offchainTokenData [][]byte) (SimulationResult, error)
+}
+
+// SimulationCache wraps the MessageSimulator and remembers simulation results per message hash, so that
+// a message isn't simulated again in every round. Results expire, so a message reverting because of the
+// receiver state (e.g. paused receiver) is eventually simulated again. Failed simulations are not cached.
+type SimulationCache struct {
+	simulator MessageSimulator
+	results   *cache.Cache
+}
+
+// NewSimulationCache creates a SimulationCache keeping simulation results for the given expiry.
+func NewSimulationCache(simulator MessageSimulator, expiry time.Duration) *SimulationCache {
+	return &SimulationCache{
+		simulator: simulator,
+		results:   cache.New(expiry, 2*expiry),
+	}
+}
+
+// Simulate returns the cached simulation result of the message or simulates it.
+func (s *SimulationCache) Simulate(
+	ctx context.Context,
+	msgHash ccipocr3.Bytes32,
+	msg ccipocr3.Message,
+	offchainTokenData [][]byte,
+) (SimulationResult, error) {
+	key := msgHash.String()
+	if result, ok := s.results.Get(key); ok {
+		return result.(SimulationResult), nil
+	}
+
+	result, err := s.simulator.SimulateMessage(ctx, msg, offchainTokenData)
+	if err != nil {
+		return SimulationResult{}, err
+	}
+	s.results.SetDefault(key, result)
+	return result, nil
+}
+
+// CheckSimulatedReverts rejects the messages which, according to the consensus of the oracles, would revert
+// if executed, so that the report doesn't spend gas on a guaranteed failure. Simulations are observed by every oracle
+// in the Filter round, so the check is deterministic across the oracles.
+func CheckSimulatedReverts(simulatedReverts exectypes.SimulatedRevertObservations) Check {
+	reverts := make(map[ccipocr3.ChainSelector]map[ccipocr3.SeqNum]struct{}, len(simulatedReverts))
+	for chain, seqNums := range simulatedReverts {
+		reverts[chain] = make(map[ccipocr3.SeqNum]struct{}, len(seqNums))
+		for _, seqNum := range seqNums {
+			reverts[chain][seqNum] = struct{}{}
+		}
+	}
+
+	return func(lggr logger.Logger, msg ccipocr3.Message, _ int, report exectypes.CommitData) (messageStatus, error) {
+		if _, ok := reverts[report.SourceChain][msg.Header.SequenceNumber]; ok {
+			lggr.Warnw(
+				"Skipping message - simulated execution reverts",
+				"messageID", msg.Header.MessageID,
+				"sourceChain", report.SourceChain,
+				"seqNum", msg.Header.SequenceNumber,
+				"messageState", SimulatedRevert)
+			return SimulatedRevert, nil
+		}
+		return None, nil
+	}
+}
@@ -0,0 +1,97 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/internal/mocks"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+type fakeSimulator struct {
+	calls    map[cciptypes.SeqNum]int
+	reverted map[cciptypes.SeqNum]bool
+	err      error
+}
+
+func (f *fakeSimulator) SimulateMessage(
+	_ context.Context, msg cciptypes.Message, _ [][]byte,
+) (SimulationResult, error) {
+	f.calls[msg.Header.SequenceNumber]++
+	if f.err != nil {
+		return SimulationResult{}, f.err
+	}
+	if f.reverted[msg.Header.SequenceNumber] {
+		return SimulationResult{Reverted: true, RevertReason: "ReceiverError"}, nil
+	}
+	return SimulationResult{}, nil
+}
+
+func Test_SimulationCache(t *testing.T) {
+	ctx := context.Background()
+	msgs := []cciptypes.Message{
+		{Header: cciptypes.RampMessageHeader{MessageID: cciptypes.Bytes32{1}, SequenceNumber: 100}},
+		{Header: cciptypes.RampMessageHeader{MessageID: cciptypes.Bytes32{2}, SequenceNumber: 101}},
+		{Header: cciptypes.RampMessageHeader{MessageID: cciptypes.Bytes32{3}, SequenceNumber: 102}},
+	}
+
+	simulator := &fakeSimulator{
+		calls:    make(map[cciptypes.SeqNum]int),
+		reverted: map[cciptypes.SeqNum]bool{101: true},
+	}
+	simulations := NewSimulationCache(simulator, time.Minute)
+
+	for round := 0; round < 2; round++ {
+		for _, msg := range msgs {
+			result, err := simulations.Simulate(ctx, msg.Header.MessageID, msg, nil)
+			require.NoError(t, err)
+			require.Equal(t, msg.Header.SequenceNumber == 101, result.Reverted)
+		}
+	}
+	// every message is simulated only once
+	require.Equal(t, map[cciptypes.SeqNum]int{100: 1, 101: 1, 102: 1}, simulator.calls)
+
+	t.Run("simulation error", func(t *testing.T) {
+		failing := &fakeSimulator{
+			calls: make(map[cciptypes.SeqNum]int),
+			err:   errors.New("rpc error"),
+		}
+		simulations := NewSimulationCache(failing, time.Minute)
+		for round := 0; round < 2; round++ {
+			_, err := simulations.Simulate(ctx, msgs[0].Header.MessageID, msgs[0], nil)
+			require.Error(t, err)
+		}
+		// failures are not cached
+		require.Equal(t, 2, failing.calls[100])
+	})
+}
+
+func Test_CheckSimulatedReverts(t *testing.T) {
+	lggr := logger.Test(t)
+	sender, err := cciptypes.NewUnknownAddressFromHex(randomAddress())
+	require.NoError(t, err)
+	report := makeTestCommitReport(mocks.NewMessageHasher(), 3, 1, 100, 999, 10101010101,
+		sender, cciptypes.Bytes32{}, nil, true)
+
+	check := CheckSimulatedReverts(exectypes.SimulatedRevertObservations{
+		report.SourceChain:     {101},
+		report.SourceChain + 1: {100},
+	})
+
+	for i, msg := range report.Messages {
+		status, err := check(lggr, msg, i, report)
+		require.NoError(t, err)
+		if msg.Header.SequenceNumber == 101 {
+			require.Equal(t, SimulatedRevert, status)
+		} else {
+			require.Equal(t, None, status)
+		}
+	}
+}
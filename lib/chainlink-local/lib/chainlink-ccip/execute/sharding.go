@@ -0,0 +1,120 @@
+package execute
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	mapset "github.com/deckarep/golang-set/v2"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	libocrtypes "github.com/smartcontractkit/libocr/ragep2p/types"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// sourceChainShard selects oraclesPerChain peers observing the source chain out of the given candidates
+// (DON members supporting the chain). Peers are ranked by the hash of the chain selector, the config digest
+// and the peer ID (rendezvous hashing), so shards are spread evenly and change only partially when
+// the set of candidates changes. The shard is raised to 2*fChain+1 peers to keep the message consensus possible.
+func sourceChainShard(
+	chain cciptypes.ChainSelector,
+	configDigest [32]byte,
+	candidates []libocrtypes.PeerID,
+	oraclesPerChain int,
+	fChain int,
+) mapset.Set[libocrtypes.PeerID] {
+	size := max(oraclesPerChain, 2*fChain+1)
+	if size >= len(candidates) {
+		return mapset.NewSet(candidates...)
+	}
+
+	type rankedPeer struct {
+		peer  libocrtypes.PeerID
+		score [32]byte
+	}
+	ranked := make([]rankedPeer, 0, len(candidates))
+	for _, peer := range candidates {
+		ranked = append(ranked, rankedPeer{peer: peer, score: shardScore(chain, configDigest, peer)})
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if c := bytes.Compare(ranked[i].score[:], ranked[j].score[:]); c != 0 {
+			return c < 0
+		}
+		return bytes.Compare(ranked[i].peer[:], ranked[j].peer[:]) < 0
+	})
+
+	shard := mapset.NewSet[libocrtypes.PeerID]()
+	for _, rp := range ranked[:size] {
+		shard.Add(rp.peer)
+	}
+	return shard
+}
+
+func shardScore(chain cciptypes.ChainSelector, configDigest [32]byte, peer libocrtypes.PeerID) [32]byte {
+	buf := make([]byte, 0, 8+len(configDigest)+len(peer))
+	buf = binary.BigEndian.AppendUint64(buf, uint64(chain))
+	buf = append(buf, configDigest[:]...)
+	buf = append(buf, peer[:]...)
+	return sha256.Sum256(buf)
+}
+
+// msgsReadingChains returns the chains whose messages and token data the oracle reads out of its supported chains.
+// With sharding, the source chains outside of the shards of the oracle are removed. Only the message reading is
+// sharded, commit reports and nonces are observed by every oracle supporting the chain.
+func (p *Plugin) msgsReadingChains(
+	id commontypes.OracleID,
+	supportedChains mapset.Set[cciptypes.ChainSelector],
+) (mapset.Set[cciptypes.ChainSelector], error) {
+	if p.offchainCfg.SourceChainSharding == nil {
+		return supportedChains, nil
+	}
+
+	peer, exists := p.oracleIDToP2pID[id]
+	if !exists {
+		return nil, fmt.Errorf("oracle ID %d not found in oracleIDToP2pID", id)
+	}
+	sharded, err := p.shardSupportedChains(peer, supportedChains)
+	if err != nil {
+		return nil, fmt.Errorf("error sharding supported chains: %w", err)
+	}
+	return sharded, nil
+}
+
+// shardSupportedChains removes the source chains outside of the shards of the peer from its supported chains.
+func (p *Plugin) shardSupportedChains(
+	peer libocrtypes.PeerID,
+	supportedChains mapset.Set[cciptypes.ChainSelector],
+) (mapset.Set[cciptypes.ChainSelector], error) {
+	fChain, err := p.homeChain.GetFChain()
+	if err != nil {
+		return nil, err
+	}
+
+	donPeers := mapset.NewSet[libocrtypes.PeerID]()
+	for _, donPeer := range p.oracleIDToP2pID {
+		donPeers.Add(donPeer)
+	}
+
+	sharded := mapset.NewSet[cciptypes.ChainSelector]()
+	for _, chain := range supportedChains.ToSlice() {
+		if chain == p.destChain {
+			sharded.Add(chain)
+			continue
+		}
+
+		chainConfig, err := p.homeChain.GetChainConfig(chain)
+		if err != nil {
+			return nil, err
+		}
+		candidates := chainConfig.SupportedNodes.Intersect(donPeers).ToSlice()
+		shard := sourceChainShard(chain, p.reportingCfg.ConfigDigest, candidates,
+			p.offchainCfg.SourceChainSharding.OraclesPerChain, fChain[chain])
+		if shard.Contains(peer) {
+			sharded.Add(chain)
+		}
+	}
+	return sharded, nil
+}
@@ -0,0 +1,127 @@
+package execute
+
+import (
+	"testing"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	libocrtypes "github.com/smartcontractkit/libocr/ragep2p/types"
+
+	"github.com/smartcontractkit/chainlink-ccip/internal/libs/testhelpers"
+	"github.com/smartcontractkit/chainlink-ccip/internal/reader"
+	reader_mock "github.com/smartcontractkit/chainlink-ccip/mocks/internal_/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+func testPeers(n int) []libocrtypes.PeerID {
+	peers := make([]libocrtypes.PeerID, n)
+	for i := range peers {
+		peers[i] = libocrtypes.PeerID{byte(i + 1)}
+	}
+	return peers
+}
+
+func Test_sourceChainShard(t *testing.T) {
+	peers := testPeers(10)
+	digest := [32]byte{1}
+
+	t.Run("deterministic", func(t *testing.T) {
+		shard := sourceChainShard(1, digest, peers, 4, 1)
+		require.Equal(t, 4, shard.Cardinality())
+
+		reversed := make([]libocrtypes.PeerID, len(peers))
+		for i, peer := range peers {
+			reversed[len(peers)-1-i] = peer
+		}
+		require.True(t, shard.Equal(sourceChainShard(1, digest, reversed, 4, 1)))
+	})
+
+	t.Run("raised to 2f+1", func(t *testing.T) {
+		require.Equal(t, 7, sourceChainShard(1, digest, peers, 4, 3).Cardinality())
+	})
+
+	t.Run("all candidates when not enough peers", func(t *testing.T) {
+		shard := sourceChainShard(1, digest, peers[:3], 4, 1)
+		require.Equal(t, 3, shard.Cardinality())
+		require.True(t, shard.Contains(peers[:3]...))
+	})
+
+	t.Run("spread across chains", func(t *testing.T) {
+		observed := make(map[libocrtypes.PeerID]int)
+		for chain := uint64(1); chain <= 50; chain++ {
+			for _, peer := range sourceChainShard(cciptypes.ChainSelector(chain), digest, peers, 4, 1).ToSlice() {
+				observed[peer]++
+			}
+		}
+		// every peer should observe some of the chains
+		require.Len(t, observed, len(peers))
+	})
+
+	t.Run("depends on config digest", func(t *testing.T) {
+		differs := false
+		for chain := uint64(1); chain <= 10 && !differs; chain++ {
+			a := sourceChainShard(cciptypes.ChainSelector(chain), digest, peers, 4, 1)
+			b := sourceChainShard(cciptypes.ChainSelector(chain), [32]byte{2}, peers, 4, 1)
+			differs = !a.Equal(b)
+		}
+		require.True(t, differs)
+	})
+}
+
+func TestPlugin_msgsReadingChains(t *testing.T) {
+	destChain := cciptypes.ChainSelector(1)
+	sourceChains := []cciptypes.ChainSelector{2, 3, 4}
+	allChains := mapset.NewSet(append(sourceChains, destChain)...)
+	oracleIDToP2pID := testhelpers.CreateOracleIDToP2pID(1, 2, 3, 4, 5, 6, 7)
+	peers := mapset.NewSet[libocrtypes.PeerID]()
+	for _, peer := range oracleIDToP2pID {
+		peers.Add(peer)
+	}
+
+	homeChain := reader_mock.NewMockHomeChain(t)
+	homeChain.EXPECT().GetFChain().Return(map[cciptypes.ChainSelector]int{1: 1, 2: 1, 3: 1, 4: 1}, nil).Maybe()
+	homeChain.EXPECT().GetSupportedChainsForPeer(mock.Anything).Return(allChains, nil).Maybe()
+	for _, chain := range sourceChains {
+		homeChain.EXPECT().GetChainConfig(chain).Return(reader.ChainConfig{FChain: 1, SupportedNodes: peers}, nil).Maybe()
+	}
+
+	p := &Plugin{
+		reportingCfg:    ocr3types.ReportingPluginConfig{ConfigDigest: [32]byte{1}},
+		offchainCfg:     pluginconfig.ExecuteOffchainConfig{},
+		destChain:       destChain,
+		oracleIDToP2pID: oracleIDToP2pID,
+		homeChain:       homeChain,
+	}
+
+	readers := func() map[cciptypes.ChainSelector]int {
+		counts := make(map[cciptypes.ChainSelector]int)
+		for id := range oracleIDToP2pID {
+			supportedChains, err := p.supportedChains(id)
+			require.NoError(t, err)
+			// supported chains are never sharded, commit reports and nonces are observed by every oracle
+			require.True(t, supportedChains.Equal(allChains))
+
+			chains, err := p.msgsReadingChains(id, supportedChains)
+			require.NoError(t, err)
+			for _, chain := range chains.ToSlice() {
+				counts[chain]++
+			}
+		}
+		return counts
+	}
+
+	// without sharding every oracle reads messages of all the chains
+	require.Equal(t, map[cciptypes.ChainSelector]int{1: 7, 2: 7, 3: 7, 4: 7}, readers())
+
+	// with sharding messages of every source chain are read by a shard only
+	p.offchainCfg.SourceChainSharding = &pluginconfig.SourceChainShardingConfig{OraclesPerChain: 3}
+	require.Equal(t, map[cciptypes.ChainSelector]int{1: 7, 2: 3, 3: 3, 4: 3}, readers())
+
+	_, err := p.msgsReadingChains(commontypes.OracleID(100), allChains)
+	require.ErrorContains(t, err, "not found in oracleIDToP2pID")
+}
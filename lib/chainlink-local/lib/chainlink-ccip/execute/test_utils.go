@@ -57,6 +57,9 @@ type IntTest struct {
 	usdcServer          *ConfigurableAttestationServer
 	tokenObserverConfig []pluginconfig.TokenDataObserverConfig
 	tokenChainReader    map[cciptypes.ChainSelector]contractreader.ContractReaderFacade
+	enableFastPath      bool
+	sourceChainSharding *pluginconfig.SourceChainShardingConfig
+	numNodes            int
 }
 
 func SetupSimpleTest(t *testing.T,
@@ -89,6 +92,7 @@ func SetupSimpleTest(t *testing.T,
 		ccipReader:          &ccipReader,
 		tokenObserverConfig: []pluginconfig.TokenDataObserverConfig{},
 		tokenChainReader:    map[cciptypes.ChainSelector]contractreader.ContractReaderFacade{},
+		numNodes:            3,
 	}
 }
 
@@ -166,7 +170,7 @@ func (it *IntTest) WithUSDC(
 	it.tokenObserverConfig = append(it.tokenObserverConfig, pluginconfig.TokenDataObserverConfig{
 		Type:    "usdc-cctp",
 		Version: "1",
-		USDCCCTPObserverConfig: &pluginconfig.USDCCCTPObserverConfig{
+		TypeConfig: &pluginconfig.USDCCCTPObserverConfig{
 			AttestationConfig: pluginconfig.AttestationConfig{
 				AttestationAPI:         it.usdcServer.server.URL,
 				AttestationAPIInterval: commonconfig.MustNewDuration(1 * time.Millisecond),
@@ -203,20 +207,37 @@ func (it *IntTest) WithUSDC(
 	}
 }
 
+// WithFastPath enables skipping of the Filter round for messages without nonce and token data dependencies.
+func (it *IntTest) WithFastPath() {
+	it.enableFastPath = true
+}
+
+// WithSourceChainSharding runs the test with numNodes oracles, every source chain observed by oraclesPerChain of them.
+func (it *IntTest) WithSourceChainSharding(oraclesPerChain, numNodes int) {
+	it.sourceChainSharding = &pluginconfig.SourceChainShardingConfig{OraclesPerChain: oraclesPerChain}
+	it.numNodes = numNodes
+}
+
 func (it *IntTest) Start() *testhelpers.OCR3Runner[[]byte] {
 	cfg := pluginconfig.ExecuteOffchainConfig{
 		MessageVisibilityInterval: *commonconfig.MustNewDuration(8 * time.Hour),
 		BatchGasLimit:             100000000,
+		EnableFastPath:            it.enableFastPath,
+		SourceChainSharding:       it.sourceChainSharding,
+	}
+	nodeIDs := make([]int, it.numNodes)
+	readers := make([]libocrtypes.PeerID, it.numNodes)
+	for i := range nodeIDs {
+		nodeIDs[i] = i + 1
+		readers[i] = libocrtypes.PeerID{byte(i + 1)}
 	}
 	chainConfigInfos := []reader.ChainConfigInfo{
 		{
 			ChainSelector: it.dstSelector,
 			ChainConfig: reader.HomeChainConfigMapper{
-				FChain: 1,
-				Readers: []libocrtypes.PeerID{
-					{1}, {2}, {3},
-				},
-				Config: mustEncodeChainConfig(chainconfig.ChainConfig{}),
+				FChain:  1,
+				Readers: readers,
+				Config:  mustEncodeChainConfig(chainconfig.ChainConfig{}),
 			},
 		},
 	}
@@ -225,11 +246,9 @@ func (it *IntTest) Start() *testhelpers.OCR3Runner[[]byte] {
 		chainConfigInfos = append(chainConfigInfos, reader.ChainConfigInfo{
 			ChainSelector: src,
 			ChainConfig: reader.HomeChainConfigMapper{
-				FChain: 1,
-				Readers: []libocrtypes.PeerID{
-					{1}, {2}, {3},
-				},
-				Config: mustEncodeChainConfig(chainconfig.ChainConfig{}),
+				FChain:  1,
+				Readers: readers,
+				Config:  mustEncodeChainConfig(chainconfig.ChainConfig{}),
 			},
 		})
 	}
@@ -254,11 +273,11 @@ func (it *IntTest) Start() *testhelpers.OCR3Runner[[]byte] {
 	ep.EXPECT().CalculateMessageMaxGas(mock.Anything).Return(uint64(0)).Maybe()
 	ep.EXPECT().CalculateMerkleTreeGas(mock.Anything).Return(uint64(0)).Maybe()
 
-	oracleIDToP2pID := testhelpers.CreateOracleIDToP2pID(1, 2, 3)
-	nodesSetup := []nodeSetup{
-		it.newNode(cfg, homeChain, ep, tkObs, oracleIDToP2pID, 1, 1, [32]byte{0xde, 0xad}, mockAddrCodec),
-		it.newNode(cfg, homeChain, ep, tkObs, oracleIDToP2pID, 2, 1, [32]byte{0xde, 0xad}, mockAddrCodec),
-		it.newNode(cfg, homeChain, ep, tkObs, oracleIDToP2pID, 3, 1, [32]byte{0xde, 0xad}, mockAddrCodec),
+	oracleIDToP2pID := testhelpers.CreateOracleIDToP2pID(nodeIDs...)
+	nodesSetup := make([]nodeSetup, 0, len(nodeIDs))
+	for _, id := range nodeIDs {
+		nodesSetup = append(nodesSetup,
+			it.newNode(cfg, homeChain, ep, tkObs, oracleIDToP2pID, id, 1, [32]byte{0xde, 0xad}, mockAddrCodec))
 	}
 
 	require.NoError(it.t, homeChain.Close())
@@ -268,12 +287,12 @@ func (it *IntTest) Start() *testhelpers.OCR3Runner[[]byte] {
 		nodes = append(nodes, n.node)
 	}
 
-	nodeIDs := make([]commontypes.OracleID, 0, len(nodesSetup))
+	oracleIDs := make([]commontypes.OracleID, 0, len(nodesSetup))
 	for _, n := range nodesSetup {
-		nodeIDs = append(nodeIDs, n.node.reportingCfg.OracleID)
+		oracleIDs = append(oracleIDs, n.node.reportingCfg.OracleID)
 	}
 
-	return testhelpers.NewOCR3Runner(nodes, nodeIDs, nil)
+	return testhelpers.NewOCR3Runner(nodes, oracleIDs, nil)
 }
 
 func (it *IntTest) Close() {
@@ -316,6 +335,8 @@ func (it *IntTest) newNode(
 		it.lggr,
 		&metrics.Noop{},
 		mockCodec,
+		nil,
+		nil,
 	)
 
 	// FIXME: Test should not rely on the specific type of the plugin but rather than that on
@@ -401,6 +422,12 @@ func withTokens(tokenAmounts ...cciptypes.RampTokenAmount) msgOption {
 	}
 }
 
+func withNonce(nonce uint64) msgOption {
+	return func(m *cciptypes.Message) {
+		m.Header.Nonce = nonce
+	}
+}
+
 func makeMsgWithMetadata(
 	seqNum cciptypes.SeqNum,
 	src, dest cciptypes.ChainSelector,
@@ -0,0 +1,18 @@
+//go:build !unix
+
+package http
+
+import (
+	"errors"
+	"os"
+)
+
+var errFileLockNotSupported = errors.New("shared rate limiter is not supported on this platform")
+
+func lockFile(_ *os.File) error {
+	return errFileLockNotSupported
+}
+
+func unlockFile(_ *os.File) error {
+	return errFileLockNotSupported
+}
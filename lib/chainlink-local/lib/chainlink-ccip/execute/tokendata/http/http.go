@@ -3,6 +3,7 @@ package http
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -11,8 +12,6 @@ import (
 	"sync"
 	"time"
 
-	"golang.org/x/time/rate"
-
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata"
@@ -29,7 +28,8 @@ const (
 type HTTPStatus int
 
 type HTTPClient interface {
-	// Get calls the USDC attestation API with the given USDC message hash.
+	// Get calls the USDC attestation API with the given path, e.g. v1 attestation of the USDC message hash or
+	// v2 messages of the source chain transaction. Path can contain query parameters.
 	// The attestation service rate limit is 10 requests per second. If you exceed 10 requests
 	// per second, the service blocks all API requests for the next 5 minutes and returns an
 	// HTTP 429 response.
@@ -51,45 +51,76 @@ type httpClient struct {
 	lggr       logger.Logger
 	apiURL     *url.URL
 	apiTimeout time.Duration
-	rate       *rate.Limiter
+	// limiter self-rate limits requests and keeps the cool down period, it's either local to the process
+	// or shared between processes (see GetHTTPClient)
+	limiter rateLimiter
 	// coolDownDuration defines the time to wait after getting rate limited.
 	// this value is only used if the 429 response does not contain the Retry-After header
 	coolDownDuration time.Duration
-	// coolDownUntil defines whether requests are blocked or not.
-	coolDownUntil time.Time
-	coolDownMu    *sync.RWMutex
 }
 
 var (
-	clientInstances = make(map[string]HTTPClient)
+	clientInstances = make(map[string]sharedHTTPClient)
 	mutex           sync.Mutex
 )
 
+// sharedHTTPClient is a singleton client along with the rate limiter config it was created with.
+type sharedHTTPClient struct {
+	client                HTTPClient
+	apiInterval           time.Duration
+	sharedRateLimiterFile string
+}
+
 // GetHTTPClient returns a singleton instance of the httpClient for the given API URL.
 // It's critical to reuse existing clients because of the self-rate limiting mechanism. Being rate limited by
 // Circle comes with a long cool down period, so we should always self-rate limit before hitting the API rate limit.
-// IMPORTANT: In the loop world this might require major rework - e.g. making httpClient a loop plugin to
-// enforce the singleton pattern.
+// Singleton is enough only when all the plugins run within the same process. In the loop world, every plugin runs
+// in its own process, so sharedRateLimiterFile must be set. All processes using the same file (e.g. multiple lanes
+// and DONs on the same node) share a single token bucket and the cool down period, so collectively they never
+// exceed the API limit. All of them must use the same apiInterval. Empty sharedRateLimiterFile limits only
+// the current process. Requesting the client of the same API URL with a different apiInterval or
+// sharedRateLimiterFile returns an error, since the requests would bypass the existing rate limiter.
 func GetHTTPClient(
 	lggr logger.Logger,
 	api string,
 	apiInterval time.Duration,
 	apiTimeout time.Duration,
 	coolDownDuration time.Duration,
+	sharedRateLimiterFile string,
 ) (HTTPClient, error) {
 	mutex.Lock()
 	defer mutex.Unlock()
 
-	if client, exists := clientInstances[api]; exists {
-		return client, nil
+	if shared, exists := clientInstances[api]; exists {
+		if shared.apiInterval != apiInterval || shared.sharedRateLimiterFile != sharedRateLimiterFile {
+			return nil, fmt.Errorf(
+				"http client of %s already exists with a different rate limiter config: "+
+					"apiInterval %s (requested %s), sharedRateLimiterFile %q (requested %q)",
+				api, shared.apiInterval, apiInterval, shared.sharedRateLimiterFile, sharedRateLimiterFile,
+			)
+		}
+		return shared.client, nil
+	}
+
+	var limiter rateLimiter = newLocalRateLimiter(apiInterval)
+	if sharedRateLimiterFile != "" {
+		fileLimiter, err := newFileRateLimiter(sharedRateLimiterFile, apiInterval)
+		if err != nil {
+			return nil, err
+		}
+		limiter = fileLimiter
 	}
 
-	client, err := newHTTPClient(lggr, api, apiInterval, apiTimeout, coolDownDuration)
+	client, err := newHTTPClientWithLimiter(lggr, api, limiter, apiTimeout, coolDownDuration)
 	if err != nil {
 		return nil, err
 	}
 
-	clientInstances[api] = client
+	clientInstances[api] = sharedHTTPClient{
+		client:                client,
+		apiInterval:           apiInterval,
+		sharedRateLimiterFile: sharedRateLimiterFile,
+	}
 	return client, nil
 }
 
@@ -99,6 +130,16 @@ func newHTTPClient(
 	apiInterval time.Duration,
 	apiTimeout time.Duration,
 	coolDownDuration time.Duration,
+) (HTTPClient, error) {
+	return newHTTPClientWithLimiter(lggr, api, newLocalRateLimiter(apiInterval), apiTimeout, coolDownDuration)
+}
+
+func newHTTPClientWithLimiter(
+	lggr logger.Logger,
+	api string,
+	limiter rateLimiter,
+	apiTimeout time.Duration,
+	coolDownDuration time.Duration,
 ) (HTTPClient, error) {
 	u, err := url.ParseRequestURI(api)
 	if err != nil {
@@ -109,16 +150,21 @@ func newHTTPClient(
 		apiURL:           u,
 		apiTimeout:       apiTimeout,
 		coolDownDuration: coolDownDuration,
-		rate:             rate.NewLimiter(rate.Every(apiInterval), 1),
-		coolDownMu:       &sync.RWMutex{},
+		limiter:          limiter,
 	}, nil
 }
 
 func (h *httpClient) Get(ctx context.Context, requestPath string) (cciptypes.Bytes, HTTPStatus, error) {
 	lggr := logutil.WithContextValues(ctx, h.lggr)
 
+	// requestPath can carry query parameters (e.g. CCTP v2 messages are queried by the transaction hash)
+	relativeURL, err := url.Parse(requestPath)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
 	requestURL := *h.apiURL
-	requestURL.Path = path.Join(requestURL.Path, requestPath)
+	requestURL.Path = path.Join(requestURL.Path, relativeURL.Path)
+	requestURL.RawQuery = relativeURL.RawQuery
 
 	response, httpStatus, err := h.callAPI(ctx, lggr, http.MethodGet, requestURL, nil)
 	lggr.Debugw(
@@ -146,13 +192,11 @@ func (h *httpClient) callAPI(
 		return nil, http.StatusTooManyRequests, tokendata.ErrRateLimit
 	}
 
-	if h.rate != nil {
-		// Wait blocks until it the attestation API can be called or the
-		// context is Done.
-		if waitErr := h.rate.Wait(ctx); waitErr != nil {
-			lggr.Warnw("Self rate-limited, sending too many requests to the API")
-			return nil, http.StatusTooManyRequests, tokendata.ErrRateLimit
-		}
+	// Wait blocks until it the attestation API can be called or the
+	// context is Done.
+	if waitErr := h.limiter.Wait(ctx); waitErr != nil {
+		lggr.Warnw("Self rate-limited, sending too many requests to the API", "err", waitErr)
+		return nil, http.StatusTooManyRequests, tokendata.ErrRateLimit
 	}
 
 	// Use a timeout to guard against attestation API hanging, causing observation timeout and
@@ -217,13 +261,17 @@ func (h *httpClient) setCoolDownPeriod(lggr logger.Logger, headers http.Header)
 		"coolDownDuration", coolDownDuration,
 	)
 
-	h.coolDownMu.Lock()
-	defer h.coolDownMu.Unlock()
-	h.coolDownUntil = time.Now().Add(coolDownDuration)
+	if err := h.limiter.SetCoolDown(time.Now().Add(coolDownDuration)); err != nil {
+		lggr.Errorw("Failed to set cool down", "err", err)
+	}
 }
 
 func (h *httpClient) inCoolDownPeriod() (bool, time.Duration) {
-	h.coolDownMu.RLock()
-	defer h.coolDownMu.RUnlock()
-	return time.Now().Before(h.coolDownUntil), time.Until(h.coolDownUntil)
+	coolDownUntil, err := h.limiter.CoolDownUntil()
+	if err != nil {
+		// Don't block requests forever if the shared state is broken, the API will rate limit us again anyway
+		h.lggr.Errorw("Failed to read cool down", "err", err)
+		return false, 0
+	}
+	return time.Now().Before(coolDownUntil), time.Until(coolDownUntil)
 }
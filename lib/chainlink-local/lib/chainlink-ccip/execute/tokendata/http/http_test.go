@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
@@ -172,6 +173,26 @@ func Test_HTTPClient_Get(t *testing.T) {
 	}
 }
 
+func Test_HTTPClient_GetWithQuery(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/messages/3" && r.URL.Query().Get("transactionHash") == "0x0102" {
+			_, err := w.Write(validAttestationResponse)
+			require.NoError(t, err)
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := newHTTPClient(logger.Test(t), ts.URL+"/api", time.Millisecond, longTimeout, maxCoolDownDuration)
+	require.NoError(t, err)
+
+	response, statusCode, err := client.Get(tests.Context(t), "v2/messages/3?transactionHash=0x0102")
+	require.NoError(t, err)
+	require.Equal(t, HTTPStatus(http.StatusOK), statusCode)
+	require.Equal(t, cciptypes.Bytes(validAttestationResponse), response)
+}
+
 func Test_HTTPClient_Cooldown(t *testing.T) {
 	var requestCount int
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -208,10 +229,10 @@ func Test_HTTPClient_GetInstance(t *testing.T) {
 	}))
 	defer ts.Close()
 
-	client1, err := GetHTTPClient(logger.Test(t), ts.URL, 1*time.Hour, longTimeout, maxCoolDownDuration)
+	client1, err := GetHTTPClient(logger.Test(t), ts.URL, 1*time.Hour, longTimeout, maxCoolDownDuration, "")
 	require.NoError(t, err)
 
-	client2, err := GetHTTPClient(logger.Test(t), ts.URL, 1*time.Hour, longTimeout, maxCoolDownDuration)
+	client2, err := GetHTTPClient(logger.Test(t), ts.URL, 1*time.Hour, longTimeout, maxCoolDownDuration, "")
 	require.NoError(t, err)
 
 	client3, err := newHTTPClient(logger.Test(t), ts.URL, 1*time.Hour, longTimeout, maxCoolDownDuration)
@@ -235,6 +256,24 @@ func Test_HTTPClient_GetInstance(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_HTTPClient_GetInstance_ConflictingRateLimiter(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write(validAttestationResponse)
+		require.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	_, err := GetHTTPClient(logger.Test(t), ts.URL, time.Second, longTimeout, maxCoolDownDuration, "")
+	require.NoError(t, err)
+
+	_, err = GetHTTPClient(logger.Test(t), ts.URL, time.Minute, longTimeout, maxCoolDownDuration, "")
+	require.ErrorContains(t, err, "different rate limiter config")
+
+	limiterFile := filepath.Join(t.TempDir(), "limiter")
+	_, err = GetHTTPClient(logger.Test(t), ts.URL, time.Second, longTimeout, maxCoolDownDuration, limiterFile)
+	require.ErrorContains(t, err, "different rate limiter config")
+}
+
 func Test_HTTPClient_CoolDownWithRetryHeader(t *testing.T) {
 	var requestCount int
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,195 @@
+package http
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// rateLimiter self-rate limits calls to the attestation API and keeps track of the cool down period
+// after being rate limited by the API.
+type rateLimiter interface {
+	// Wait blocks until the next request can be sent. It returns error immediately if the context
+	// would be done before the request is allowed.
+	Wait(ctx context.Context) error
+	// SetCoolDown blocks all requests until the given time.
+	SetCoolDown(until time.Time) error
+	// CoolDownUntil returns the time until which all requests are blocked.
+	CoolDownUntil() (time.Time, error)
+}
+
+// localRateLimiter is shared only by the httpClient instances of the current process (see GetHTTPClient).
+type localRateLimiter struct {
+	rate          *rate.Limiter
+	coolDownUntil time.Time
+	coolDownMu    *sync.RWMutex
+}
+
+func newLocalRateLimiter(apiInterval time.Duration) *localRateLimiter {
+	return &localRateLimiter{
+		rate:       rate.NewLimiter(rate.Every(apiInterval), 1),
+		coolDownMu: &sync.RWMutex{},
+	}
+}
+
+func (l *localRateLimiter) Wait(ctx context.Context) error {
+	return l.rate.Wait(ctx)
+}
+
+func (l *localRateLimiter) SetCoolDown(until time.Time) error {
+	l.coolDownMu.Lock()
+	defer l.coolDownMu.Unlock()
+	l.coolDownUntil = until
+	return nil
+}
+
+func (l *localRateLimiter) CoolDownUntil() (time.Time, error) {
+	l.coolDownMu.RLock()
+	defer l.coolDownMu.RUnlock()
+	return l.coolDownUntil, nil
+}
+
+var errWaitExceedsDeadline = errors.New("rate limiter wait would exceed context deadline")
+
+// fileRateLimiter is a token bucket (with the burst of 1) shared by all the processes on the host using the same
+// state file. It's required when plugins run in separate processes (LOOPP), because every process would
+// otherwise self-rate limit independently and all of them together would exceed the attestation API limit.
+// State file keeps the time of the next free request slot and the end of the cool down period. It's guarded by
+// the exclusive file lock, so every process reserves its own slot before waiting for it.
+type fileRateLimiter struct {
+	path     string
+	interval time.Duration
+	// mu serializes access within the process, file lock serializes access between processes
+	mu sync.Mutex
+}
+
+// sharedRateLimiterState is persisted as two big-endian unix nanos timestamps
+type sharedRateLimiterState struct {
+	nextSlot      time.Time
+	coolDownUntil time.Time
+}
+
+const sharedRateLimiterStateSize = 16
+
+func newFileRateLimiter(path string, apiInterval time.Duration) (*fileRateLimiter, error) {
+	l := &fileRateLimiter{
+		path:     path,
+		interval: apiInterval,
+	}
+	// fail fast if the state file can't be created or locked
+	if err := l.withState(func(*sharedRateLimiterState) bool { return false }); err != nil {
+		return nil, fmt.Errorf("init shared rate limiter %s: %w", path, err)
+	}
+	return l, nil
+}
+
+func (l *fileRateLimiter) Wait(ctx context.Context) error {
+	var slot time.Time
+	reserved := false
+	err := l.withState(func(s *sharedRateLimiterState) bool {
+		slot = time.Now()
+		if s.nextSlot.After(slot) {
+			slot = s.nextSlot
+		}
+		if deadline, ok := ctx.Deadline(); ok && deadline.Before(slot) {
+			return false
+		}
+		s.nextSlot = slot.Add(l.interval)
+		reserved = true
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !reserved {
+		return errWaitExceedsDeadline
+	}
+
+	delay := time.Until(slot)
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l *fileRateLimiter) SetCoolDown(until time.Time) error {
+	return l.withState(func(s *sharedRateLimiterState) bool {
+		s.coolDownUntil = until
+		return true
+	})
+}
+
+func (l *fileRateLimiter) CoolDownUntil() (time.Time, error) {
+	var until time.Time
+	err := l.withState(func(s *sharedRateLimiterState) bool {
+		until = s.coolDownUntil
+		return false
+	})
+	return until, err
+}
+
+// withState runs fn while holding the exclusive lock of the state file, state is written back if fn returns true.
+func (l *fileRateLimiter) withState(fn func(*sharedRateLimiterState) bool) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, err := os.OpenFile(l.path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err = lockFile(f); err != nil {
+		return fmt.Errorf("lock %s: %w", l.path, err)
+	}
+	defer func() { _ = unlockFile(f) }()
+
+	var state sharedRateLimiterState
+	buf := make([]byte, sharedRateLimiterStateSize)
+	n, err := f.ReadAt(buf, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	// empty (just created) or truncated file is treated as a fresh state
+	if n == sharedRateLimiterStateSize {
+		state.nextSlot = unixNanoToTime(binary.BigEndian.Uint64(buf[0:8]))
+		state.coolDownUntil = unixNanoToTime(binary.BigEndian.Uint64(buf[8:16]))
+	}
+
+	if !fn(&state) {
+		return nil
+	}
+
+	binary.BigEndian.PutUint64(buf[0:8], timeToUnixNano(state.nextSlot))
+	binary.BigEndian.PutUint64(buf[8:16], timeToUnixNano(state.coolDownUntil))
+	_, err = f.WriteAt(buf, 0)
+	return err
+}
+
+func unixNanoToTime(v uint64) time.Time {
+	if v == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, int64(v)) //nolint:gosec // timestamps written by timeToUnixNano
+}
+
+func timeToUnixNano(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+	return uint64(t.UnixNano()) //nolint:gosec // timestamps are always after the epoch
+}
@@ -0,0 +1,90 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata"
+	"github.com/smartcontractkit/chainlink-ccip/internal/mocks"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+func Test_fileRateLimiter_Wait(t *testing.T) {
+	interval := 50 * time.Millisecond
+	path := filepath.Join(t.TempDir(), "attestation.lock")
+
+	// every limiter acts as a separate process sharing the same state file
+	limiters := make([]*fileRateLimiter, 3)
+	for i := range limiters {
+		l, err := newFileRateLimiter(path, interval)
+		require.NoError(t, err)
+		limiters[i] = l
+	}
+
+	numRequests := 6
+	start := time.Now()
+	wg := sync.WaitGroup{}
+	for i := 0; i < numRequests; i++ {
+		wg.Add(1)
+		go func(l *fileRateLimiter) {
+			defer wg.Done()
+			require.NoError(t, l.Wait(tests.Context(t)))
+		}(limiters[i%len(limiters)])
+	}
+	wg.Wait()
+
+	// first request is sent immediately, every other waits for its own slot
+	require.GreaterOrEqual(t, time.Since(start), time.Duration(numRequests-1)*interval)
+
+	t.Run("deadline before the next slot", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(tests.Context(t), time.Millisecond)
+		defer cancel()
+		require.NoError(t, limiters[0].Wait(tests.Context(t)))
+		require.ErrorIs(t, limiters[1].Wait(ctx), errWaitExceedsDeadline)
+	})
+}
+
+func Test_fileRateLimiter_CoolDownIsShared(t *testing.T) {
+	var requestCount atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount.Add(1)
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+
+	path := filepath.Join(t.TempDir(), "attestation.lock")
+	newClient := func() HTTPClient {
+		limiter, err := newFileRateLimiter(path, time.Millisecond)
+		require.NoError(t, err)
+		client, err := newHTTPClientWithLimiter(mocks.NullLogger, ts.URL, limiter, longTimeout, maxCoolDownDuration)
+		require.NoError(t, err)
+		return client
+	}
+	client1, client2 := newClient(), newClient()
+
+	_, _, err := client1.Get(tests.Context(t), cciptypes.Bytes32{1, 2, 3}.String())
+	require.ErrorIs(t, err, tokendata.ErrRateLimit)
+
+	// other process is blocked by the cool down without hitting the API
+	_, status, err := client2.Get(tests.Context(t), cciptypes.Bytes32{1, 2, 3}.String())
+	require.ErrorIs(t, err, tokendata.ErrRateLimit)
+	require.Equal(t, HTTPStatus(http.StatusTooManyRequests), status)
+	require.Equal(t, int32(1), requestCount.Load())
+
+	limiter, err := newFileRateLimiter(path, time.Millisecond)
+	require.NoError(t, err)
+	until, err := limiter.CoolDownUntil()
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(time.Minute), until, 5*time.Second)
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
-	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/usdc"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
@@ -47,7 +46,8 @@ type compositeTokenDataObserver struct {
 
 // NewConfigBasedCompositeObservers creates a compositeTokenDataObserver based on the provided configuration.
 // Slice of []pluginconfig.TokenDataObserverConfig must be deduped and validated by the plugin.
-// Therefore, we don't re-run any validation and only match configs to the proper TokenDataObserver implementation.
+// Therefore, we don't re-run any validation and only match configs to the proper TokenDataObserver implementation
+// using the factories registered with RegisterFactory.
 // This constructor that should be used by the plugin.
 func NewConfigBasedCompositeObservers(
 	ctx context.Context,
@@ -60,34 +60,22 @@ func NewConfigBasedCompositeObservers(
 ) (TokenDataObserver, error) {
 	observers := make([]TokenDataObserver, len(config))
 	for i, c := range config {
-		// TODO consider if we can get rid of this switch stmt by moving the logic to the config
-		// e.g. observers[i] := config.CreateTokenDataObserver()
-		switch {
-		case c.USDCCCTPObserverConfig != nil:
-			observer, err := usdc.NewUSDCTokenDataObserver(ctx, lggr, destChainSelector,
-				*c.USDCCCTPObserverConfig,
-				encoder.EncodeUSDC, readers, addrCodec)
-			if err != nil {
-				return nil, fmt.Errorf("create USDC/CCTP token observer: %w", err)
-			}
-
-			if c.USDCCCTPObserverConfig.IsForeground() {
-				lggr.Info("Using foreground observer for USDC/CCTP")
-				observers[i] = observer
-			} else {
-				lggr.Info("Using background observer for USDC/CCTP")
-				observers[i] = NewBackgroundObserver(
-					lggr,
-					observer,
-					c.USDCCCTPObserverConfig.NumWorkers,
-					c.USDCCCTPObserverConfig.CacheExpirationInterval.Duration(),
-					c.USDCCCTPObserverConfig.CacheCleanupInterval.Duration(),
-					c.USDCCCTPObserverConfig.ObserveTimeout.Duration(),
-				)
-			}
-		default:
-			return nil, errors.New("unsupported token data observer")
+		factory, ok := lookupFactory(c.Type, c.Version)
+		if !ok {
+			return nil, fmt.Errorf("unsupported token data observer: type %q version %q", c.Type, c.Version)
+		}
+		observer, err := factory(ctx, FactoryParams{
+			Lggr:              lggr,
+			DestChainSelector: destChainSelector,
+			Config:            c,
+			Encoder:           encoder,
+			Readers:           readers,
+			AddrCodec:         addrCodec,
+		})
+		if err != nil {
+			return nil, err
 		}
+		observers[i] = observer
 	}
 	return NewCompositeObservers(lggr, observers...), nil
 }
@@ -135,6 +123,17 @@ func (c *compositeTokenDataObserver) IsTokenSupported(
 	return false
 }
 
+// Invalidate forwards the call to every child observer caching token data.
+func (c *compositeTokenDataObserver) Invalidate(ctx context.Context, msgIDs ...cciptypes.Bytes32) error {
+	var errs []error
+	for _, ob := range c.observers {
+		if invalidator, ok := ob.(TokenDataCacheInvalidator); ok {
+			errs = append(errs, invalidator.Invalidate(ctx, msgIDs...))
+		}
+	}
+	return errors.Join(errs...)
+}
+
 func (c *compositeTokenDataObserver) Close() error {
 	for _, ob := range c.observers {
 		if err := ob.Close(); err != nil {
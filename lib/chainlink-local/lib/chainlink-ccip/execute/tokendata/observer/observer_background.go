@@ -26,6 +26,27 @@ type backgroundObserver struct {
 	reprocessInterval time.Duration // how long to wait before reprocessing a message
 }
 
+// TokenDataCacheInvalidator is implemented by observers caching token data. It allows dropping cached token data
+// that are known to be invalid, e.g. when the attestation was rejected on the destination chain.
+type TokenDataCacheInvalidator interface {
+	Invalidate(ctx context.Context, msgIDs ...cciptypes.Bytes32) error
+}
+
+// BackgroundObserverOption configures optional features of the background observer.
+type BackgroundObserverOption func(*backgroundObserverOptions)
+
+type backgroundObserverOptions struct {
+	store TokenDataStore
+}
+
+// WithTokenDataStore persists token data fetched by the background observer to the store. The in-memory cache
+// is warmed up from the store when the observer is created, so restarts don't trigger attestation API storms.
+func WithTokenDataStore(store TokenDataStore) BackgroundObserverOption {
+	return func(o *backgroundObserverOptions) {
+		o.store = store
+	}
+}
+
 // NewBackgroundObserver initializes an observer that retrieves and caches token data in the background.
 // It uses the provided observer to make the actual Observe calls, storing results in memory for efficient access later.
 // Goroutines are spawned to process messages concurrently, numWorkers defines how many.
@@ -39,7 +60,12 @@ func NewBackgroundObserver(
 	cacheExpirationInterval time.Duration,
 	cacheCleanupInterval time.Duration,
 	observeTimeout time.Duration,
+	opts ...BackgroundObserverOption,
 ) TokenDataObserver {
+	options := &backgroundObserverOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
 	doneChan := make(chan struct{})
 
 	o := &backgroundObserver{
@@ -51,6 +77,7 @@ func NewBackgroundObserver(
 			cacheExpirationInterval,
 			cacheCleanupInterval,
 			doneChan,
+			options.store,
 		),
 		msgQueue:          newMsgQueue(logger.Named(lggr, "msgQueue")),
 		wg:                sync.WaitGroup{},
@@ -126,6 +153,14 @@ func (o *backgroundObserver) Observe(
 	return tokenDataResults, nil
 }
 
+// Invalidate removes the token data of the messages from the cache, so they are fetched again by the underlying
+// observer. The store (if configured) is updated in the background.
+func (o *backgroundObserver) Invalidate(_ context.Context, msgIDs ...cciptypes.Bytes32) error {
+	o.lggr.Infow("invalidating cached token data", "msgIDs", msgIDs)
+	o.cachedTokenData.delete(msgIDs...)
+	return nil
+}
+
 // IsTokenSupported simply forwards the call to the underlying observer.
 func (o *backgroundObserver) IsTokenSupported(
 	sourceChain cciptypes.ChainSelector, msgToken cciptypes.RampTokenAmount) bool {
@@ -133,9 +168,11 @@ func (o *backgroundObserver) IsTokenSupported(
 }
 
 // Close stops the background goroutines and cleans up resources.
+// Pending writes to the store are flushed before returning.
 func (o *backgroundObserver) Close() error {
 	close(o.done)
 	o.wg.Wait()
+	o.cachedTokenData.closeStore()
 	return nil
 }
 
@@ -336,14 +373,23 @@ type inMemTokenDataCache struct {
 	expiresAt          map[cciptypes.Bytes32]time.Time
 	mu                 *sync.RWMutex
 	done               chan struct{}
+	// store is optional, when set cached token data are written through to it
+	store TokenDataStore
+	// storeWriter applies the changes of the cache to the store, nil without a store
+	storeWriter *storeWriter
 }
 
+// storeTimeout bounds every call to the TokenDataStore
+const storeTimeout = 10 * time.Second
+
 // newInMemObservationsCache initializes an in-memory cache for token data.
 // It uses a background goroutine to periodically check and remove expired data.
 // cleanupInterval specifies the frequency for checking and cleaning up inactive data.
 // Setting a low value is discouraged, as the cleanup process holds a lock.
+// If the store is provided, cache is warmed up with the persisted token data.
 func newInMemObservationsCache(lggr logger.Logger,
-	expirationInterval, cleanupInterval time.Duration, doneChan chan struct{}) *inMemTokenDataCache {
+	expirationInterval, cleanupInterval time.Duration, doneChan chan struct{}, store TokenDataStore,
+) *inMemTokenDataCache {
 	c := &inMemTokenDataCache{
 		lggr:               lggr,
 		expirationInterval: expirationInterval,
@@ -351,11 +397,44 @@ func newInMemObservationsCache(lggr logger.Logger,
 		expiresAt:          make(map[cciptypes.Bytes32]time.Time),
 		mu:                 &sync.RWMutex{},
 		done:               doneChan,
+		store:              store,
 	}
+	if store != nil {
+		c.storeWriter = newStoreWriter(logger.Named(lggr, "storeWriter"), store)
+	}
+	c.warmUp()
 	c.runExpirationLoop(cleanupInterval)
 	return c
 }
 
+// warmUp loads persisted token data into memory. Failure is not fatal, token data are fetched again.
+func (c *inMemTokenDataCache) warmUp() {
+	if c.store == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), storeTimeout)
+	defer cancel()
+
+	entries, err := c.store.LoadAll(ctx)
+	if err != nil {
+		c.lggr.Errorw("failed to load persisted token data, starting with empty cache", "err", err)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now().UTC()
+	for _, entry := range entries {
+		if !entry.TokenData.SupportedAreReady() || !now.Before(entry.ExpiresAt) {
+			continue
+		}
+		c.inMemTokenData[entry.MessageID] = entry.TokenData
+		c.expiresAt[entry.MessageID] = entry.ExpiresAt.UTC()
+	}
+	c.lggr.Infow("token data cache warmed up from the store", "numEntries", len(c.inMemTokenData))
+}
+
 // get returns the token data for the given message ID if it exists in the cache.
 func (c *inMemTokenDataCache) get(msgID cciptypes.Bytes32) (exectypes.MessageTokenData, bool) {
 	c.mu.RLock()
@@ -377,6 +456,28 @@ func (c *inMemTokenDataCache) set(msgID cciptypes.Bytes32, tokenData exectypes.M
 	c.inMemTokenData[msgID] = tokenData
 	c.expiresAt[msgID] = time.Now().Add(c.expirationInterval).UTC()
 	c.lggr.Debugw("token data cached", "msgID", msgID, "expiresAt", c.expiresAt[msgID])
+
+	// enqueued under the lock, so the store sees the changes in the same order as the cache
+	c.storeWriter.enqueue(storeOp{
+		entry: &StoredTokenData{MessageID: msgID, TokenData: tokenData, ExpiresAt: c.expiresAt[msgID]},
+	})
+}
+
+// delete removes the token data of the messages from memory and (in the background) from the store.
+func (c *inMemTokenDataCache) delete(msgIDs ...cciptypes.Bytes32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, msgID := range msgIDs {
+		delete(c.inMemTokenData, msgID)
+		delete(c.expiresAt, msgID)
+	}
+	c.storeWriter.enqueue(storeOp{deleted: msgIDs})
+}
+
+// closeStore flushes the pending store writes and stops the store writer.
+func (c *inMemTokenDataCache) closeStore() {
+	c.storeWriter.close()
 }
 
 // size returns the number of cached token data
@@ -402,6 +503,8 @@ func (c *inMemTokenDataCache) runExpirationLoop(cleanupInterval time.Duration) {
 					c.mu.Lock()
 					defer c.mu.Unlock()
 
+					expired := make([]cciptypes.Bytes32, 0)
+
 					for msgID, expiresAt := range c.expiresAt {
 						if c.hasExpired(msgID) {
 							c.lggr.Debugw("token data expired and removed from cache",
@@ -412,8 +515,13 @@ func (c *inMemTokenDataCache) runExpirationLoop(cleanupInterval time.Duration) {
 
 							delete(c.inMemTokenData, msgID)
 							delete(c.expiresAt, msgID)
+							expired = append(expired, msgID)
 						}
 					}
+					// expired token data are removed from the store, so it doesn't grow indefinitely
+					if len(expired) > 0 {
+						c.storeWriter.enqueue(storeOp{deleted: expired})
+					}
 				}()
 			}
 		}
@@ -430,3 +538,97 @@ func (c *inMemTokenDataCache) hasExpired(msgID cciptypes.Bytes32) bool {
 
 	return time.Now().UTC().After(expiresAt)
 }
+
+// storeOp is a single change of the token data cache that has to be applied to the store.
+type storeOp struct {
+	entry   *StoredTokenData
+	deleted []cciptypes.Bytes32
+}
+
+// storeWriter applies the changes of the in-memory cache to the TokenDataStore from a single background goroutine.
+// Changes are applied in the order they were enqueued, so the store never ends up with stale token data, and the
+// cache lock is never held during the store I/O. A nil storeWriter is a nop.
+type storeWriter struct {
+	lggr    logger.Logger
+	store   TokenDataStore
+	mu      *sync.Mutex
+	ops     []storeOp
+	signal  chan struct{}
+	stop    chan struct{}
+	stopped chan struct{}
+}
+
+func newStoreWriter(lggr logger.Logger, store TokenDataStore) *storeWriter {
+	w := &storeWriter{
+		lggr:    lggr,
+		store:   store,
+		mu:      &sync.Mutex{},
+		signal:  make(chan struct{}, 1),
+		stop:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	go w.run()
+	return w
+}
+
+// enqueue schedules the change without blocking on the store.
+func (w *storeWriter) enqueue(op storeOp) {
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	w.ops = append(w.ops, op)
+	w.mu.Unlock()
+
+	select {
+	case w.signal <- struct{}{}:
+	default:
+		// a flush is already pending
+	}
+}
+
+// close applies the pending changes and stops the background goroutine.
+func (w *storeWriter) close() {
+	if w == nil {
+		return
+	}
+	close(w.stop)
+	<-w.stopped
+}
+
+func (w *storeWriter) run() {
+	defer close(w.stopped)
+	for {
+		select {
+		case <-w.stop:
+			w.flush()
+			w.lggr.Debug("store writer gracefully stopped")
+			return
+		case <-w.signal:
+			w.flush()
+		}
+	}
+}
+
+// flush applies all the pending changes to the store, failures are logged since token data are fetched again.
+func (w *storeWriter) flush() {
+	w.mu.Lock()
+	ops := w.ops
+	w.ops = nil
+	w.mu.Unlock()
+
+	for _, op := range ops {
+		ctx, cancel := context.WithTimeout(context.Background(), storeTimeout)
+		if op.entry != nil {
+			if err := w.store.Store(ctx, *op.entry); err != nil {
+				w.lggr.Warnw("failed to persist token data", "msgID", op.entry.MessageID, "err", err)
+			}
+		}
+		if len(op.deleted) > 0 {
+			if err := w.store.Delete(ctx, op.deleted...); err != nil {
+				w.lggr.Warnw("failed to delete token data from the store", "msgIDs", op.deleted, "err", err)
+			}
+		}
+		cancel()
+	}
+}
@@ -0,0 +1,195 @@
+package observer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// TokenDataStore persists token data fetched by the background observer, so that they survive node restarts.
+// Without it, every restart re-queries all the attestations, which can easily hit the attestation API rate limits.
+// Only token data with all supported tokens ready are stored. Entries are keyed by the message ID (message hash).
+// Implementations must be safe for concurrent use, e.g. file or SQL backed stores.
+type TokenDataStore interface {
+	// LoadAll returns all the entries that haven't expired yet. It's used to warm up the in-memory cache on startup.
+	LoadAll(ctx context.Context) ([]StoredTokenData, error)
+	// Store persists (or overrides) token data of the message.
+	Store(ctx context.Context, entry StoredTokenData) error
+	// Delete invalidates token data of the messages, missing entries are ignored.
+	Delete(ctx context.Context, msgIDs ...cciptypes.Bytes32) error
+}
+
+// StoredTokenData is a single entry of the TokenDataStore.
+type StoredTokenData struct {
+	MessageID cciptypes.Bytes32
+	TokenData exectypes.MessageTokenData
+	ExpiresAt time.Time
+}
+
+// tokenDataRecordVersion is bumped whenever the format of the persisted records changes,
+// records with different version are invalidated when loaded.
+const tokenDataRecordVersion = 1
+
+// tokenDataRecord is the persisted form of the StoredTokenData. exectypes.TokenData doesn't serialize internal
+// fields (e.g. Supported), so they are stored explicitly.
+type tokenDataRecord struct {
+	Version   int                 `json:"version"`
+	MessageID cciptypes.Bytes32   `json:"messageId"`
+	TokenData []tokenDataRecordV1 `json:"tokenData"`
+	ExpiresAt time.Time           `json:"expiresAt"`
+}
+
+type tokenDataRecordV1 struct {
+	Ready     bool            `json:"ready"`
+	Supported bool            `json:"supported"`
+	Data      cciptypes.Bytes `json:"data,omitempty"`
+}
+
+func newTokenDataRecord(entry StoredTokenData) tokenDataRecord {
+	tokenData := make([]tokenDataRecordV1, len(entry.TokenData.TokenData))
+	for i, td := range entry.TokenData.TokenData {
+		tokenData[i] = tokenDataRecordV1{Ready: td.Ready, Supported: td.Supported, Data: td.Data}
+	}
+	return tokenDataRecord{
+		Version:   tokenDataRecordVersion,
+		MessageID: entry.MessageID,
+		TokenData: tokenData,
+		ExpiresAt: entry.ExpiresAt.UTC(),
+	}
+}
+
+func (r tokenDataRecord) toStoredTokenData() StoredTokenData {
+	tokenData := make([]exectypes.TokenData, len(r.TokenData))
+	for i, td := range r.TokenData {
+		tokenData[i] = exectypes.TokenData{Ready: td.Ready, Supported: td.Supported, Data: td.Data}
+	}
+	return StoredTokenData{
+		MessageID: r.MessageID,
+		TokenData: exectypes.NewMessageTokenData(tokenData...),
+		ExpiresAt: r.ExpiresAt,
+	}
+}
+
+// fileTokenDataStore is a TokenDataStore keeping every entry in a separate JSON file named after the message ID.
+// Files are written to a temporary file first and renamed, so a crash never leaves a partially written entry.
+type fileTokenDataStore struct {
+	lggr logger.Logger
+	dir  string
+}
+
+// NewFileTokenDataStore creates a TokenDataStore persisting entries to the given directory. Directory is created
+// if it doesn't exist. Directory must not be shared between observers of different types or plugins.
+func NewFileTokenDataStore(lggr logger.Logger, dir string) (TokenDataStore, error) {
+	if dir == "" {
+		return nil, errors.New("token data store directory not set")
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("create token data store directory %s: %w", dir, err)
+	}
+	return &fileTokenDataStore{
+		lggr: lggr,
+		dir:  dir,
+	}, nil
+}
+
+func (s *fileTokenDataStore) LoadAll(ctx context.Context) ([]StoredTokenData, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("read token data store directory %s: %w", s.dir, err)
+	}
+
+	now := time.Now().UTC()
+	out := make([]StoredTokenData, 0, len(entries))
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if entry.IsDir() {
+			continue
+		}
+		if strings.HasPrefix(entry.Name(), "tmp-") {
+			// leftover of the interrupted Store
+			s.remove(filepath.Join(s.dir, entry.Name()))
+			continue
+		}
+		if !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+
+		path := filepath.Join(s.dir, entry.Name())
+		record, err := s.readRecord(path)
+		if err != nil || record.Version != tokenDataRecordVersion || !now.Before(record.ExpiresAt) {
+			s.lggr.Debugw("invalidating persisted token data",
+				"path", path, "version", record.Version, "expiresAt", record.ExpiresAt, "err", err)
+			s.remove(path)
+			continue
+		}
+		out = append(out, record.toStoredTokenData())
+	}
+	return out, nil
+}
+
+func (s *fileTokenDataStore) Store(_ context.Context, entry StoredTokenData) error {
+	encoded, err := json.Marshal(newTokenDataRecord(entry))
+	if err != nil {
+		return fmt.Errorf("encode token data: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(s.dir, "tmp-*")
+	if err != nil {
+		return fmt.Errorf("create temporary file: %w", err)
+	}
+	defer s.remove(tmp.Name())
+
+	if _, err = tmp.Write(encoded); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write token data: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("close temporary file: %w", err)
+	}
+	if err = os.Rename(tmp.Name(), s.path(entry.MessageID)); err != nil {
+		return fmt.Errorf("rename temporary file: %w", err)
+	}
+	return nil
+}
+
+func (s *fileTokenDataStore) Delete(_ context.Context, msgIDs ...cciptypes.Bytes32) error {
+	var errs []error
+	for _, msgID := range msgIDs {
+		if err := os.Remove(s.path(msgID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *fileTokenDataStore) readRecord(path string) (tokenDataRecord, error) {
+	var record tokenDataRecord
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return record, err
+	}
+	err = json.Unmarshal(content, &record)
+	return record, err
+}
+
+func (s *fileTokenDataStore) path(msgID cciptypes.Bytes32) string {
+	return filepath.Join(s.dir, msgID.String()+".json")
+}
+
+func (s *fileTokenDataStore) remove(path string) {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		s.lggr.Warnw("failed to remove token data file", "path", path, "err", err)
+	}
+}
@@ -0,0 +1,171 @@
+package observer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/internal/mocks"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+func Test_fileTokenDataStore(t *testing.T) {
+	ctx := tests.Context(t)
+	dir := filepath.Join(t.TempDir(), "store")
+
+	store, err := NewFileTokenDataStore(mocks.NullLogger, dir)
+	require.NoError(t, err)
+
+	active := StoredTokenData{
+		MessageID: cciptypes.Bytes32{1},
+		TokenData: exectypes.NewMessageTokenData(
+			exectypes.NewSuccessTokenData([]byte{0xa, 0xb}),
+			exectypes.NotSupportedTokenData(),
+		),
+		ExpiresAt: time.Now().Add(time.Hour).UTC().Round(0),
+	}
+	expired := StoredTokenData{
+		MessageID: cciptypes.Bytes32{2},
+		TokenData: exectypes.NewMessageTokenData(exectypes.NewSuccessTokenData([]byte{0xc})),
+		ExpiresAt: time.Now().Add(-time.Minute).UTC(),
+	}
+	require.NoError(t, store.Store(ctx, active))
+	require.NoError(t, store.Store(ctx, expired))
+
+	// corrupted and leftover files are invalidated
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "corrupted.json"), []byte("{"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "tmp-123"), []byte("{"), 0o600))
+
+	entries, err := store.LoadAll(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []StoredTokenData{active}, entries)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	require.NoError(t, store.Delete(ctx, active.MessageID, expired.MessageID))
+	entries, err = store.LoadAll(ctx)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func Test_backgroundObserver_persistentCache(t *testing.T) {
+	ctx := tests.Context(t)
+	store, err := NewFileTokenDataStore(mocks.NullLogger, t.TempDir())
+	require.NoError(t, err)
+
+	numMsgsPerChain := map[cciptypes.ChainSelector]int{1000: 5, 2000: 5}
+	msgObservations, _ := generateMsgObservations(numMsgsPerChain)
+
+	newObserver := func(base TokenDataObserver) *backgroundObserver {
+		return NewBackgroundObserver(
+			mocks.NullLogger,
+			base,
+			2,
+			10*time.Minute,
+			15*time.Minute,
+			time.Second,
+			WithTokenDataStore(store),
+		).(*backgroundObserver)
+	}
+	allReady := func(observations exectypes.TokenDataObservations) bool {
+		for _, seqNums := range observations {
+			for _, tokenData := range seqNums {
+				if !tokenData.IsReady() {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	// fetch and persist all token data
+	observer := newObserver(&NoopTokenDataObserver{tokenSupported: true})
+	require.Eventually(t, func() bool {
+		tokenData, err1 := observer.Observe(ctx, msgObservations)
+		require.NoError(t, err1)
+		return allReady(tokenData)
+	}, tests.WaitTimeout(t), 50*time.Millisecond)
+	require.NoError(t, observer.Close())
+
+	// restarted observer serves token data from the store, underlying observer always fails
+	failing := make(map[cciptypes.ChainSelector]map[cciptypes.SeqNum][]int)
+	for chain, seqNums := range msgObservations {
+		failing[chain] = make(map[cciptypes.SeqNum][]int)
+		for seqNum := range seqNums {
+			failing[chain][seqNum] = []int{0, 1}
+		}
+	}
+	observer = newObserver(&NoopTokenDataObserver{tokenSupported: true, errorTokenData: failing})
+	defer func() { require.NoError(t, observer.Close()) }()
+
+	tokenData, err := observer.Observe(ctx, msgObservations)
+	require.NoError(t, err)
+	require.True(t, allReady(tokenData))
+
+	// invalidated token data are fetched again
+	invalidated := msgObservations[1000][0]
+	require.NoError(t, observer.Invalidate(ctx, invalidated.Header.MessageID))
+
+	tokenData, err = observer.Observe(ctx, msgObservations)
+	require.NoError(t, err)
+	require.False(t, tokenData[1000][0].IsReady())
+	require.True(t, tokenData[2000][0].IsReady())
+
+	// store is updated in the background
+	require.Eventually(t, func() bool {
+		entries, err1 := store.LoadAll(ctx)
+		require.NoError(t, err1)
+		return len(entries) == 9
+	}, tests.WaitTimeout(t), 50*time.Millisecond)
+}
+
+// blockingTokenDataStore blocks every Store call until unblocked.
+type blockingTokenDataStore struct {
+	TokenDataStore
+	unblock chan struct{}
+}
+
+func (s *blockingTokenDataStore) Store(ctx context.Context, entry StoredTokenData) error {
+	<-s.unblock
+	return s.TokenDataStore.Store(ctx, entry)
+}
+
+func Test_inMemTokenDataCache_storeDoesNotBlockCache(t *testing.T) {
+	ctx := tests.Context(t)
+	fileStore, err := NewFileTokenDataStore(mocks.NullLogger, t.TempDir())
+	require.NoError(t, err)
+	store := &blockingTokenDataStore{TokenDataStore: fileStore, unblock: make(chan struct{})}
+
+	done := make(chan struct{})
+	defer close(done)
+	cache := newInMemObservationsCache(mocks.NullLogger, time.Hour, time.Hour, done, store)
+
+	msgID := cciptypes.Bytes32{1}
+	tokenData := exectypes.NewMessageTokenData(exectypes.NewSuccessTokenData([]byte{0xa}))
+	cache.set(msgID, tokenData)
+	cache.set(cciptypes.Bytes32{2}, tokenData)
+	cache.delete(cciptypes.Bytes32{2})
+
+	// the cache is served while the store is still blocked
+	cached, ok := cache.get(msgID)
+	require.True(t, ok)
+	require.Equal(t, tokenData, cached)
+
+	close(store.unblock)
+	cache.closeStore()
+
+	// changes are applied in order, the deleted entry is not resurrected
+	entries, err := fileStore.LoadAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, msgID, entries[0].MessageID)
+}
@@ -0,0 +1,176 @@
+package observer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/rebase"
+	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/usdc"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+// FactoryParams contains everything plugin provides for constructing TokenDataObserver from the config.
+type FactoryParams struct {
+	Lggr              logger.Logger
+	DestChainSelector cciptypes.ChainSelector
+	Config            pluginconfig.TokenDataObserverConfig
+	Encoder           cciptypes.TokenDataEncoder
+	Readers           map[cciptypes.ChainSelector]contractreader.ContractReaderFacade
+	AddrCodec         cciptypes.AddressCodec
+}
+
+// Factory constructs TokenDataObserver from the already validated config.
+type Factory func(ctx context.Context, params FactoryParams) (TokenDataObserver, error)
+
+type factoryKey struct {
+	observerType string
+	version      string
+}
+
+var (
+	factoriesMu sync.RWMutex
+	factories   = make(map[factoryKey]Factory)
+)
+
+// RegisterFactory registers TokenDataObserver constructor for the observer type and version. It's the counterpart
+// of pluginconfig.RegisterTokenDataObserverConfig and it's meant to be called from the init function of the package
+// implementing the observer. Use pluginconfig.AnyTokenDataObserverVersion to register the constructor for every
+// version of the type.
+func RegisterFactory(observerType, version string, factory Factory) error {
+	if observerType == "" {
+		return errors.New("token data observer type not set")
+	}
+	if version == "" {
+		return errors.New("token data observer version not set")
+	}
+	if factory == nil {
+		return errors.New("token data observer factory not set")
+	}
+
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+
+	key := factoryKey{observerType: observerType, version: version}
+	if _, exists := factories[key]; exists {
+		return fmt.Errorf("token data observer %q version %q already registered", observerType, version)
+	}
+	factories[key] = factory
+	return nil
+}
+
+// MustRegisterFactory is the same as RegisterFactory, but panics on error.
+func MustRegisterFactory(observerType, version string, factory Factory) {
+	if err := RegisterFactory(observerType, version, factory); err != nil {
+		panic(err)
+	}
+}
+
+func lookupFactory(observerType, version string) (Factory, bool) {
+	factoriesMu.RLock()
+	defer factoriesMu.RUnlock()
+
+	if f, ok := factories[factoryKey{observerType, version}]; ok {
+		return f, true
+	}
+	f, ok := factories[factoryKey{observerType, pluginconfig.AnyTokenDataObserverVersion}]
+	return f, ok
+}
+
+func init() {
+	MustRegisterFactory(pluginconfig.USDCCCTPHandlerType, pluginconfig.AnyTokenDataObserverVersion, newUSDCObserver)
+	MustRegisterFactory(pluginconfig.USDCCCTPHandlerType, pluginconfig.USDCCCTPVersion2, newUSDCV2Observer)
+	MustRegisterFactory(pluginconfig.RebaseTokenHandlerType, pluginconfig.AnyTokenDataObserverVersion, newRebaseObserver)
+}
+
+func newUSDCObserver(ctx context.Context, p FactoryParams) (TokenDataObserver, error) {
+	usdcConfig, ok := p.Config.TypeConfig.(*pluginconfig.USDCCCTPObserverConfig)
+	if !ok || usdcConfig == nil {
+		return nil, errors.New("USDCCCTPObserverConfig is empty")
+	}
+	cfg := *usdcConfig
+
+	observer, err := usdc.NewUSDCTokenDataObserver(ctx, p.Lggr, p.DestChainSelector,
+		cfg,
+		p.Encoder.EncodeUSDC, p.Readers, p.AddrCodec)
+	if err != nil {
+		return nil, fmt.Errorf("create USDC/CCTP token observer: %w", err)
+	}
+
+	return wrapWithWorkers(p, "USDC/CCTP", cfg.WorkerConfig, observer)
+}
+
+func newUSDCV2Observer(ctx context.Context, p FactoryParams) (TokenDataObserver, error) {
+	usdcConfig, ok := p.Config.TypeConfig.(*pluginconfig.USDCCCTPObserverConfig)
+	if !ok || usdcConfig == nil {
+		return nil, errors.New("USDCCCTPObserverConfig is empty")
+	}
+	cfg := *usdcConfig
+
+	observer, err := usdc.NewUSDCTokenDataObserverV2(ctx, p.Lggr,
+		cfg,
+		p.Encoder.EncodeUSDC, p.Readers, p.AddrCodec)
+	if err != nil {
+		return nil, fmt.Errorf("create USDC/CCTP v2 token observer: %w", err)
+	}
+
+	return wrapWithWorkers(p, "USDC/CCTP v2", cfg.WorkerConfig, observer)
+}
+
+func newRebaseObserver(ctx context.Context, p FactoryParams) (TokenDataObserver, error) {
+	rebaseConfig, ok := p.Config.TypeConfig.(*pluginconfig.RebaseTokenObserverConfig)
+	if !ok || rebaseConfig == nil {
+		return nil, errors.New("RebaseTokenObserverConfig is empty")
+	}
+
+	observer, err := rebase.NewRebaseTokenDataObserver(ctx, p.Lggr,
+		*rebaseConfig,
+		p.Readers, p.AddrCodec)
+	if err != nil {
+		return nil, fmt.Errorf("create rebase token observer: %w", err)
+	}
+	p.Lggr.Info("Using foreground observer for rebase token")
+	return observer, nil
+}
+
+// wrapWithWorkers wraps the observer with the background observer unless the foreground mode is configured.
+func wrapWithWorkers(
+	p FactoryParams,
+	name string,
+	cfg pluginconfig.WorkerConfig,
+	observer TokenDataObserver,
+) (TokenDataObserver, error) {
+	if cfg.IsForeground() {
+		p.Lggr.Infof("Using foreground observer for %s", name)
+		return observer, nil
+	}
+
+	var opts []BackgroundObserverOption
+	if cfg.CacheDir != "" {
+		// Each observer and destination chain gets its own directory, so entries never collide
+		dir := filepath.Join(cfg.CacheDir,
+			fmt.Sprintf("%s-%s-%d", p.Config.Type, p.Config.Version, p.DestChainSelector))
+		store, err := NewFileTokenDataStore(logger.Named(p.Lggr, "TokenDataStore"), dir)
+		if err != nil {
+			return nil, fmt.Errorf("create token data store for %s: %w", name, err)
+		}
+		opts = append(opts, WithTokenDataStore(store))
+	}
+
+	p.Lggr.Infow(fmt.Sprintf("Using background observer for %s", name), "cacheDir", cfg.CacheDir)
+	return NewBackgroundObserver(
+		p.Lggr,
+		observer,
+		cfg.NumWorkers,
+		cfg.CacheExpirationInterval.Duration(),
+		cfg.CacheCleanupInterval.Duration(),
+		cfg.ObserveTimeout.Duration(),
+		opts...,
+	), nil
+}
@@ -0,0 +1,101 @@
+package observer_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/observer"
+	"github.com/smartcontractkit/chainlink-ccip/internal"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+type registryTestConfig struct {
+	SourcePoolAddress string `json:"sourcePoolAddress"`
+}
+
+func (c *registryTestConfig) Validate() error {
+	return nil
+}
+
+// registryTestTypes makes the observer types registered by the tests unique, the registries are global and can't be
+// registered twice, e.g. when running the tests with -count.
+var registryTestTypes atomic.Int64
+
+func Test_ConfigBasedCompositeObservers_Registry(t *testing.T) {
+	observerType := fmt.Sprintf("registry-test-observer-%d", registryTestTypes.Add(1))
+	pool := internal.RandBytes().String()
+
+	pluginconfig.MustRegisterTokenDataObserverConfig(observerType, pluginconfig.AnyTokenDataObserverVersion,
+		pluginconfig.TokenDataObserverConfigHandler{
+			NewConfig: func() pluginconfig.TokenDataObserverTypeConfig { return &registryTestConfig{} },
+		})
+	observer.MustRegisterFactory(observerType, "1.0",
+		func(_ context.Context, p observer.FactoryParams) (observer.TokenDataObserver, error) {
+			cfg := p.Config.TypeConfig.(*registryTestConfig)
+			return fake("TEST", map[cciptypes.ChainSelector]string{1: cfg.SourcePoolAddress}), nil
+		})
+
+	t.Run("registered observer is constructed", func(t *testing.T) {
+		obs, err := observer.NewConfigBasedCompositeObservers(
+			tests.Context(t),
+			logger.Test(t),
+			100,
+			[]pluginconfig.TokenDataObserverConfig{
+				{
+					Type:       observerType,
+					Version:    "1.0",
+					TypeConfig: &registryTestConfig{SourcePoolAddress: pool},
+				},
+			},
+			nil,
+			nil,
+			internal.NewMockAddressCodecHex(t),
+		)
+		require.NoError(t, err)
+
+		tkData, err := obs.Observe(context.Background(), exectypes.MessageObservations{
+			1: {10: internal.MessageWithTokens(t, pool, internal.RandBytes().String())},
+		})
+		require.NoError(t, err)
+		require.Equal(t, exectypes.TokenDataObservations{
+			1: {
+				10: exectypes.NewMessageTokenData(
+					exectypes.NewSuccessTokenData([]byte("TEST_10_0")),
+					exectypes.NewNoopTokenData(),
+				),
+			},
+		}, tkData)
+	})
+
+	t.Run("unregistered version is rejected", func(t *testing.T) {
+		_, err := observer.NewConfigBasedCompositeObservers(
+			tests.Context(t),
+			logger.Test(t),
+			100,
+			[]pluginconfig.TokenDataObserverConfig{
+				{Type: observerType, Version: "2.0", TypeConfig: &registryTestConfig{}},
+			},
+			nil,
+			nil,
+			internal.NewMockAddressCodecHex(t),
+		)
+		require.ErrorContains(t, err, "unsupported token data observer")
+	})
+
+	t.Run("duplicate factory registration fails", func(t *testing.T) {
+		err := observer.RegisterFactory(observerType, "1.0",
+			func(_ context.Context, _ observer.FactoryParams) (observer.TokenDataObserver, error) {
+				return nil, nil
+			})
+		require.ErrorContains(t, err, "already registered")
+	})
+}
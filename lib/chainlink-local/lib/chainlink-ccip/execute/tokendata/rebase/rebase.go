@@ -0,0 +1,211 @@
+package rebase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+const (
+	// evmWordSize is the size of a single abi encoded uint256
+	evmWordSize = 32
+)
+
+var (
+	ErrInvalidInterestRate = errors.New("invalid rebase token interest rate")
+)
+
+// RebaseTokenDataObserver attaches the sender's per-user interest rate to the token data of rebase tokens.
+// The rate is shipped by the source pool in the RampTokenAmount.ExtraData and before it's used as the token data
+// it's validated against the global interest rate of the token on the source chain.
+type RebaseTokenDataObserver struct {
+	lggr                     logger.Logger
+	supportedPoolsBySelector map[cciptypes.ChainSelector]string
+	rebaseTokenReader        reader.RebaseTokenReader
+}
+
+func NewRebaseTokenDataObserver(
+	ctx context.Context,
+	lggr logger.Logger,
+	rebaseConfig pluginconfig.RebaseTokenObserverConfig,
+	readers map[cciptypes.ChainSelector]contractreader.ContractReaderFacade,
+	addrCodec cciptypes.AddressCodec,
+) (*RebaseTokenDataObserver, error) {
+	rebaseReader, err := reader.NewRebaseTokenReader(
+		ctx,
+		lggr,
+		rebaseConfig.Tokens,
+		readers,
+		addrCodec,
+	)
+	if err != nil {
+		return nil, err
+	}
+	supportedPoolsBySelector := make(map[cciptypes.ChainSelector]string)
+	for chainSelector, tokenConfig := range rebaseConfig.Tokens {
+		supportedPoolsBySelector[chainSelector] = tokenConfig.SourcePoolAddress
+	}
+	lggr.Infow("Created Rebase Token Data Observer",
+		"supportedTokenPools", supportedPoolsBySelector,
+	)
+	return InitRebaseTokenDataObserver(lggr, supportedPoolsBySelector, rebaseReader), nil
+}
+
+func InitRebaseTokenDataObserver(
+	lggr logger.Logger,
+	supportedPoolsBySelector map[cciptypes.ChainSelector]string,
+	rebaseTokenReader reader.RebaseTokenReader,
+) *RebaseTokenDataObserver {
+	return &RebaseTokenDataObserver{
+		lggr:                     lggr,
+		supportedPoolsBySelector: supportedPoolsBySelector,
+		rebaseTokenReader:        rebaseTokenReader,
+	}
+}
+
+func (r *RebaseTokenDataObserver) Observe(
+	ctx context.Context,
+	messages exectypes.MessageObservations,
+) (exectypes.TokenDataObservations, error) {
+	lggr := logutil.WithContextValues(ctx, r.lggr)
+
+	tokenObservations := make(exectypes.TokenDataObservations)
+	for chainSelector, chainMessages := range messages {
+		tokenObservations[chainSelector] = make(map[cciptypes.SeqNum]exectypes.MessageTokenData)
+
+		// Global rate is fetched lazily, only when there is at least one rebase token from that chain
+		var globalRate *big.Int
+		var globalRateErr error
+
+		for seqNum, message := range chainMessages {
+			tokenData := make([]exectypes.TokenData, len(message.TokenAmounts))
+			for i, tokenAmount := range message.TokenAmounts {
+				if !r.IsTokenSupported(chainSelector, tokenAmount) {
+					tokenData[i] = exectypes.NotSupportedTokenData()
+					continue
+				}
+
+				if globalRate == nil && globalRateErr == nil {
+					globalRate, globalRateErr = r.rebaseTokenReader.GlobalInterestRate(ctx, chainSelector)
+					if globalRateErr != nil {
+						lggr.Errorw(
+							"Failed fetching global interest rate from the source chain",
+							"sourceChainSelector", chainSelector,
+							"error", globalRateErr,
+						)
+					}
+				}
+				if globalRateErr != nil {
+					tokenData[i] = exectypes.NewErrorTokenData(globalRateErr)
+					continue
+				}
+
+				tokenData[i] = r.extractTokenData(lggr, chainSelector, seqNum, tokenAmount, globalRate)
+			}
+			tokenObservations[chainSelector][seqNum] = exectypes.NewMessageTokenData(tokenData...)
+		}
+	}
+	return tokenObservations, nil
+}
+
+func (r *RebaseTokenDataObserver) IsTokenSupported(
+	sourceChain cciptypes.ChainSelector,
+	msgToken cciptypes.RampTokenAmount,
+) bool {
+	return strings.EqualFold(r.supportedPoolsBySelector[sourceChain], msgToken.SourcePoolAddress.String())
+}
+
+func (r *RebaseTokenDataObserver) Close() error {
+	return nil
+}
+
+func (r *RebaseTokenDataObserver) extractTokenData(
+	lggr logger.Logger,
+	chainSelector cciptypes.ChainSelector,
+	seqNum cciptypes.SeqNum,
+	tokenAmount cciptypes.RampTokenAmount,
+	globalRate *big.Int,
+) exectypes.TokenData {
+	payload, err := NewSourcePoolDataPayloadFromBytes(tokenAmount.ExtraData)
+	if err != nil {
+		return exectypes.NewErrorTokenData(fmt.Errorf("decode source pool data: %w", err))
+	}
+
+	if err := payload.Validate(globalRate); err != nil {
+		lggr.Warnw(
+			"Rebase token interest rate didn't pass validation",
+			"seqNum", seqNum,
+			"sourceChainSelector", chainSelector,
+			"sourcePoolAddress", tokenAmount.SourcePoolAddress.String(),
+			"userInterestRate", payload.UserInterestRate.String(),
+			"globalInterestRate", globalRate.String(),
+			"error", err,
+		)
+		return exectypes.NewErrorTokenData(err)
+	}
+	return exectypes.NewSuccessTokenData(payload.ToBytes())
+}
+
+// SourcePoolDataPayload extracts the per-user interest rate from the rebase token pool's source data.
+// Please see the Solidity code in CCRebaseTokenPool to understand more details
+//
+//	uint256 userInterestRate = ICCRebaseToken(address(i_token)).getUserInterestRate(lockOrBurnIn.originalSender);
+//	lockOrBurnOut = Pool.LockOrBurnOutV1({
+//	    destTokenAddress: getRemoteToken(lockOrBurnIn.remoteChainSelector),
+//	    destPoolData: abi.encode(userInterestRate)
+//	});
+//
+// Implementation relies on the EVM abi encoding, so entire struct is EVM-specific and can't be reused for other chains
+type SourcePoolDataPayload struct {
+	UserInterestRate *big.Int
+}
+
+func NewSourcePoolDataPayload(userInterestRate *big.Int) *SourcePoolDataPayload {
+	return &SourcePoolDataPayload{
+		UserInterestRate: userInterestRate,
+	}
+}
+
+func NewSourcePoolDataPayloadFromBytes(extraData cciptypes.Bytes) (*SourcePoolDataPayload, error) {
+	if len(extraData) < evmWordSize {
+		return nil, fmt.Errorf("extraData is too short, expected at least %d bytes", evmWordSize)
+	}
+
+	return &SourcePoolDataPayload{
+		UserInterestRate: new(big.Int).SetBytes(extraData[:evmWordSize]),
+	}, nil
+}
+
+// Validate checks the per-user rate against the current global rate of the token. Global rate can only decrease,
+// therefore every per-user rate snapshotted by the source pool must be greater or equal to the current global rate.
+func (s SourcePoolDataPayload) Validate(globalRate *big.Int) error {
+	if s.UserInterestRate == nil || s.UserInterestRate.Sign() <= 0 {
+		return fmt.Errorf("%w: user interest rate must be positive", ErrInvalidInterestRate)
+	}
+	if globalRate == nil || globalRate.Sign() < 0 {
+		return fmt.Errorf("%w: global interest rate must be set", ErrInvalidInterestRate)
+	}
+	if s.UserInterestRate.Cmp(globalRate) < 0 {
+		return fmt.Errorf("%w: user interest rate %s is lower than the global interest rate %s",
+			ErrInvalidInterestRate, s.UserInterestRate.String(), globalRate.String())
+	}
+	return nil
+}
+
+// ToBytes returns the normalized (abi encoded uint256) representation of the per-user interest rate
+func (s SourcePoolDataPayload) ToBytes() cciptypes.Bytes {
+	rateBytes := [evmWordSize]byte{} // padded to 32 bytes
+	s.UserInterestRate.FillBytes(rateBytes[:])
+	return rateBytes[:]
+}
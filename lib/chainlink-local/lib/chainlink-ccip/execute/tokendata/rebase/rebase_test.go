@@ -0,0 +1,203 @@
+package rebase_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/rebase"
+	"github.com/smartcontractkit/chainlink-ccip/internal"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+func TestRebaseTokenDataObserver_Observe(t *testing.T) {
+	ethereumRebasePool := internal.RandBytes().String()
+	avalancheRebasePool := internal.RandBytes().String()
+	supportedPoolsBySelector := map[cciptypes.ChainSelector]string{
+		cciptypes.ChainSelector(1): ethereumRebasePool,
+		cciptypes.ChainSelector(2): avalancheRebasePool,
+	}
+
+	globalRates := reader.NewFakeRebaseTokenReader(map[cciptypes.ChainSelector]*big.Int{
+		1: big.NewInt(500),
+		2: big.NewInt(400),
+	})
+
+	tests := []struct {
+		name                string
+		messageObservations exectypes.MessageObservations
+		rebaseReader        reader.RebaseTokenReader
+		expectedTokenData   exectypes.TokenDataObservations
+		expectedErrors      map[cciptypes.SeqNum][]int
+	}{
+		{
+			name:                "no messages",
+			messageObservations: exectypes.MessageObservations{},
+			rebaseReader:        globalRates,
+			expectedTokenData:   exectypes.TokenDataObservations{},
+		},
+		{
+			name: "no rebase messages",
+			messageObservations: exectypes.MessageObservations{
+				1: {
+					10: messageWithRebaseTokens(t, rebaseToken{pool: internal.RandBytes().String()}),
+					11: messageWithRebaseTokens(t),
+				},
+			},
+			rebaseReader: reader.NewFakeRebaseTokenReader(nil),
+			expectedTokenData: exectypes.TokenDataObservations{
+				1: {
+					10: exectypes.NewMessageTokenData(exectypes.NotSupportedTokenData()),
+					11: exectypes.NewMessageTokenData(),
+				},
+			},
+		},
+		{
+			name: "rebase tokens mixed with regular tokens on multiple chains",
+			messageObservations: exectypes.MessageObservations{
+				1: {
+					10: messageWithRebaseTokens(t,
+						rebaseToken{pool: internal.RandBytes().String()},
+						rebaseToken{pool: ethereumRebasePool, rate: big.NewInt(500)},
+					),
+				},
+				2: {
+					12: messageWithRebaseTokens(t,
+						rebaseToken{pool: avalancheRebasePool, rate: big.NewInt(1000)},
+					),
+				},
+			},
+			rebaseReader: globalRates,
+			expectedTokenData: exectypes.TokenDataObservations{
+				1: {
+					10: exectypes.NewMessageTokenData(
+						exectypes.NotSupportedTokenData(),
+						exectypes.NewSuccessTokenData(rebase.NewSourcePoolDataPayload(big.NewInt(500)).ToBytes()),
+					),
+				},
+				2: {
+					12: exectypes.NewMessageTokenData(
+						exectypes.NewSuccessTokenData(rebase.NewSourcePoolDataPayload(big.NewInt(1000)).ToBytes()),
+					),
+				},
+			},
+		},
+		{
+			name: "user rate lower than global rate is rejected",
+			messageObservations: exectypes.MessageObservations{
+				1: {
+					10: messageWithRebaseTokens(t,
+						rebaseToken{pool: ethereumRebasePool, rate: big.NewInt(499)},
+						rebaseToken{pool: ethereumRebasePool, rate: big.NewInt(501)},
+					),
+				},
+			},
+			rebaseReader: globalRates,
+			expectedTokenData: exectypes.TokenDataObservations{
+				1: {
+					10: exectypes.NewMessageTokenData(
+						exectypes.NewErrorTokenData(rebase.ErrInvalidInterestRate),
+						exectypes.NewSuccessTokenData(rebase.NewSourcePoolDataPayload(big.NewInt(501)).ToBytes()),
+					),
+				},
+			},
+			expectedErrors: map[cciptypes.SeqNum][]int{10: {0}},
+		},
+		{
+			name: "malformed pool data and missing global rate are reported as errors",
+			messageObservations: exectypes.MessageObservations{
+				1: {
+					10: messageWithRebaseTokens(t, rebaseToken{pool: ethereumRebasePool}),
+				},
+				2: {
+					12: messageWithRebaseTokens(t, rebaseToken{pool: avalancheRebasePool, rate: big.NewInt(1000)}),
+				},
+			},
+			rebaseReader: reader.NewFakeRebaseTokenReader(map[cciptypes.ChainSelector]*big.Int{
+				1: big.NewInt(500),
+			}),
+			expectedTokenData: exectypes.TokenDataObservations{
+				1: {
+					10: exectypes.NewMessageTokenData(exectypes.NewErrorTokenData(nil)),
+				},
+				2: {
+					12: exectypes.NewMessageTokenData(exectypes.NewErrorTokenData(nil)),
+				},
+			},
+			expectedErrors: map[cciptypes.SeqNum][]int{10: {0}, 12: {0}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			observer := rebase.InitRebaseTokenDataObserver(
+				logger.Test(t),
+				supportedPoolsBySelector,
+				test.rebaseReader,
+			)
+
+			tkData, err := observer.Observe(context.Background(), test.messageObservations)
+			require.NoError(t, err)
+
+			// Errors are compared separately, because wrapped errors carry message specific details
+			for chainSelector, chainTokenData := range tkData {
+				for seqNum, msgTokenData := range chainTokenData {
+					for _, idx := range test.expectedErrors[seqNum] {
+						require.Error(t, msgTokenData.TokenData[idx].Error)
+						msgTokenData.TokenData[idx].Error = nil
+						test.expectedTokenData[chainSelector][seqNum].TokenData[idx].Error = nil
+					}
+				}
+			}
+			require.Equal(t, test.expectedTokenData, tkData)
+		})
+	}
+}
+
+func TestSourcePoolDataPayload(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		payload := rebase.NewSourcePoolDataPayload(big.NewInt(5e10))
+		decoded, err := rebase.NewSourcePoolDataPayloadFromBytes(payload.ToBytes())
+		require.NoError(t, err)
+		require.Equal(t, payload.UserInterestRate, decoded.UserInterestRate)
+		require.Len(t, payload.ToBytes(), 32)
+	})
+
+	t.Run("too short", func(t *testing.T) {
+		_, err := rebase.NewSourcePoolDataPayloadFromBytes([]byte{1, 2, 3})
+		require.ErrorContains(t, err, "extraData is too short")
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		require.NoError(t, rebase.NewSourcePoolDataPayload(big.NewInt(10)).Validate(big.NewInt(10)))
+		require.ErrorIs(t, rebase.NewSourcePoolDataPayload(big.NewInt(9)).Validate(big.NewInt(10)),
+			rebase.ErrInvalidInterestRate)
+		require.ErrorIs(t, rebase.NewSourcePoolDataPayload(big.NewInt(0)).Validate(big.NewInt(0)),
+			rebase.ErrInvalidInterestRate)
+	})
+}
+
+type rebaseToken struct {
+	pool string
+	rate *big.Int
+}
+
+func messageWithRebaseTokens(t *testing.T, tokens ...rebaseToken) cciptypes.Message {
+	pools := make([]string, len(tokens))
+	for i, token := range tokens {
+		pools[i] = token.pool
+	}
+	msg := internal.MessageWithTokens(t, pools...)
+	for i, token := range tokens {
+		if token.rate != nil {
+			msg.TokenAmounts[i].ExtraData = rebase.NewSourcePoolDataPayload(token.rate).ToBytes()
+		}
+	}
+	return msg
+}
@@ -82,6 +82,7 @@ func NewSequentialAttestationClient(
 		config.AttestationAPIInterval.Duration(),
 		config.AttestationAPITimeout.Duration(),
 		config.AttestationAPICooldown.Duration(),
+		config.AttestationAPIRateLimiterFile,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("create HTTP client: %w", err)
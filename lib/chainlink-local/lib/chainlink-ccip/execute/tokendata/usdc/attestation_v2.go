@@ -0,0 +1,125 @@
+package usdc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/http"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+const (
+	apiVersionV2 = "v2"
+	messagesPath = "messages"
+)
+
+// httpResponseV2 is the response of the `GET /v2/messages/{sourceDomain}?transactionHash={txHash}` endpoint.
+// A single source transaction can burn USDC multiple times, so the API returns a list of messages.
+type httpResponseV2 struct {
+	Messages []httpMessageV2 `json:"messages"`
+	Error    string          `json:"error"`
+}
+
+type httpMessageV2 struct {
+	Message     string            `json:"message"`
+	EventNonce  string            `json:"eventNonce"`
+	Attestation string            `json:"attestation"`
+	CCTPVersion int               `json:"cctpVersion"`
+	Status      attestationStatus `json:"status"`
+}
+
+// AttestationMessageV2 is a CCTP v2 message returned by the attestation API. Message and Attestation are empty
+// until the attestation is complete.
+type AttestationMessageV2 struct {
+	Status      attestationStatus
+	Message     cciptypes.Bytes
+	Attestation cciptypes.Bytes
+}
+
+func (m AttestationMessageV2) IsComplete() bool {
+	return m.Status == attestationStatusSuccess
+}
+
+// USDCAttestationClientV2 fetches CCTP v2 messages and their attestations from the Circle API. In contrast to v1,
+// messages are looked up by the source domain and the source transaction hash, because nonce is assigned by the
+// attestation service and message hash can't be computed from the `MessageSent (bytes message)` event.
+type USDCAttestationClientV2 struct {
+	lggr   logger.Logger
+	client http.HTTPClient
+}
+
+func NewUSDCAttestationClientV2(
+	lggr logger.Logger,
+	config pluginconfig.USDCCCTPObserverConfig,
+) (*USDCAttestationClientV2, error) {
+	client, err := http.GetHTTPClient(
+		lggr,
+		config.AttestationAPI,
+		config.AttestationAPIInterval.Duration(),
+		config.AttestationAPITimeout.Duration(),
+		config.AttestationAPICooldown.Duration(),
+		config.AttestationAPIRateLimiterFile,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create HTTP client: %w", err)
+	}
+	return InitUSDCAttestationClientV2(lggr, client), nil
+}
+
+func InitUSDCAttestationClientV2(lggr logger.Logger, client http.HTTPClient) *USDCAttestationClientV2 {
+	return &USDCAttestationClientV2{
+		lggr:   lggr,
+		client: client,
+	}
+}
+
+// Messages returns all CCTP v2 messages emitted by the transaction on the source domain.
+// tokendata.ErrNotReady is returned when the transaction is not indexed by the attestation service yet.
+func (s *USDCAttestationClientV2) Messages(
+	ctx context.Context,
+	sourceDomain uint32,
+	txHash string,
+) ([]AttestationMessageV2, error) {
+	body, _, err := s.client.Get(
+		ctx,
+		fmt.Sprintf("%s/%s/%d?transactionHash=%s", apiVersionV2, messagesPath, sourceDomain, txHash),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return messagesFromResponseV2(body)
+}
+
+func messagesFromResponseV2(body cciptypes.Bytes) ([]AttestationMessageV2, error) {
+	var response httpResponseV2
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to decode json: %w", err)
+	}
+	if response.Error != "" {
+		return nil, fmt.Errorf("attestation API error: %s", response.Error)
+	}
+
+	messages := make([]AttestationMessageV2, 0, len(response.Messages))
+	for _, m := range response.Messages {
+		if m.Status == "" {
+			return nil, fmt.Errorf("invalid attestation response")
+		}
+		out := AttestationMessageV2{Status: m.Status}
+		if m.Status == attestationStatusSuccess {
+			var err error
+			if out.Message, err = cciptypes.NewBytesFromString(m.Message); err != nil {
+				return nil, fmt.Errorf("failed to decode message hex: %w", err)
+			}
+			if out.Attestation, err = cciptypes.NewBytesFromString(m.Attestation); err != nil {
+				return nil, fmt.Errorf("failed to decode attestation hex: %w", err)
+			}
+		}
+		messages = append(messages, out)
+	}
+	return messages, nil
+}
@@ -0,0 +1,346 @@
+package usdc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+// AttestationClientV2 returns CCTP v2 messages emitted by the source transaction, see USDCAttestationClientV2.
+type AttestationClientV2 interface {
+	Messages(ctx context.Context, sourceDomain uint32, txHash string) ([]AttestationMessageV2, error)
+}
+
+// USDCTokenDataObserverV2 is the CCTP v2 counterpart of the USDCTokenDataObserver. CCTP v2 messages don't have
+// nonce when emitted on the source chain, so they can't be attested by the message hash. Instead, observer reads
+// the `MessageSent` events of the source transaction (matched with the USDC transfers by the deposit hash shipped
+// in the SourceTokenDataPayloadV2), queries the attestation API for all messages of that transaction and picks
+// the attested message that was emitted as the event.
+type USDCTokenDataObserverV2 struct {
+	lggr                     logger.Logger
+	supportedPoolsBySelector map[cciptypes.ChainSelector]string
+	minFinalityThreshold     uint32
+	attestationEncoder       AttestationEncoder
+	usdcMessageReader        reader.USDCMessageReaderV2
+	attestationClient        AttestationClientV2
+}
+
+func NewUSDCTokenDataObserverV2(
+	ctx context.Context,
+	lggr logger.Logger,
+	usdcConfig pluginconfig.USDCCCTPObserverConfig,
+	attestationEncoder AttestationEncoder,
+	readers map[cciptypes.ChainSelector]contractreader.ContractReaderFacade,
+	addrCodec cciptypes.AddressCodec,
+) (*USDCTokenDataObserverV2, error) {
+	usdcReader, err := reader.NewUSDCMessageReaderV2(
+		ctx,
+		lggr,
+		usdcConfig.Tokens,
+		readers,
+		addrCodec,
+	)
+	if err != nil {
+		return nil, err
+	}
+	attestationClient, err := NewUSDCAttestationClientV2(lggr, usdcConfig)
+	if err != nil {
+		return nil, fmt.Errorf("create attestation client: %w", err)
+	}
+	supportedPoolsBySelector := make(map[cciptypes.ChainSelector]string)
+	for chainSelector, tokenConfig := range usdcConfig.Tokens {
+		supportedPoolsBySelector[chainSelector] = tokenConfig.SourcePoolAddress
+	}
+	lggr.Infow("Created USDC CCTP v2 Token Data Observer",
+		"supportedTokenPools", supportedPoolsBySelector,
+		"minFinalityThreshold", usdcConfig.MinFinalityThreshold,
+	)
+	return InitUSDCTokenDataObserverV2(
+		lggr,
+		supportedPoolsBySelector,
+		usdcConfig.MinFinalityThreshold,
+		attestationEncoder,
+		usdcReader,
+		attestationClient,
+	), nil
+}
+
+func InitUSDCTokenDataObserverV2(
+	lggr logger.Logger,
+	supportedPoolsBySelector map[cciptypes.ChainSelector]string,
+	minFinalityThreshold uint32,
+	attestationEncoder AttestationEncoder,
+	usdcMessageReader reader.USDCMessageReaderV2,
+	attestationClient AttestationClientV2,
+) *USDCTokenDataObserverV2 {
+	if minFinalityThreshold == 0 {
+		minFinalityThreshold = reader.CCTPFinalityThresholdStandard
+	}
+	return &USDCTokenDataObserverV2{
+		lggr:                     lggr,
+		supportedPoolsBySelector: supportedPoolsBySelector,
+		minFinalityThreshold:     minFinalityThreshold,
+		attestationEncoder:       attestationEncoder,
+		usdcMessageReader:        usdcMessageReader,
+		attestationClient:        attestationClient,
+	}
+}
+
+// sourceTx identifies a single request to the attestation API
+type sourceTx struct {
+	sourceDomain uint32
+	txHash       string
+}
+
+// usdcTransferV2 is a single USDC token transfer of the CCIP message
+type usdcTransferV2 struct {
+	chainSelector cciptypes.ChainSelector
+	seqNum        cciptypes.SeqNum
+	tokenIndex    int
+	tx            sourceTx
+	depositHash   [32]byte
+	// sentMessage is the message emitted in the `MessageSent` event of the source transaction
+	sentMessage cciptypes.Bytes
+}
+
+func (t usdcTransferV2) tokenID() reader.MessageTokenID {
+	return reader.NewMessageTokenID(t.seqNum, t.tokenIndex)
+}
+
+func (u *USDCTokenDataObserverV2) Observe(
+	ctx context.Context,
+	messages exectypes.MessageObservations,
+) (exectypes.TokenDataObservations, error) {
+	lggr := logutil.WithContextValues(ctx, u.lggr)
+
+	// 1. Initialize token data of every message, USDC transfers are marked as missing until matched
+	tokenObservations := make(exectypes.TokenDataObservations)
+	transfers := make([]usdcTransferV2, 0)
+	for chainSelector, chainMessages := range messages {
+		tokenObservations[chainSelector] = make(map[cciptypes.SeqNum]exectypes.MessageTokenData)
+		for seqNum, message := range chainMessages {
+			tokenData := make([]exectypes.TokenData, len(message.TokenAmounts))
+			for i, tokenAmount := range message.TokenAmounts {
+				if !u.IsTokenSupported(chainSelector, tokenAmount) {
+					tokenData[i] = exectypes.NotSupportedTokenData()
+					continue
+				}
+				transfer, err := u.newTransfer(chainSelector, seqNum, i, message, tokenAmount)
+				if err != nil {
+					lggr.Warnw("Unable to extract USDC CCTP v2 transfer from the message",
+						"seqNum", seqNum,
+						"sourceChainSelector", chainSelector,
+						"tokenIndex", i,
+						"err", err,
+					)
+					tokenData[i] = exectypes.NewErrorTokenData(err)
+					continue
+				}
+				tokenData[i] = exectypes.NewErrorTokenData(tokendata.ErrDataMissing)
+				transfers = append(transfers, transfer)
+			}
+			tokenObservations[chainSelector][seqNum] = exectypes.NewMessageTokenData(tokenData...)
+		}
+	}
+
+	// 2. Fetch USDC messages by token id based on the `MessageSent (bytes message)` event
+	transfers, err := u.fetchUSDCEventMessages(ctx, lggr, transfers)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Fetch messages of every source transaction once and match them with transfers. Transfers are processed
+	// in order, so that identical burns within a single transaction are assigned to distinct CCTP messages.
+	sort.Slice(transfers, func(i, j int) bool {
+		if transfers[i].chainSelector != transfers[j].chainSelector {
+			return transfers[i].chainSelector < transfers[j].chainSelector
+		}
+		if transfers[i].seqNum != transfers[j].seqNum {
+			return transfers[i].seqNum < transfers[j].seqNum
+		}
+		return transfers[i].tokenIndex < transfers[j].tokenIndex
+	})
+
+	fetched := make(map[sourceTx]*sourceTxMessages)
+	for _, transfer := range transfers {
+		if transfer.sentMessage == nil {
+			continue
+		}
+		txMessages, ok := fetched[transfer.tx]
+		if !ok {
+			txMessages = u.fetchMessages(ctx, lggr, transfer.tx)
+			fetched[transfer.tx] = txMessages
+		}
+		tokenObservations[transfer.chainSelector][transfer.seqNum].TokenData[transfer.tokenIndex] =
+			u.matchTokenData(ctx, transfer, txMessages)
+	}
+	return tokenObservations, nil
+}
+
+func (u *USDCTokenDataObserverV2) IsTokenSupported(
+	sourceChain cciptypes.ChainSelector,
+	msgToken cciptypes.RampTokenAmount,
+) bool {
+	return strings.EqualFold(u.supportedPoolsBySelector[sourceChain], msgToken.SourcePoolAddress.String())
+}
+
+func (u *USDCTokenDataObserverV2) Close() error {
+	return nil
+}
+
+func (u *USDCTokenDataObserverV2) newTransfer(
+	chainSelector cciptypes.ChainSelector,
+	seqNum cciptypes.SeqNum,
+	tokenIndex int,
+	message cciptypes.Message,
+	tokenAmount cciptypes.RampTokenAmount,
+) (usdcTransferV2, error) {
+	if message.Header.TxHash == "" {
+		return usdcTransferV2{}, fmt.Errorf("source transaction hash unknown: %w", tokendata.ErrDataMissing)
+	}
+	payload, err := reader.NewSourceTokenDataPayloadV2FromBytes(tokenAmount.ExtraData)
+	if err != nil {
+		return usdcTransferV2{}, fmt.Errorf("decode source token data payload: %w", err)
+	}
+	return usdcTransferV2{
+		chainSelector: chainSelector,
+		seqNum:        seqNum,
+		tokenIndex:    tokenIndex,
+		tx:            sourceTx{sourceDomain: payload.SourceDomain, txHash: message.Header.TxHash},
+		depositHash:   payload.DepositHash,
+	}, nil
+}
+
+// fetchUSDCEventMessages reads the `MessageSent` events of the transfers from the source chains. Transfers without
+// a matching event stay marked as missing.
+func (u *USDCTokenDataObserverV2) fetchUSDCEventMessages(
+	ctx context.Context,
+	lggr logger.Logger,
+	transfers []usdcTransferV2,
+) ([]usdcTransferV2, error) {
+	byChain := make(map[cciptypes.ChainSelector]map[reader.MessageTokenID]reader.CCTPTransferV2)
+	for _, transfer := range transfers {
+		if _, ok := byChain[transfer.chainSelector]; !ok {
+			byChain[transfer.chainSelector] = make(map[reader.MessageTokenID]reader.CCTPTransferV2)
+		}
+		byChain[transfer.chainSelector][transfer.tokenID()] = reader.CCTPTransferV2{
+			TxHash:      transfer.tx.txHash,
+			DepositHash: transfer.depositHash,
+		}
+	}
+
+	msgByChain := make(map[cciptypes.ChainSelector]map[reader.MessageTokenID]cciptypes.Bytes, len(byChain))
+	for chainSelector, chainTransfers := range byChain {
+		// TODO Sequential reading USDC messages from the source chain
+		msgByTokenID, err := u.usdcMessageReader.MessagesByTokenID(ctx, chainSelector, chainTransfers)
+		if err != nil {
+			lggr.Errorw(
+				"Failed fetching USDC CCTP v2 events from the source chain",
+				"sourceChainSelector", chainSelector,
+				"messageTokenIDs", maps.Keys(chainTransfers),
+				"error", err,
+			)
+			return nil, err
+		}
+		msgByChain[chainSelector] = msgByTokenID
+	}
+
+	for i := range transfers {
+		transfers[i].sentMessage = msgByChain[transfers[i].chainSelector][transfers[i].tokenID()]
+	}
+	return transfers, nil
+}
+
+// sourceTxMessages holds the decoded CCTP v2 messages of a single source transaction
+type sourceTxMessages struct {
+	err      error
+	messages []decodedMessageV2
+}
+
+type decodedMessageV2 struct {
+	AttestationMessageV2
+	decoded *reader.CCTPMessageV2
+	used    bool
+}
+
+func (u *USDCTokenDataObserverV2) fetchMessages(
+	ctx context.Context,
+	lggr logger.Logger,
+	tx sourceTx,
+) *sourceTxMessages {
+	lggr.Debugw("Fetching CCTP v2 messages from the API",
+		"sourceDomain", tx.sourceDomain,
+		"txHash", tx.txHash,
+	)
+	messages, err := u.attestationClient.Messages(ctx, tx.sourceDomain, tx.txHash)
+	if err != nil {
+		return &sourceTxMessages{err: err}
+	}
+
+	out := &sourceTxMessages{messages: make([]decodedMessageV2, 0, len(messages))}
+	for _, m := range messages {
+		decoded := decodedMessageV2{AttestationMessageV2: m}
+		if m.IsComplete() {
+			msg, err1 := reader.DecodeCCTPMessageV2(m.Message)
+			if err1 != nil {
+				lggr.Warnw("Ignoring CCTP message returned by the API",
+					"sourceDomain", tx.sourceDomain,
+					"txHash", tx.txHash,
+					"err", err1,
+				)
+				continue
+			}
+			decoded.decoded = msg
+		}
+		out.messages = append(out.messages, decoded)
+	}
+	return out
+}
+
+func (u *USDCTokenDataObserverV2) matchTokenData(
+	ctx context.Context,
+	transfer usdcTransferV2,
+	txMessages *sourceTxMessages,
+) exectypes.TokenData {
+	if txMessages.err != nil {
+		return exectypes.NewErrorTokenData(txMessages.err)
+	}
+
+	for i := range txMessages.messages {
+		m := &txMessages.messages[i]
+		if m.used || m.decoded == nil || !bytes.Equal(m.decoded.SentMessage(), transfer.sentMessage) {
+			continue
+		}
+		m.used = true
+		if m.decoded.FinalityThresholdExecuted < u.minFinalityThreshold {
+			return exectypes.NewErrorTokenData(tokendata.ErrNotReady)
+		}
+		tokenData, err := u.attestationEncoder(ctx, m.Message, m.Attestation)
+		if err != nil {
+			return exectypes.NewErrorTokenData(fmt.Errorf("unable to encode attestation: %w", err))
+		}
+		return exectypes.NewSuccessTokenData(tokenData)
+	}
+
+	// Messages with pending attestations can't be decoded, so the transfer is most likely not attested yet
+	for _, m := range txMessages.messages {
+		if !m.IsComplete() {
+			return exectypes.NewErrorTokenData(tokendata.ErrNotReady)
+		}
+	}
+	return exectypes.NewErrorTokenData(tokendata.ErrDataMissing)
+}
@@ -0,0 +1,190 @@
+package usdc_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata"
+	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/usdc"
+	"github.com/smartcontractkit/chainlink-ccip/internal"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+type usdcMessageV2 struct {
+	txHash      string
+	message     reader.CCTPMessageV2
+	attestation cciptypes.Bytes
+	status      string
+}
+
+func newUSDCMessageV2(txHash string, amount int64, finalityExecuted uint32) usdcMessageV2 {
+	return usdcMessageV2{
+		txHash: txHash,
+		message: reader.CCTPMessageV2{
+			Version:                   reader.CCTPMessageVersionV2,
+			SourceDomain:              0,
+			DestinationDomain:         6,
+			Nonce:                     [32]byte(internal.RandBytes()),
+			MintRecipient:             [32]byte(internal.RandBytes()),
+			BurnToken:                 [32]byte(internal.RandBytes()),
+			MinFinalityThreshold:      reader.CCTPFinalityThresholdFast,
+			FinalityThresholdExecuted: finalityExecuted,
+			Amount:                    big.NewInt(amount),
+			MaxFee:                    big.NewInt(0),
+			FeeExecuted:               big.NewInt(0),
+			ExpirationBlock:           big.NewInt(0),
+		},
+		attestation: internal.RandBytes(),
+		status:      "complete",
+	}
+}
+
+func (m usdcMessageV2) tokenAmount(t *testing.T, pool string) cciptypes.RampTokenAmount {
+	poolAddr, err := cciptypes.NewUnknownAddressFromHex(pool)
+	require.NoError(t, err)
+	return cciptypes.RampTokenAmount{
+		SourcePoolAddress: poolAddr,
+		ExtraData:         reader.NewSourceTokenDataPayloadV2(0, m.message.DepositHash()).ToBytes(),
+	}
+}
+
+func (m usdcMessageV2) rampMessage(t *testing.T, pool string) cciptypes.Message {
+	return cciptypes.Message{
+		Header:       cciptypes.RampMessageHeader{TxHash: m.txHash},
+		TokenAmounts: []cciptypes.RampTokenAmount{m.tokenAmount(t, pool)},
+	}
+}
+
+func Test_USDC_CCTPv2_Flow(t *testing.T) {
+	pool := internal.RandBytes().String()
+	tx1 := internal.RandBytes().String()
+	tx2 := internal.RandBytes().String()
+
+	m1 := newUSDCMessageV2(tx1, 100, reader.CCTPFinalityThresholdStandard)
+	m2 := newUSDCMessageV2(tx1, 200, reader.CCTPFinalityThresholdStandard)
+	m2.status = "pending_confirmations"
+	m3 := newUSDCMessageV2(tx2, 300, reader.CCTPFinalityThresholdFast)
+	m4 := newUSDCMessageV2(tx2, 400, reader.CCTPFinalityThresholdStandard)
+	// m5 has the same deposit hash as the emitted event, but the attested message is different
+	m5 := newUSDCMessageV2(tx2, 500, reader.CCTPFinalityThresholdStandard)
+	m5Event := m5.message
+	m5Event.HookData = internal.RandBytes()
+
+	server := mockHTTPServerResponseV2(t, m1, m2, m3, m4, m5)
+	defer server.Close()
+
+	encoder := func(_ context.Context, _ cciptypes.Bytes, attestation cciptypes.Bytes) (cciptypes.Bytes, error) {
+		return attestation, nil
+	}
+
+	unknownTx := newUSDCMessageV2(internal.RandBytes().String(), 600, reader.CCTPFinalityThresholdStandard)
+	noTxHash := newUSDCMessageV2("", 700, reader.CCTPFinalityThresholdStandard)
+	noEvent := newUSDCMessageV2(tx1, 800, reader.CCTPFinalityThresholdStandard)
+
+	usdcReader := reader.NewFakeUSDCMessageReaderV2(map[reader.MessageTokenID]cciptypes.Bytes{
+		reader.NewMessageTokenID(10, 0): m1.message.SentMessage(),
+		reader.NewMessageTokenID(11, 0): m2.message.SentMessage(),
+		reader.NewMessageTokenID(12, 0): m3.message.SentMessage(),
+		reader.NewMessageTokenID(13, 0): m4.message.SentMessage(),
+		reader.NewMessageTokenID(14, 0): m5Event.SentMessage(),
+		reader.NewMessageTokenID(15, 0): unknownTx.message.SentMessage(),
+		reader.NewMessageTokenID(16, 0): noTxHash.message.SentMessage(),
+	})
+
+	newObserver := func(t *testing.T, minFinalityThreshold uint32) *usdc.USDCTokenDataObserverV2 {
+		config := pluginconfig.USDCCCTPObserverConfig{
+			AttestationConfig: pluginconfig.AttestationConfig{
+				AttestationAPI:         server.URL,
+				AttestationAPIInterval: commonconfig.MustNewDuration(1 * time.Microsecond),
+				AttestationAPITimeout:  commonconfig.MustNewDuration(1 * time.Second),
+			},
+			AttestationAPICooldown: commonconfig.MustNewDuration(5 * time.Minute),
+		}
+		attestationClient, err := usdc.NewUSDCAttestationClientV2(logger.Test(t), config)
+		require.NoError(t, err)
+		return usdc.InitUSDCTokenDataObserverV2(
+			logger.Test(t),
+			map[cciptypes.ChainSelector]string{1: pool},
+			minFinalityThreshold,
+			encoder,
+			usdcReader,
+			attestationClient,
+		)
+	}
+
+	messages := exectypes.MessageObservations{
+		1: {
+			10: m1.rampMessage(t, pool),
+			11: m2.rampMessage(t, pool),
+			12: m3.rampMessage(t, pool),
+			13: m4.rampMessage(t, pool),
+			14: m5.rampMessage(t, pool),
+			15: unknownTx.rampMessage(t, pool),
+			16: noTxHash.rampMessage(t, pool),
+			17: noEvent.rampMessage(t, pool),
+			18: internal.MessageWithTokens(t, internal.RandBytes().String()),
+		},
+	}
+
+	t.Run("standard transfers", func(t *testing.T) {
+		tkData, err := newObserver(t, 0).Observe(tests.Context(t), messages)
+		require.NoError(t, err)
+
+		observations := tkData[1]
+		require.Equal(t, exectypes.NewSuccessTokenData(m1.attestation), observations[10].TokenData[0])
+		require.ErrorIs(t, observations[11].TokenData[0].Error, tokendata.ErrNotReady)
+		require.ErrorIs(t, observations[12].TokenData[0].Error, tokendata.ErrNotReady)
+		require.Equal(t, exectypes.NewSuccessTokenData(m4.attestation), observations[13].TokenData[0])
+		require.ErrorIs(t, observations[14].TokenData[0].Error, tokendata.ErrDataMissing)
+		require.ErrorIs(t, observations[15].TokenData[0].Error, tokendata.ErrNotReady)
+		require.ErrorIs(t, observations[16].TokenData[0].Error, tokendata.ErrDataMissing)
+		require.ErrorIs(t, observations[17].TokenData[0].Error, tokendata.ErrDataMissing)
+		require.Equal(t, exectypes.NotSupportedTokenData(), observations[18].TokenData[0])
+	})
+
+	t.Run("fast transfers", func(t *testing.T) {
+		tkData, err := newObserver(t, reader.CCTPFinalityThresholdFast).Observe(tests.Context(t), messages)
+		require.NoError(t, err)
+		require.Equal(t, exectypes.NewSuccessTokenData(m3.attestation), tkData[1][12].TokenData[0])
+	})
+}
+
+func mockHTTPServerResponseV2(t *testing.T, messages ...usdcMessageV2) *httptest.Server {
+	byTxHash := make(map[string][]string)
+	for _, m := range messages {
+		message, attestation := "0x", "PENDING"
+		if m.status == "complete" {
+			message, attestation = m.message.ToBytes().String(), m.attestation.String()
+		}
+		byTxHash[m.txHash] = append(byTxHash[m.txHash], fmt.Sprintf(
+			`{"message": "%s", "attestation": "%s", "eventNonce": "1", "cctpVersion": 2, "status": "%s"}`,
+			message, attestation, m.status,
+		))
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v2/messages/0", r.URL.Path)
+		msgs, ok := byTxHash[r.URL.Query().Get("transactionHash")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, err := w.Write([]byte(`{"messages": [` + strings.Join(msgs, ",") + `]}`))
+		require.NoError(t, err)
+	}))
+}
@@ -0,0 +1,122 @@
+package trace
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"os"
+	"sync"
+)
+
+// maxLineSize limits the size of a single round trace when reading the JSON-lines file.
+const maxLineSize = 64 * 1024 * 1024
+
+// FileExporter appends every round trace as a single JSON line to the file.
+type FileExporter struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileExporter opens (or creates) the JSON-lines trace file. Traces are appended to the existing content.
+func NewFileExporter(path string) (*FileExporter, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("open trace file %s: %w", path, err)
+	}
+	return &FileExporter{file: f}, nil
+}
+
+func (e *FileExporter) Export(_ context.Context, round RoundTrace) error {
+	line, err := json.Marshal(round)
+	if err != nil {
+		return fmt.Errorf("encode round trace: %w", err)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, err = e.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("write round trace: %w", err)
+	}
+	return nil
+}
+
+func (e *FileExporter) Close() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.file.Close()
+}
+
+// ReadFile iterates over the round traces stored by the FileExporter. Iteration stops at the first error.
+func ReadFile(path string) iter.Seq2[RoundTrace, error] {
+	return func(yield func(RoundTrace, error) bool) {
+		f, err := os.Open(path)
+		if err != nil {
+			yield(RoundTrace{}, fmt.Errorf("open trace file %s: %w", path, err))
+			return
+		}
+		defer f.Close()
+
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+		for scanner.Scan() {
+			var round RoundTrace
+			if err = json.Unmarshal(scanner.Bytes(), &round); err != nil {
+				yield(RoundTrace{}, fmt.Errorf("decode round trace: %w", err))
+				return
+			}
+			if !yield(round, nil) {
+				return
+			}
+		}
+		if err = scanner.Err(); err != nil {
+			yield(RoundTrace{}, fmt.Errorf("read trace file %s: %w", path, err))
+		}
+	}
+}
+
+// MemoryExporter keeps the traces of the last rounds in memory.
+type MemoryExporter struct {
+	mu        sync.RWMutex
+	rounds    []RoundTrace
+	maxRounds int
+}
+
+// NewMemoryExporter creates an exporter keeping at most maxRounds latest round traces.
+func NewMemoryExporter(maxRounds int) *MemoryExporter {
+	return &MemoryExporter{
+		rounds:    make([]RoundTrace, 0, maxRounds),
+		maxRounds: maxRounds,
+	}
+}
+
+func (e *MemoryExporter) Export(_ context.Context, round RoundTrace) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.maxRounds <= 0 {
+		return nil
+	}
+	if len(e.rounds) == e.maxRounds {
+		e.rounds = append(e.rounds[:0], e.rounds[1:]...)
+	}
+	e.rounds = append(e.rounds, round)
+	return nil
+}
+
+// Rounds iterates over the kept round traces from the oldest one. Rounds exported during the iteration
+// are not visited.
+func (e *MemoryExporter) Rounds() iter.Seq[RoundTrace] {
+	e.mu.RLock()
+	rounds := make([]RoundTrace, len(e.rounds))
+	copy(rounds, e.rounds)
+	e.mu.RUnlock()
+
+	return func(yield func(RoundTrace) bool) {
+		for _, round := range rounds {
+			if !yield(round) {
+				return
+			}
+		}
+	}
+}
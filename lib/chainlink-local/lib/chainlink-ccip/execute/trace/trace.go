@@ -0,0 +1,125 @@
+// Package trace records why messages were (or weren't) selected for execution in every Filter round of
+// the execute plugin. Traces are exported per round, e.g. to a JSON-lines file, and can be queried to answer
+// why a particular message hasn't been executed without reading the plugin logs.
+package trace
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// MessageTrace describes the fate of a single message in the round.
+type MessageTrace struct {
+	SourceChain cciptypes.ChainSelector `json:"sourceChain"`
+	SeqNum      cciptypes.SeqNum        `json:"seqNum"`
+	MessageID   cciptypes.Bytes32       `json:"messageId"`
+	// Status is set by the check which rejected the message (e.g. invalid_nonce, token_data_not_ready),
+	// it's ready_to_execute if the message passed all the checks.
+	Status string `json:"status"`
+	// TokenDataReady is true if all the token data of the message are ready.
+	TokenDataReady bool `json:"tokenDataReady"`
+	// TokenDataError is the token data error of the message, if known. Errors aren't part of the observations,
+	// so it's usually set only for the token data observed by the node itself.
+	TokenDataError string `json:"tokenDataError,omitempty"`
+	// Included is true if the message is part of the execution report. Message ready to execute may still be left
+	// out of the report when the report limits are reached.
+	Included bool `json:"included"`
+}
+
+// RoundTrace contains the traces of all the messages checked in a single OCR round.
+type RoundTrace struct {
+	SeqNr    uint64         `json:"seqNr"`
+	Time     time.Time      `json:"time"`
+	Messages []MessageTrace `json:"messages"`
+}
+
+// Exporter stores or forwards the round traces. Export must not block the plugin for long.
+type Exporter interface {
+	Export(ctx context.Context, round RoundTrace) error
+}
+
+type messageKey struct {
+	sourceChain cciptypes.ChainSelector
+	seqNum      cciptypes.SeqNum
+}
+
+// Round collects message traces while the execution report is being built. It's not safe for concurrent use.
+type Round struct {
+	seqNr    uint64
+	started  time.Time
+	messages []MessageTrace
+	index    map[messageKey]int
+}
+
+// NewRound starts tracing of the OCR round with the given sequence number.
+func NewRound(seqNr uint64) *Round {
+	return &Round{
+		seqNr:   seqNr,
+		started: time.Now().UTC(),
+		index:   make(map[messageKey]int),
+	}
+}
+
+// MessageChecked records the status of the message after all the checks. Repeated calls override the status.
+func (r *Round) MessageChecked(msg cciptypes.Message, status string, tokenData exectypes.MessageTokenData) {
+	mt := MessageTrace{
+		SourceChain:    msg.Header.SourceChainSelector,
+		SeqNum:         msg.Header.SequenceNumber,
+		MessageID:      msg.Header.MessageID,
+		Status:         status,
+		TokenDataReady: tokenData.IsReady(),
+	}
+	if err := tokenData.Error(); err != nil {
+		mt.TokenDataError = err.Error()
+	}
+
+	key := messageKey{sourceChain: mt.SourceChain, seqNum: mt.SeqNum}
+	if i, ok := r.index[key]; ok {
+		r.messages[i] = mt
+		return
+	}
+	r.index[key] = len(r.messages)
+	r.messages = append(r.messages, mt)
+}
+
+// MessageIncluded marks the message as a part of the execution report.
+func (r *Round) MessageIncluded(sourceChain cciptypes.ChainSelector, seqNum cciptypes.SeqNum) {
+	if i, ok := r.index[messageKey{sourceChain: sourceChain, seqNum: seqNum}]; ok {
+		r.messages[i].Included = true
+	}
+}
+
+// Trace returns the collected traces of the round.
+func (r *Round) Trace() RoundTrace {
+	messages := make([]MessageTrace, len(r.messages))
+	copy(messages, r.messages)
+	return RoundTrace{
+		SeqNr:    r.seqNr,
+		Time:     r.started,
+		Messages: messages,
+	}
+}
+
+// FindMessage yields the OCR sequence number and the trace of every round in which the message was checked.
+func FindMessage(
+	rounds iter.Seq[RoundTrace],
+	sourceChain cciptypes.ChainSelector,
+	seqNum cciptypes.SeqNum,
+) iter.Seq2[uint64, MessageTrace] {
+	return func(yield func(uint64, MessageTrace) bool) {
+		for round := range rounds {
+			for _, mt := range round.Messages {
+				if mt.SourceChain != sourceChain || mt.SeqNum != seqNum {
+					continue
+				}
+				if !yield(round.SeqNr, mt) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package trace
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+func testMessage(src cciptypes.ChainSelector, seqNum cciptypes.SeqNum) cciptypes.Message {
+	return cciptypes.Message{
+		Header: cciptypes.RampMessageHeader{
+			MessageID:           cciptypes.Bytes32{byte(src), byte(seqNum)},
+			SourceChainSelector: src,
+			SequenceNumber:      seqNum,
+		},
+	}
+}
+
+func testRound(seqNr uint64) RoundTrace {
+	round := NewRound(seqNr)
+	round.MessageChecked(testMessage(1, 10), "ready_to_execute",
+		exectypes.NewMessageTokenData(exectypes.NewSuccessTokenData([]byte{1})))
+	round.MessageChecked(testMessage(1, 11), "ready_to_execute", exectypes.NewMessageTokenData())
+	round.MessageChecked(testMessage(2, 10), "token_data_not_ready",
+		exectypes.NewMessageTokenData(exectypes.NewErrorTokenData(errors.New("attestation not ready"))))
+	round.MessageIncluded(1, 10)
+	return round.Trace()
+}
+
+func TestRound(t *testing.T) {
+	round := testRound(5)
+	require.Equal(t, uint64(5), round.SeqNr)
+	require.Equal(t, []MessageTrace{
+		{
+			SourceChain:    1,
+			SeqNum:         10,
+			MessageID:      cciptypes.Bytes32{1, 10},
+			Status:         "ready_to_execute",
+			TokenDataReady: true,
+			Included:       true,
+		},
+		{
+			SourceChain:    1,
+			SeqNum:         11,
+			MessageID:      cciptypes.Bytes32{1, 11},
+			Status:         "ready_to_execute",
+			TokenDataReady: true,
+		},
+		{
+			SourceChain:    2,
+			SeqNum:         10,
+			MessageID:      cciptypes.Bytes32{2, 10},
+			Status:         "token_data_not_ready",
+			TokenDataError: "attestation not ready",
+		},
+	}, round.Messages)
+}
+
+func TestFileExporter(t *testing.T) {
+	ctx := tests.Context(t)
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+
+	exporter, err := NewFileExporter(path)
+	require.NoError(t, err)
+	require.NoError(t, exporter.Export(ctx, testRound(1)))
+	require.NoError(t, exporter.Export(ctx, testRound(2)))
+	require.NoError(t, exporter.Close())
+
+	var seqNrs []uint64
+	for round, err := range ReadFile(path) {
+		require.NoError(t, err)
+		seqNrs = append(seqNrs, round.SeqNr)
+		require.Len(t, round.Messages, 3)
+	}
+	require.Equal(t, []uint64{1, 2}, seqNrs)
+
+	for _, err := range ReadFile(filepath.Join(t.TempDir(), "missing.jsonl")) {
+		require.Error(t, err)
+	}
+}
+
+func TestMemoryExporter(t *testing.T) {
+	ctx := tests.Context(t)
+	exporter := NewMemoryExporter(2)
+	for seqNr := uint64(1); seqNr <= 3; seqNr++ {
+		require.NoError(t, exporter.Export(ctx, testRound(seqNr)))
+	}
+
+	var seqNrs []uint64
+	for round := range exporter.Rounds() {
+		seqNrs = append(seqNrs, round.SeqNr)
+	}
+	require.Equal(t, []uint64{2, 3}, seqNrs)
+
+	var statuses []string
+	for seqNr, mt := range FindMessage(exporter.Rounds(), 2, 10) {
+		require.Contains(t, []uint64{2, 3}, seqNr)
+		statuses = append(statuses, mt.Status)
+	}
+	require.Equal(t, []string{"token_data_not_ready", "token_data_not_ready"}, statuses)
+	for range FindMessage(exporter.Rounds(), 3, 10) {
+		require.Fail(t, "unexpected message trace")
+	}
+}
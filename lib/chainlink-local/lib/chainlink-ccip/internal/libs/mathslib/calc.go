@@ -17,6 +17,18 @@ func Deviates(x1, x2 *big.Int, ppb int64) bool {
 	if x1.BitLen() == 0 || x2.BitLen() == 0 {
 		return x1.Cmp(x2) != 0
 	}
+	return DeviationPPB(x1, x2).Cmp(big.NewInt(ppb)) > 0 // diff > ppb
+}
+
+// DeviationPPB returns the deviation between x1 and x2 in ppb (parts per billion), calculated like in Deviates.
+// If exactly one of the numbers is 0 the deviation is unbounded and nil is returned.
+func DeviationPPB(x1, x2 *big.Int) *big.Int {
+	if x1.BitLen() == 0 || x2.BitLen() == 0 {
+		if x1.Cmp(x2) != 0 {
+			return nil
+		}
+		return big.NewInt(0)
+	}
 	// ensure x1 > x2
 	if x1.Cmp(x2) < 0 {
 		x1, x2 = x2, x1
@@ -24,8 +36,7 @@ func Deviates(x1, x2 *big.Int, ppb int64) bool {
 	diff := big.NewInt(0).Sub(x1, x2) // diff = x1-x2
 	diff.Mul(diff, big.NewInt(1e9))   // diff = diff * 1e9
 	// dividing by the smaller value gives consistent ppb regardless of input order, and supports >100% deviation.
-	diff.Div(diff, x2)
-	return diff.Cmp(big.NewInt(ppb)) > 0 // diff > ppb
+	return diff.Div(diff, x2)
 }
 
 // CalculateUsdPerUnitGas accepts source gas price denoted in chain-specific price units,
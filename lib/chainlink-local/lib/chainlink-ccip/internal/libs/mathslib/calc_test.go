@@ -111,6 +111,33 @@ func TestDeviates(t *testing.T) {
 	}
 }
 
+func TestDeviationPPB(t *testing.T) {
+	tests := []struct {
+		name string
+		x1   *big.Int
+		x2   *big.Int
+		want *big.Int
+	}{
+		{name: "both zero", x1: big.NewInt(0), x2: big.NewInt(0), want: big.NewInt(0)},
+		{name: "one zero", x1: big.NewInt(0), x2: big.NewInt(5), want: nil},
+		{name: "equal", x1: big.NewInt(5), x2: big.NewInt(5), want: big.NewInt(0)},
+		{name: "10 percent", x1: big.NewInt(110), x2: big.NewInt(100), want: big.NewInt(1e8)},
+		{name: "order does not matter", x1: big.NewInt(100), x2: big.NewInt(110), want: big.NewInt(1e8)},
+		{name: "more than 100 percent", x1: big.NewInt(1), x2: big.NewInt(3), want: big.NewInt(2e9)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DeviationPPB(tt.x1, tt.x2)
+			if tt.want == nil {
+				require.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.Equal(t, tt.want.String(), got.String())
+		})
+	}
+}
+
 func TestCalculateUsdPerUnitGas(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -0,0 +1,175 @@
+package consensus
+
+import (
+	"math/big"
+	"slices"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/libocr/commontypes"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// Weighted is an observed value weighted by the weight of its observer.
+type Weighted[T any] struct {
+	Value  T
+	Weight uint64
+}
+
+// OracleWeights maps the oracles to their voting weight, e.g. derived from their stake.
+// Oracles that are not listed have no weight. Without weights every oracle has a weight of 1.
+type OracleWeights map[commontypes.OracleID]uint64
+
+// Weight returns the weight of the oracle.
+func (w OracleWeights) Weight(oracleID commontypes.OracleID) uint64 {
+	if len(w) == 0 {
+		return 1
+	}
+	return w[oracleID]
+}
+
+// QuorumWeight returns the minimum weight of the observers for a value to reach consensus,
+// zero without weights since the thresholds on the number of observations apply.
+func (w OracleWeights) QuorumWeight() uint64 {
+	if len(w) == 0 {
+		return 0
+	}
+	var total uint64
+	for _, weight := range w {
+		total += weight
+	}
+	return TwoThirdsWeight(total)
+}
+
+// TwoThirdsWeight is the weighted counterpart of TwoFPlus1, it returns the smallest weight that is more
+// than two thirds of the total weight.
+func TwoThirdsWeight(total uint64) uint64 {
+	oneThirdCeil := total / 3
+	if total%3 != 0 {
+		oneThirdCeil++
+	}
+	return total - oneThirdCeil + 1
+}
+
+// TotalWeight returns the sum of the weights of the values.
+func TotalWeight[T any](vals []Weighted[T]) uint64 {
+	var total uint64
+	for _, val := range vals {
+		total += val.Weight
+	}
+	return total
+}
+
+// WeightedMedian returns the first value, in sorted order, at which the cumulative weight exceeds half of the
+// total weight. With equal weights it returns the same value as Median.
+// If the total weight is zero, it returns the zero value of the type.
+func WeightedMedian[T any](vals []Weighted[T], less func(T, T) bool) T {
+	total := TotalWeight(vals)
+	if total == 0 {
+		var zero T
+		return zero
+	}
+
+	sorted := slices.Clone(vals)
+	slices.SortStableFunc(sorted, func(a, b Weighted[T]) int {
+		switch {
+		case less(a.Value, b.Value):
+			return -1
+		case less(b.Value, a.Value):
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	var cumulative uint64
+	for _, val := range sorted {
+		cumulative += val.Weight
+		// cumulative > total/2 without the rounding of the division.
+		if cumulative > total-cumulative {
+			return val.Value
+		}
+	}
+	return sorted[len(sorted)-1].Value
+}
+
+// RejectOutliersMAD drops the values that are further from the weighted median than multiplier times the
+// median absolute deviation (MAD) of the values. At least half of the weight is always kept, so the outliers
+// can't move the median, a zero multiplier disables the rejection.
+func RejectOutliersMAD[T any](vals []Weighted[T], value func(T) *big.Int, multiplier uint64) []Weighted[T] {
+	if multiplier == 0 || len(vals) < 3 || TotalWeight(vals) == 0 {
+		return vals
+	}
+
+	lessBig := func(a, b *big.Int) bool { return a.Cmp(b) < 0 }
+	median := WeightedMedian(vals, func(a, b T) bool { return lessBig(value(a), value(b)) })
+
+	deviations := make([]Weighted[*big.Int], len(vals))
+	for i, val := range vals {
+		deviation := new(big.Int).Sub(value(val.Value), value(median))
+		deviations[i] = Weighted[*big.Int]{Value: deviation.Abs(deviation), Weight: val.Weight}
+	}
+	mad := WeightedMedian(deviations, lessBig)
+	maxDeviation := new(big.Int).Mul(mad, new(big.Int).SetUint64(multiplier))
+
+	kept := make([]Weighted[T], 0, len(vals))
+	for i, val := range vals {
+		if deviations[i].Value.Cmp(maxDeviation) <= 0 {
+			kept = append(kept, val)
+		}
+	}
+	return kept
+}
+
+// WeightedAggregator is a function type that aggregates a slice of weighted values into a single value.
+type WeightedAggregator[T any] func(vals []Weighted[T]) T
+
+// GetWeightedConsensusMapAggregator is the weighted counterpart of GetConsensusMapAggregator. A key reaches
+// consensus if it has at least the threshold number of values and the total weight of the values is at least
+// minWeight.
+func GetWeightedConsensusMapAggregator[K comparable, T any](
+	lggr logger.Logger,
+	objectName string,
+	items map[K][]Weighted[T],
+	f MultiThreshold[K],
+	minWeight uint64,
+	agg WeightedAggregator[T],
+) map[K]T {
+	consensus := make(map[K]T)
+
+	for key, values := range items {
+		if thresh, ok := f.Get(key); !ok || len(values) < int(thresh) {
+			lggr.Debugw("could not reach consensus in weightedConsensusMapAggregator",
+				"objectName", objectName,
+				"key", key)
+			continue
+		}
+		if weight := TotalWeight(values); weight < minWeight {
+			lggr.Debugw("could not reach weighted quorum in weightedConsensusMapAggregator",
+				"objectName", objectName,
+				"key", key,
+				"weight", weight,
+				"minWeight", minWeight)
+			continue
+		}
+		consensus[key] = agg(values)
+	}
+	return consensus
+}
+
+// BigIntWeightedAggregator returns the weighted median of the values that are not outliers, see RejectOutliersMAD.
+func BigIntWeightedAggregator(madMultiplier uint64) WeightedAggregator[cciptypes.BigInt] {
+	return func(vals []Weighted[cciptypes.BigInt]) cciptypes.BigInt {
+		vals = RejectOutliersMAD(vals, func(v cciptypes.BigInt) *big.Int { return v.Int }, madMultiplier)
+		return WeightedMedian(vals, BigIntComparator)
+	}
+}
+
+// TokenPriceWeightedAggregator returns the weighted median of the token prices that are not outliers,
+// see RejectOutliersMAD.
+func TokenPriceWeightedAggregator(madMultiplier uint64) WeightedAggregator[cciptypes.TokenPrice] {
+	return func(vals []Weighted[cciptypes.TokenPrice]) cciptypes.TokenPrice {
+		vals = RejectOutliersMAD(vals, func(v cciptypes.TokenPrice) *big.Int { return v.Price.Int }, madMultiplier)
+		return WeightedMedian(vals, TokenPriceComparator)
+	}
+}
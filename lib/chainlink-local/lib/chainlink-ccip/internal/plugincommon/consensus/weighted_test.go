@@ -0,0 +1,142 @@
+package consensus
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+func weightedInts(valsAndWeights ...int64) []Weighted[cciptypes.BigInt] {
+	var vals []Weighted[cciptypes.BigInt]
+	for i := 0; i < len(valsAndWeights); i += 2 {
+		vals = append(vals, Weighted[cciptypes.BigInt]{
+			Value:  cciptypes.NewBigIntFromInt64(valsAndWeights[i]),
+			Weight: uint64(valsAndWeights[i+1]),
+		})
+	}
+	return vals
+}
+
+func TestWeightedMedian(t *testing.T) {
+	testCases := []struct {
+		name string
+		vals []Weighted[cciptypes.BigInt]
+		exp  int64
+	}{
+		{name: "equal weights, odd", vals: weightedInts(3, 1, 1, 1, 2, 1), exp: 2},
+		// same as Median which returns the upper median.
+		{name: "equal weights, even", vals: weightedInts(4, 1, 1, 1, 3, 1, 2, 1), exp: 3},
+		{name: "heavy low value", vals: weightedInts(1, 5, 2, 1, 3, 1), exp: 1},
+		{name: "heavy high value", vals: weightedInts(1, 1, 2, 1, 3, 3), exp: 3},
+		{name: "zero weights are ignored", vals: weightedInts(1, 0, 2, 0, 3, 1), exp: 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, WeightedMedian(tc.vals, BigIntComparator).Int64())
+		})
+	}
+
+	t.Run("equal weights match Median", func(t *testing.T) {
+		vals := weightedInts(7, 1, 3, 1, 9, 1, 1, 1, 5, 1, 2, 1)
+		plain := make([]cciptypes.BigInt, len(vals))
+		for i, val := range vals {
+			plain[i] = val.Value
+		}
+		require.Equal(t, Median(plain, BigIntComparator), WeightedMedian(vals, BigIntComparator))
+	})
+
+	t.Run("zero total weight", func(t *testing.T) {
+		require.Nil(t, WeightedMedian(weightedInts(1, 0), BigIntComparator).Int)
+	})
+}
+
+func TestTwoThirdsWeight(t *testing.T) {
+	require.Equal(t, uint64(1), TwoThirdsWeight(1))
+	require.Equal(t, uint64(3), TwoThirdsWeight(3))
+	require.Equal(t, uint64(3), TwoThirdsWeight(4))
+	require.Equal(t, uint64(7), TwoThirdsWeight(10))
+	// 2/3 of the max uint64 does not overflow.
+	require.Equal(t, uint64(12297829382473034411), TwoThirdsWeight(^uint64(0)))
+}
+
+func TestOracleWeights(t *testing.T) {
+	var noWeights OracleWeights
+	require.Equal(t, uint64(1), noWeights.Weight(3))
+	require.Zero(t, noWeights.QuorumWeight())
+
+	weights := OracleWeights{0: 10, 1: 20, 2: 30}
+	require.Equal(t, uint64(20), weights.Weight(1))
+	require.Zero(t, weights.Weight(3))
+	require.Equal(t, uint64(41), weights.QuorumWeight())
+}
+
+func TestRejectOutliersMAD(t *testing.T) {
+	value := func(v cciptypes.BigInt) *big.Int { return v.Int }
+
+	t.Run("extreme value is rejected", func(t *testing.T) {
+		vals := weightedInts(100, 1, 101, 1, 99, 1, 102, 1, 1_000_000, 1)
+		kept := RejectOutliersMAD(vals, value, 3)
+		require.Equal(t, vals[:4], kept)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		vals := weightedInts(100, 1, 101, 1, 1_000_000, 1)
+		require.Equal(t, vals, RejectOutliersMAD(vals, value, 0))
+	})
+
+	t.Run("too few values", func(t *testing.T) {
+		vals := weightedInts(100, 1, 1_000_000, 1)
+		require.Equal(t, vals, RejectOutliersMAD(vals, value, 3))
+	})
+
+	t.Run("identical values reject any deviation", func(t *testing.T) {
+		vals := weightedInts(100, 1, 100, 1, 100, 1, 101, 1)
+		require.Equal(t, vals[:3], RejectOutliersMAD(vals, value, 3))
+	})
+
+	t.Run("misbehaving oracle does not shift the median", func(t *testing.T) {
+		vals := weightedInts(100, 1, 101, 1, 102, 1, 1_000_000, 1)
+		require.Equal(t, int64(102), WeightedMedian(vals, BigIntComparator).Int64())
+		require.Equal(t, int64(101), BigIntWeightedAggregator(3)(vals).Int64())
+	})
+}
+
+func TestGetWeightedConsensusMapAggregator(t *testing.T) {
+	lggr := logger.Test(t)
+	items := map[cciptypes.ChainSelector][]Weighted[cciptypes.BigInt]{
+		1: weightedInts(10, 5, 20, 5, 30, 5),
+		// enough observations but not enough weight.
+		2: weightedInts(10, 1, 20, 1, 30, 1),
+		// enough weight but not enough observations.
+		3: weightedInts(10, 20),
+	}
+
+	result := GetWeightedConsensusMapAggregator(
+		lggr,
+		"test",
+		items,
+		MakeConstantThreshold[cciptypes.ChainSelector](3),
+		10,
+		BigIntWeightedAggregator(0),
+	)
+	require.Len(t, result, 1)
+	require.Equal(t, int64(20), result[1].Int64())
+}
+
+func TestTokenPriceWeightedAggregator(t *testing.T) {
+	vals := []Weighted[cciptypes.TokenPrice]{
+		{Value: cciptypes.NewTokenPrice("a", big.NewInt(10)), Weight: 1},
+		{Value: cciptypes.NewTokenPrice("a", big.NewInt(11)), Weight: 1},
+		{Value: cciptypes.NewTokenPrice("a", big.NewInt(12)), Weight: 1},
+		{Value: cciptypes.NewTokenPrice("a", big.NewInt(1_000)), Weight: 1},
+		{Value: cciptypes.NewTokenPrice("a", big.NewInt(2_000)), Weight: 1},
+	}
+	require.Equal(t, int64(12), TokenPriceWeightedAggregator(0)(vals).Price.Int64())
+	require.Equal(t, int64(11), TokenPriceWeightedAggregator(2)(vals).Price.Int64())
+}
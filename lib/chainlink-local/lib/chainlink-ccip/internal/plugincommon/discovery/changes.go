@@ -0,0 +1,76 @@
+package discovery
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// Kinds of the changes of the agreed contract addresses.
+const (
+	AddressAdded    = "added"
+	AddressUpdated  = "updated"
+	AddressRejected = "rejected"
+)
+
+// AddressChange is a change of the agreed address of a contract on a chain.
+type AddressChange struct {
+	Contract string
+	Chain    cciptypes.ChainSelector
+	// Previous is the last agreed address, empty if the address was never agreed on.
+	Previous cciptypes.UnknownAddress
+	// Current is the newly agreed address.
+	Current cciptypes.UnknownAddress
+}
+
+// Kind returns AddressAdded if the address was never agreed on and AddressUpdated otherwise.
+func (c AddressChange) Kind() string {
+	if len(c.Previous) == 0 {
+		return AddressAdded
+	}
+	return AddressUpdated
+}
+
+// DiffContractAddresses returns the addresses of current that differ from the ones of previous, sorted by contract
+// and chain. Addresses that are missing from current are not considered removed since the lack of consensus in a
+// round does not mean that the contract changed.
+func DiffContractAddresses(previous, current reader.ContractAddresses) []AddressChange {
+	var changes []AddressChange
+	for contract, addrs := range current {
+		for chain, addr := range addrs {
+			prevAddr := previous[contract][chain]
+			if bytes.Equal(prevAddr, addr) {
+				continue
+			}
+			changes = append(changes, AddressChange{
+				Contract: contract,
+				Chain:    chain,
+				Previous: prevAddr,
+				Current:  addr,
+			})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Contract != changes[j].Contract {
+			return changes[i].Contract < changes[j].Contract
+		}
+		return changes[i].Chain < changes[j].Chain
+	})
+	return changes
+}
+
+// mergeContractAddresses returns a copy of previous where the addresses of current take precedence.
+func mergeContractAddresses(previous, current reader.ContractAddresses) reader.ContractAddresses {
+	merged := make(reader.ContractAddresses, len(previous))
+	for _, contracts := range []reader.ContractAddresses{previous, current} {
+		for contract, addrs := range contracts {
+			for chain, addr := range addrs {
+				merged = merged.Append(contract, chain, addr)
+			}
+		}
+	}
+	return merged
+}
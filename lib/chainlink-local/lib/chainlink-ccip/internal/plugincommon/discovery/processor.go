@@ -3,6 +3,7 @@ package discovery
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/smartcontractkit/libocr/commontypes"
 	ragep2ptypes "github.com/smartcontractkit/libocr/ragep2p/types"
@@ -17,11 +18,24 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
 )
 
 // Ensure that PluginProcessor is implemented.
 var _ plugincommon.PluginProcessor[dt.Query, dt.Observation, dt.Outcome] = &ContractDiscoveryProcessor{}
 
+// MetricsReporter tracks the outputs of the processor and the changes of the agreed contract addresses.
+type MetricsReporter interface {
+	plugincommon.MetricsReporter
+	TrackContractAddressChange(contract string, chain cciptypes.ChainSelector, kind string)
+}
+
+type NoopMetrics struct {
+	plugincommon.NoopReporter
+}
+
+func (n NoopMetrics) TrackContractAddressChange(string, cciptypes.ChainSelector, string) {}
+
 // ContractDiscoveryProcessor is a plugin processor for discovering contracts.
 type ContractDiscoveryProcessor struct {
 	lggr            logger.Logger
@@ -30,6 +44,21 @@ type ContractDiscoveryProcessor struct {
 	dest            cciptypes.ChainSelector
 	fRoleDON        int
 	oracleIDToP2PID map[commontypes.OracleID]ragep2ptypes.PeerID
+	reporter        MetricsReporter
+	allowlist       pluginconfig.ContractAddressAllowlist
+
+	// lastAgreed holds the last agreed address of every contract and chain, it's used to detect the changes.
+	lastAgreedMu sync.Mutex
+	lastAgreed   reader.ContractAddresses
+}
+
+type Option func(cdp *ContractDiscoveryProcessor)
+
+// WithAddressAllowlist refuses to adopt the agreed addresses that are not allowed by the allowlist.
+func WithAddressAllowlist(allowlist pluginconfig.ContractAddressAllowlist) Option {
+	return func(cdp *ContractDiscoveryProcessor) {
+		cdp.allowlist = allowlist
+	}
 }
 
 func NewContractDiscoveryProcessor(
@@ -39,7 +68,8 @@ func NewContractDiscoveryProcessor(
 	dest cciptypes.ChainSelector,
 	fRoleDON int,
 	oracleIDToP2PID map[commontypes.OracleID]ragep2ptypes.PeerID,
-	reporter plugincommon.MetricsReporter,
+	reporter MetricsReporter,
+	opts ...Option,
 ) plugincommon.PluginProcessor[dt.Query, dt.Observation, dt.Outcome] {
 	p := &ContractDiscoveryProcessor{
 		lggr:            lggr,
@@ -48,6 +78,10 @@ func NewContractDiscoveryProcessor(
 		dest:            dest,
 		fRoleDON:        fRoleDON,
 		oracleIDToP2PID: oracleIDToP2PID,
+		reporter:        reporter,
+	}
+	for _, opt := range opts {
+		opt(p)
 	}
 	return plugincommon.NewTrackedProcessor(lggr, p, "discovery", reporter)
 }
@@ -337,6 +371,8 @@ func (cdp *ContractDiscoveryProcessor) Outcome(
 	}
 	contracts[consts.ContractNameRouter] = routerConsensus
 
+	contracts = cdp.adoptContracts(lggr, contracts)
+
 	// call Sync to bind contracts.
 	// NOTE: since Sync may make network calls, it could potentially fail and we don't want to
 	// fail the entire outcome because of that. The reason being is that if this node is a leader
@@ -352,6 +388,46 @@ func (cdp *ContractDiscoveryProcessor) Outcome(
 	return dt.Outcome{}, nil
 }
 
+// adoptContracts compares the agreed contract addresses with the last agreed ones. Every change is reported and
+// the addresses that are not allowed by the allowlist are replaced by the last agreed ones, or dropped if there
+// are none. It returns the addresses to sync.
+func (cdp *ContractDiscoveryProcessor) adoptContracts(
+	lggr logger.Logger, contracts reader.ContractAddresses,
+) reader.ContractAddresses {
+	cdp.lastAgreedMu.Lock()
+	defer cdp.lastAgreedMu.Unlock()
+
+	for _, change := range DiffContractAddresses(cdp.lastAgreed, contracts) {
+		lggr := logger.With(lggr,
+			"contract", change.Contract,
+			"chain", change.Chain,
+			"previousAddress", change.Previous,
+			"currentAddress", change.Current,
+		)
+
+		if !cdp.allowlist.Allows(change.Contract, change.Chain, change.Current) {
+			lggr.Errorw("Refusing to adopt contract address that is not in the allowlist")
+			cdp.reporter.TrackContractAddressChange(change.Contract, change.Chain, AddressRejected)
+			if change.Kind() == AddressAdded {
+				delete(contracts[change.Contract], change.Chain)
+			} else {
+				contracts[change.Contract][change.Chain] = change.Previous
+			}
+			continue
+		}
+
+		if change.Kind() == AddressUpdated {
+			lggr.Warnw("Agreed contract address changed")
+		} else {
+			lggr.Infow("New contract address agreed")
+		}
+		cdp.reporter.TrackContractAddressChange(change.Contract, change.Chain, change.Kind())
+	}
+
+	cdp.lastAgreed = mergeContractAddresses(cdp.lastAgreed, contracts)
+	return contracts
+}
+
 func (cdp *ContractDiscoveryProcessor) Close() error {
 	return nil
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
 )
 
 func TestContractDiscoveryProcessor_Observation_SupportsDest_HappyPath(t *testing.T) {
@@ -541,6 +542,130 @@ func TestContractDiscoveryProcessor_Outcome_ErrorSyncingContracts(t *testing.T)
 	require.Equal(t, outcome, discoverytypes.Outcome{})
 }
 
+type addressChangeRecorder struct {
+	NoopMetrics
+	changes []string
+}
+
+func (r *addressChangeRecorder) TrackContractAddressChange(
+	contract string, chain cciptypes.ChainSelector, kind string,
+) {
+	r.changes = append(r.changes, fmt.Sprintf("%s/%d/%s", contract, chain, kind))
+}
+
+func TestContractDiscoveryProcessor_Outcome_AddressChanges(t *testing.T) {
+	mockReader := mock_reader.NewMockCCIPReader(t)
+	mockReaderIface := reader.CCIPReader(mockReader)
+	mockHomeChain := mock_home_chain.NewMockHomeChain(t)
+	lggr := logger.Test(t)
+	dest := cciptypes.ChainSelector(1)
+	source := cciptypes.ChainSelector(2)
+	recorder := &addressChangeRecorder{}
+
+	cdp := NewContractDiscoveryProcessor(
+		lggr,
+		&mockReaderIface,
+		mockHomeChain,
+		dest,
+		1,
+		nil, // oracleIDToP2PID, not needed for this test
+		recorder,
+		WithAddressAllowlist(pluginconfig.ContractAddressAllowlist{
+			consts.ContractNameOnRamp: {
+				source: {cciptypes.UnknownAddress("onRamp1"), cciptypes.UnknownAddress("onRamp2")},
+			},
+		}),
+	)
+
+	contractsWithOnRamp := func(onRamp string) reader.ContractAddresses {
+		return reader.ContractAddresses{
+			consts.ContractNameOnRamp: {source: cciptypes.UnknownAddress(onRamp)},
+			consts.ContractNameNonceManager: {
+				dest: cciptypes.UnknownAddress("nonceManager"),
+			},
+			consts.ContractNameRMNRemote: {},
+			consts.ContractNameFeeQuoter: {},
+			consts.ContractNameRouter:    {},
+		}
+	}
+	runOutcome := func(onRamp string) {
+		obs := discoverytypes.Observation{
+			FChain: map[cciptypes.ChainSelector]int{dest: 1, source: 1},
+			Addresses: map[string]map[cciptypes.ChainSelector]cciptypes.UnknownAddress{
+				consts.ContractNameOnRamp:       {source: cciptypes.UnknownAddress(onRamp)},
+				consts.ContractNameNonceManager: {dest: cciptypes.UnknownAddress("nonceManager")},
+			},
+		}
+		aos := []plugincommon.AttributedObservation[discoverytypes.Observation]{
+			{Observation: obs},
+			{Observation: obs},
+			{Observation: obs},
+		}
+		_, err := cdp.Outcome(tests.Context(t), discoverytypes.Outcome{}, discoverytypes.Query{}, aos)
+		require.NoError(t, err)
+	}
+
+	// the first agreed addresses are added.
+	mockReader.EXPECT().Sync(mock.Anything, contractsWithOnRamp("onRamp1")).Return(nil).Once()
+	runOutcome("onRamp1")
+	require.Equal(t, []string{"NonceManager/1/added", "OnRamp/2/added"}, recorder.changes)
+
+	// unchanged addresses are not reported.
+	recorder.changes = nil
+	mockReader.EXPECT().Sync(mock.Anything, contractsWithOnRamp("onRamp1")).Return(nil).Once()
+	runOutcome("onRamp1")
+	require.Empty(t, recorder.changes)
+
+	// the onramp is swapped for an allowed address.
+	mockReader.EXPECT().Sync(mock.Anything, contractsWithOnRamp("onRamp2")).Return(nil).Once()
+	runOutcome("onRamp2")
+	require.Equal(t, []string{"OnRamp/2/updated"}, recorder.changes)
+
+	// the onramp is swapped for an address that is not allowed, the last agreed address is kept.
+	recorder.changes = nil
+	mockReader.EXPECT().Sync(mock.Anything, contractsWithOnRamp("onRamp2")).Return(nil).Once()
+	runOutcome("unknownOnRamp")
+	require.Equal(t, []string{"OnRamp/2/rejected"}, recorder.changes)
+}
+
+func TestDiffContractAddresses(t *testing.T) {
+	previous := reader.ContractAddresses{
+		consts.ContractNameOnRamp: {
+			2: cciptypes.UnknownAddress("onRamp2"),
+			3: cciptypes.UnknownAddress("onRamp3"),
+		},
+		consts.ContractNameRouter: {
+			1: cciptypes.UnknownAddress("router"),
+		},
+	}
+	current := reader.ContractAddresses{
+		consts.ContractNameOnRamp: {
+			2: cciptypes.UnknownAddress("onRamp2"),
+			3: cciptypes.UnknownAddress("newOnRamp3"),
+			4: cciptypes.UnknownAddress("onRamp4"),
+		},
+		// the router missing from current is not a change.
+	}
+
+	changes := DiffContractAddresses(previous, current)
+	require.Equal(t, []AddressChange{
+		{
+			Contract: consts.ContractNameOnRamp,
+			Chain:    3,
+			Previous: cciptypes.UnknownAddress("onRamp3"),
+			Current:  cciptypes.UnknownAddress("newOnRamp3"),
+		},
+		{
+			Contract: consts.ContractNameOnRamp,
+			Chain:    4,
+			Current:  cciptypes.UnknownAddress("onRamp4"),
+		},
+	}, changes)
+	require.Equal(t, AddressUpdated, changes[0].Kind())
+	require.Equal(t, AddressAdded, changes[1].Kind())
+	require.Empty(t, DiffContractAddresses(previous, previous))
+}
+
 func TestContractDiscoveryProcessor_ValidateObservation_HappyPath(t *testing.T) {
 	mockHomeChain := mock_home_chain.NewMockHomeChain(t)
 	lggr := logger.Test(t)
@@ -793,6 +918,6 @@ func internalNewContractDiscoveryProcessor(
 		dest,
 		fRoleDON,
 		oracleIDToP2PID,
-		plugincommon.NoopReporter{},
+		NoopMetrics{},
 	)
 }
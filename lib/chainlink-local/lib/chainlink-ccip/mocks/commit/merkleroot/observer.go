@@ -263,6 +263,54 @@ func (_c *MockObserver_ObserveOffRampNextSeqNums_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// ObservePausedLanes provides a mock function with given fields: ctx
+func (_m *MockObserver) ObservePausedLanes(ctx context.Context) []plugintypes.SeqNumChain {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ObservePausedLanes")
+	}
+
+	var r0 []plugintypes.SeqNumChain
+	if rf, ok := ret.Get(0).(func(context.Context) []plugintypes.SeqNumChain); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]plugintypes.SeqNumChain)
+		}
+	}
+
+	return r0
+}
+
+// MockObserver_ObservePausedLanes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ObservePausedLanes'
+type MockObserver_ObservePausedLanes_Call struct {
+	*mock.Call
+}
+
+// ObservePausedLanes is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockObserver_Expecter) ObservePausedLanes(ctx interface{}) *MockObserver_ObservePausedLanes_Call {
+	return &MockObserver_ObservePausedLanes_Call{Call: _e.mock.On("ObservePausedLanes", ctx)}
+}
+
+func (_c *MockObserver_ObservePausedLanes_Call) Run(run func(ctx context.Context)) *MockObserver_ObservePausedLanes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockObserver_ObservePausedLanes_Call) Return(_a0 []plugintypes.SeqNumChain) *MockObserver_ObservePausedLanes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockObserver_ObservePausedLanes_Call) RunAndReturn(run func(context.Context) []plugintypes.SeqNumChain) *MockObserver_ObservePausedLanes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ObserveRMNRemoteCfg provides a mock function with given fields: ctx
 func (_m *MockObserver) ObserveRMNRemoteCfg(ctx context.Context) ccipocr3.RemoteConfig {
 	ret := _m.Called(ctx)
@@ -16,6 +16,8 @@ const (
 	ContractNameRMNProxy               = "RMNProxy"
 	ContractNameRouter                 = "Router"
 	ContractNameCCTPMessageTransmitter = "MessageTransmitter"
+	ContractNameRebaseToken            = "RebaseToken"
+	ContractNameExchangeRateProvider   = "ExchangeRateProvider"
 )
 
 // Method Names
@@ -108,14 +110,27 @@ const (
 
 	// RMNProxy.sol methods
 	MethodNameGetARM = "GetARM"
+
+	// RebaseToken methods
+	// Used by the rebase token data observer.
+	MethodNameGetInterestRate = "GetInterestRate"
+
+	// ExchangeRateProvider methods
+	// Used by the price reader to derive prices of wrapped tokens.
+	MethodNameGetExchangeRate = "GetExchangeRate"
 )
 
 // Event Names
 const (
-	EventNameCCIPMessageSent       = "CCIPMessageSent"
-	EventNameExecutionStateChanged = "ExecutionStateChanged"
-	EventNameCommitReportAccepted  = "CommitReportAccepted"
-	EventNameCCTPMessageSent       = "MessageSent"
+	EventNameCCIPMessageSent        = "CCIPMessageSent"
+	EventNameExecutionStateChanged  = "ExecutionStateChanged"
+	EventNameCommitReportAccepted   = "CommitReportAccepted"
+	EventNameCCTPMessageSent        = "MessageSent"
+	EventNameConfigSet              = "ConfigSet"
+	EventNameDynamicConfigSet       = "DynamicConfigSet"
+	EventNameConfigPromoted         = "ConfigPromoted"
+	EventNameActiveConfigRevoked    = "ActiveConfigRevoked"
+	EventNameCandidateConfigRevoked = "CandidateConfigRevoked"
 )
 
 // Event Attributes
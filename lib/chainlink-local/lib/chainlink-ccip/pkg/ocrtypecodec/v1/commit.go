@@ -93,6 +93,8 @@ func (c *CommitCodecProto) EncodeObservation(observation committypes.Observation
 			OffRampNextSeqNums: c.tr.seqNumChainToProto(observation.MerkleRootObs.OffRampNextSeqNums),
 			RmnRemoteConfig:    c.tr.rmnRemoteConfigToProto(observation.MerkleRootObs.RMNRemoteConfig),
 			FChain:             c.tr.fChainToProto(observation.MerkleRootObs.FChain),
+			PausedLanes:        c.tr.seqNumChainToProto(observation.MerkleRootObs.PausedLanes),
+			Timestamp:          c.tr.optionalTimestampToProto(observation.MerkleRootObs.Timestamp),
 		},
 		TokenPriceObs: &ocrtypecodecpb.TokenPriceObservation{
 			FeedTokenPrices:       c.tr.feedTokenPricesToProto(observation.TokenPriceObs.FeedTokenPrices),
@@ -136,6 +138,8 @@ func (c *CommitCodecProto) DecodeObservation(data []byte) (committypes.Observati
 			OffRampNextSeqNums: c.tr.seqNumChainFromProto(pbObs.MerkleRootObs.OffRampNextSeqNums),
 			RMNRemoteConfig:    c.tr.rmnRemoteConfigFromProto(pbObs.MerkleRootObs.RmnRemoteConfig),
 			FChain:             c.tr.fChainFromProto(pbObs.MerkleRootObs.FChain),
+			PausedLanes:        c.tr.seqNumChainFromProto(pbObs.MerkleRootObs.PausedLanes),
+			Timestamp:          c.tr.optionalTimestampFromProto(pbObs.MerkleRootObs.Timestamp),
 		},
 		TokenPriceObs: tokenprice.Observation{
 			FeedTokenPrices:       c.tr.feedTokenPricesFromProto(pbObs.TokenPriceObs.FeedTokenPrices),
@@ -169,6 +173,8 @@ func (c *CommitCodecProto) EncodeOutcome(outcome committypes.Outcome) ([]byte, e
 			ReportTransmissionCheckAttempts: uint32(outcome.MerkleRootOutcome.ReportTransmissionCheckAttempts),
 			RmnReportSignatures:             c.tr.ccipRmnSignaturesToProto(outcome.MerkleRootOutcome.RMNReportSignatures),
 			RmnRemoteCfg:                    c.tr.rmnRemoteConfigToProto(outcome.MerkleRootOutcome.RMNRemoteCfg),
+			PausedLanes:                     c.tr.pausedLanesToProto(outcome.MerkleRootOutcome.PausedLanes),
+			PendingBatches:                  c.tr.pendingBatchesToProto(outcome.MerkleRootOutcome.PendingBatches),
 		},
 		TokenPriceOutcome: &ocrtypecodecpb.TokenPriceOutcome{
 			TokenPrices: c.tr.feedTokenPricesToProto(outcome.TokenPriceOutcome.TokenPrices),
@@ -205,6 +211,8 @@ func (c *CommitCodecProto) DecodeOutcome(data []byte) (committypes.Outcome, erro
 			ReportTransmissionCheckAttempts: uint(pbOutcome.MerkleRootOutcome.ReportTransmissionCheckAttempts),
 			RMNReportSignatures:             c.tr.ccipRmnSignaturesFromProto(pbOutcome.MerkleRootOutcome.RmnReportSignatures),
 			RMNRemoteCfg:                    c.tr.rmnRemoteConfigFromProto(pbOutcome.MerkleRootOutcome.RmnRemoteCfg),
+			PausedLanes:                     c.tr.pausedLanesFromProto(pbOutcome.MerkleRootOutcome.PausedLanes),
+			PendingBatches:                  c.tr.pendingBatchesFromProto(pbOutcome.MerkleRootOutcome.PendingBatches),
 		},
 		TokenPriceOutcome: tokenprice.Outcome{
 			TokenPrices: c.tr.feedTokenPricesFromProto(pbOutcome.TokenPriceOutcome.TokenPrices),
@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"bytes"
+	"compress/flate"
+	"fmt"
+	"io"
+)
+
+// maxDecompressedLen guards against decompression bombs, it is well above the OCR observation limits.
+const maxDecompressedLen = 16 << 20
+
+// compressionDictionary primes the compressor with byte sequences that are common in the encoded observations.
+// It is part of the wire format, changing it breaks the decoding of the payloads compressed by other oracles.
+var compressionDictionary = func() []byte {
+	dict := make([]byte, 0, 96)
+	// padding of the abi encoded values and of the addresses shorter than 32 bytes.
+	dict = append(dict, make([]byte, 32)...)
+	dict = append(dict, bytes.Repeat([]byte{0xff}, 32)...)
+	// extra args tags: EVMExtraArgsV1, GenericExtraArgsV2 and SVMExtraArgsV1.
+	dict = append(dict, 0x97, 0xa6, 0x57, 0xc9, 0x18, 0x1d, 0xcf, 0x10, 0x1f, 0x3b, 0x3a, 0xba)
+	return dict
+}()
+
+func compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := flate.NewWriterDict(&buf, flate.DefaultCompression, compressionDictionary)
+	if err != nil {
+		return nil, fmt.Errorf("create flate writer: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return nil, fmt.Errorf("compress: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("close flate writer: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func decompress(data []byte) ([]byte, error) {
+	r := flate.NewReaderDict(bytes.NewReader(data), compressionDictionary)
+	defer r.Close()
+
+	decompressed, err := io.ReadAll(io.LimitReader(r, maxDecompressedLen+1))
+	if err != nil {
+		return nil, fmt.Errorf("decompress: %w", err)
+	}
+	if len(decompressed) > maxDecompressedLen {
+		return nil, fmt.Errorf("decompressed data exceeds %d bytes", maxDecompressedLen)
+	}
+	return decompressed, nil
+}
@@ -0,0 +1,105 @@
+package v1
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/ocrtypecodec/v1/ocrtypecodecpb"
+)
+
+type addressField struct {
+	addr *[]byte
+	// ref is the 1-based index of the address in the addresses of the observation, zero if the address is inline.
+	ref *uint32
+}
+
+// messageAddresses returns pointers to the address fields of the message that are deduplicated,
+// each one paired with its reference field.
+func messageAddresses(msg *ocrtypecodecpb.Message) []addressField {
+	fields := []addressField{
+		{addr: &msg.Sender, ref: &msg.SenderRef},
+		{addr: &msg.Receiver, ref: &msg.ReceiverRef},
+		{addr: &msg.FeeToken, ref: &msg.FeeTokenRef},
+	}
+	if msg.Header != nil {
+		fields = append(fields, addressField{addr: &msg.Header.OnRamp, ref: &msg.Header.OnRampRef})
+	}
+	for _, tokenAmount := range msg.TokenAmounts {
+		fields = append(fields,
+			addressField{addr: &tokenAmount.SourcePoolAddress, ref: &tokenAmount.SourcePoolAddressRef},
+			addressField{addr: &tokenAmount.DestTokenAddress, ref: &tokenAmount.DestTokenAddressRef},
+		)
+	}
+	return fields
+}
+
+// observationMessages returns the messages of the observation in a deterministic order.
+func observationMessages(pbObs *ocrtypecodecpb.ExecObservation) []*ocrtypecodecpb.Message {
+	var msgs []*ocrtypecodecpb.Message
+	for _, chainSel := range slices.Sorted(maps.Keys(pbObs.CommitReports)) {
+		for _, commitData := range pbObs.CommitReports[chainSel].GetCommitData() {
+			msgs = append(msgs, commitData.Messages...)
+		}
+	}
+	for _, chainSel := range slices.Sorted(maps.Keys(pbObs.SeqNumsToMsgs)) {
+		seqNumToMsg := pbObs.SeqNumsToMsgs[chainSel].GetMessages()
+		for _, seqNum := range slices.Sorted(maps.Keys(seqNumToMsg)) {
+			msgs = append(msgs, seqNumToMsg[seqNum])
+		}
+	}
+	return msgs
+}
+
+// deduplicateAddresses moves every address that appears more than once in the messages of the observation
+// to the addresses of the observation and replaces it with a reference.
+func deduplicateAddresses(pbObs *ocrtypecodecpb.ExecObservation) {
+	var fields []addressField
+	for _, msg := range observationMessages(pbObs) {
+		fields = append(fields, messageAddresses(msg)...)
+	}
+
+	counts := make(map[string]int)
+	for _, field := range fields {
+		if len(*field.addr) > 0 {
+			counts[string(*field.addr)]++
+		}
+	}
+
+	refs := make(map[string]uint32)
+	for _, field := range fields {
+		key := string(*field.addr)
+		if counts[key] < 2 {
+			continue
+		}
+		ref, exists := refs[key]
+		if !exists {
+			pbObs.Addresses = append(pbObs.Addresses, *field.addr)
+			ref = uint32(len(pbObs.Addresses))
+			refs[key] = ref
+		}
+		*field.addr = nil
+		*field.ref = ref
+	}
+}
+
+// resolveAddresses replaces the address references of the messages with the referenced addresses.
+// Observations without deduplicated addresses are left as is.
+func resolveAddresses(pbObs *ocrtypecodecpb.ExecObservation) error {
+	for _, msg := range observationMessages(pbObs) {
+		for _, field := range messageAddresses(msg) {
+			if *field.ref == 0 {
+				continue
+			}
+			if int(*field.ref) > len(pbObs.Addresses) {
+				return fmt.Errorf("address reference %d out of range, %d addresses", *field.ref, len(pbObs.Addresses))
+			}
+			// copy so that the decoded messages do not share their addresses.
+			*field.addr = bytes.Clone(pbObs.Addresses[*field.ref-1])
+			*field.ref = 0
+		}
+	}
+	pbObs.Addresses = nil
+	return nil
+}
@@ -25,12 +25,39 @@ type ExecCodec interface {
 
 type ExecCodecProto struct {
 	tr *protoTranslator
+	// deduplicateAddresses stores the repeated message addresses of the observations once.
+	deduplicateAddresses bool
+	// compressObservations compresses the encoded observations with DEFLATE and a shared dictionary.
+	compressObservations bool
 }
 
-func NewExecCodecProto() *ExecCodecProto {
-	return &ExecCodecProto{
+type ExecCodecProtoOption func(*ExecCodecProto)
+
+// WithAddressDeduplication stores every message address that appears more than once in an observation once, the
+// messages refer to it by index. Decoders always resolve the references, but decoders that predate the option
+// silently drop the addresses, so it must only be enabled through a versioned wire format (see ocrtypecodec/v2).
+func WithAddressDeduplication() ExecCodecProtoOption {
+	return func(e *ExecCodecProto) {
+		e.deduplicateAddresses = true
+	}
+}
+
+// WithObservationCompression compresses the encoded observations. The codec only decodes compressed observations
+// when the option is set.
+func WithObservationCompression() ExecCodecProtoOption {
+	return func(e *ExecCodecProto) {
+		e.compressObservations = true
+	}
+}
+
+func NewExecCodecProto(opts ...ExecCodecProtoOption) *ExecCodecProto {
+	e := &ExecCodecProto{
 		tr: newProtoTranslator(),
 	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
 }
 
 func (e *ExecCodecProto) EncodeObservation(observation exectypes.Observation) ([]byte, error) {
@@ -41,7 +68,8 @@ func (e *ExecCodecProto) EncodeObservation(observation exectypes.Observation) ([
 		TokenDataObservations: &ocrtypecodecpb.TokenDataObservations{
 			TokenData: e.tr.tokenDataObservationsToProto(observation.TokenData),
 		},
-		Nonces: e.tr.nonceObservationsToProto(observation.Nonces),
+		Nonces:           e.tr.nonceObservationsToProto(observation.Nonces),
+		SimulatedReverts: e.tr.simulatedRevertsToProto(observation.SimulatedReverts),
 		Contracts: &ocrtypecodecpb.DiscoveryObservation{
 			FChain: e.tr.fChainToProto(observation.Contracts.FChain),
 			ContractNames: &ocrtypecodecpb.ContractNameChainAddresses{
@@ -51,7 +79,18 @@ func (e *ExecCodecProto) EncodeObservation(observation exectypes.Observation) ([
 		FChain: e.tr.fChainToProto(observation.FChain),
 	}
 
-	return proto.Marshal(pbObs)
+	if e.deduplicateAddresses {
+		deduplicateAddresses(pbObs)
+	}
+
+	data, err := proto.Marshal(pbObs)
+	if err != nil {
+		return nil, err
+	}
+	if e.compressObservations {
+		return compress(data)
+	}
+	return data, nil
 }
 
 func (e *ExecCodecProto) DecodeObservation(data []byte) (exectypes.Observation, error) {
@@ -59,17 +98,28 @@ func (e *ExecCodecProto) DecodeObservation(data []byte) (exectypes.Observation,
 		return exectypes.Observation{}, nil
 	}
 
+	if e.compressObservations {
+		var err error
+		if data, err = decompress(data); err != nil {
+			return exectypes.Observation{}, err
+		}
+	}
+
 	pbObs := &ocrtypecodecpb.ExecObservation{}
 	if err := proto.Unmarshal(data, pbObs); err != nil {
 		return exectypes.Observation{}, fmt.Errorf("proto unmarshal ExecObservation: %w", err)
 	}
+	if err := resolveAddresses(pbObs); err != nil {
+		return exectypes.Observation{}, fmt.Errorf("resolve addresses: %w", err)
+	}
 
 	return exectypes.Observation{
-		CommitReports: e.tr.commitReportsFromProto(pbObs.CommitReports),
-		Messages:      e.tr.messageObservationsFromProto(pbObs.SeqNumsToMsgs),
-		Hashes:        e.tr.messageHashesFromProto(pbObs.MsgHashes),
-		TokenData:     e.tr.tokenDataObservationsFromProto(pbObs.TokenDataObservations.TokenData),
-		Nonces:        e.tr.nonceObservationsFromProto(pbObs.Nonces),
+		CommitReports:    e.tr.commitReportsFromProto(pbObs.CommitReports),
+		Messages:         e.tr.messageObservationsFromProto(pbObs.SeqNumsToMsgs),
+		Hashes:           e.tr.messageHashesFromProto(pbObs.MsgHashes),
+		TokenData:        e.tr.tokenDataObservationsFromProto(pbObs.TokenDataObservations.TokenData),
+		Nonces:           e.tr.nonceObservationsFromProto(pbObs.Nonces),
+		SimulatedReverts: e.tr.simulatedRevertsFromProto(pbObs.SimulatedReverts),
 		Contracts: discoverytypes.Observation{
 			FChain:    e.tr.fChainFromProto(pbObs.Contracts.FChain),
 			Addresses: e.tr.discoveryAddressesFromProto(pbObs.Contracts.ContractNames.Addresses),
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"math/big"
+	"time"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -9,6 +10,7 @@ import (
 	rmnpb "github.com/smartcontractkit/chainlink-protos/rmn/v1.6/go/serialization"
 
 	"github.com/smartcontractkit/chainlink-ccip/commit/chainfee"
+	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot"
 	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn"
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
@@ -215,6 +217,79 @@ func (t *protoTranslator) seqNumChainFromProto(pbSnc []*ocrtypecodecpb.SeqNumCha
 	return snc
 }
 
+func (t *protoTranslator) pausedLanesToProto(lanes []merkleroot.PausedLane) []*ocrtypecodecpb.PausedLane {
+	pbLanes := make([]*ocrtypecodecpb.PausedLane, len(lanes))
+	for i, l := range lanes {
+		pbLanes[i] = &ocrtypecodecpb.PausedLane{
+			ChainSel:    uint64(l.ChainSel),
+			FirstSeqNum: uint64(l.FirstSeqNum),
+			LastSeqNum:  uint64(l.LastSeqNum),
+			Resumed:     l.Resumed,
+		}
+	}
+	return pbLanes
+}
+
+func (t *protoTranslator) pausedLanesFromProto(pbLanes []*ocrtypecodecpb.PausedLane) []merkleroot.PausedLane {
+	var lanes []merkleroot.PausedLane
+	if len(pbLanes) > 0 {
+		lanes = make([]merkleroot.PausedLane, len(pbLanes))
+	}
+
+	for i, l := range pbLanes {
+		lanes[i] = merkleroot.PausedLane{
+			ChainSel:    cciptypes.ChainSelector(l.ChainSel),
+			FirstSeqNum: cciptypes.SeqNum(l.FirstSeqNum),
+			LastSeqNum:  cciptypes.SeqNum(l.LastSeqNum),
+			Resumed:     l.Resumed,
+		}
+	}
+	return lanes
+}
+
+func (t *protoTranslator) pendingBatchesToProto(batches []merkleroot.PendingBatch) []*ocrtypecodecpb.PendingBatch {
+	pbBatches := make([]*ocrtypecodecpb.PendingBatch, len(batches))
+	for i, b := range batches {
+		pbBatches[i] = &ocrtypecodecpb.PendingBatch{
+			ChainSel:    uint64(b.ChainSel),
+			FirstSeqNum: uint64(b.FirstSeqNum),
+			Since:       t.optionalTimestampToProto(b.Since),
+		}
+	}
+	return pbBatches
+}
+
+func (t *protoTranslator) pendingBatchesFromProto(pbBatches []*ocrtypecodecpb.PendingBatch) []merkleroot.PendingBatch {
+	var batches []merkleroot.PendingBatch
+	if len(pbBatches) > 0 {
+		batches = make([]merkleroot.PendingBatch, len(pbBatches))
+	}
+
+	for i, b := range pbBatches {
+		batches[i] = merkleroot.PendingBatch{
+			ChainSel:    cciptypes.ChainSelector(b.ChainSel),
+			FirstSeqNum: cciptypes.SeqNum(b.FirstSeqNum),
+			Since:       t.optionalTimestampFromProto(b.Since),
+		}
+	}
+	return batches
+}
+
+// optionalTimestampToProto encodes the zero time as nil, so that it's not decoded as the unix epoch.
+func (t *protoTranslator) optionalTimestampToProto(ts time.Time) *timestamppb.Timestamp {
+	if ts.IsZero() {
+		return nil
+	}
+	return timestamppb.New(ts)
+}
+
+func (t *protoTranslator) optionalTimestampFromProto(pbTs *timestamppb.Timestamp) time.Time {
+	if pbTs == nil {
+		return time.Time{}
+	}
+	return pbTs.AsTime()
+}
+
 func (t *protoTranslator) rmnRemoteConfigToProto(rmnRemoteCfg cciptypes.RemoteConfig) *ocrtypecodecpb.RmnRemoteConfig {
 	var rmnRemoteConfigSignersPB []*ocrtypecodecpb.RemoteSignerInfo
 	if len(rmnRemoteCfg.Signers) > 0 {
@@ -880,6 +955,44 @@ func (t *protoTranslator) nonceObservationsFromProto(
 	return nonces
 }
 
+func (t *protoTranslator) simulatedRevertsToProto(
+	observations exectypes.SimulatedRevertObservations,
+) map[uint64]*ocrtypecodecpb.SeqNums {
+	var simulatedReverts map[uint64]*ocrtypecodecpb.SeqNums
+	if len(observations) > 0 {
+		simulatedReverts = make(map[uint64]*ocrtypecodecpb.SeqNums, len(observations))
+	}
+
+	for chainSel, seqNums := range observations {
+		pbSeqNums := make([]uint64, len(seqNums))
+		for i, seqNum := range seqNums {
+			pbSeqNums[i] = uint64(seqNum)
+		}
+		simulatedReverts[uint64(chainSel)] = &ocrtypecodecpb.SeqNums{SeqNums: pbSeqNums}
+	}
+
+	return simulatedReverts
+}
+
+func (t *protoTranslator) simulatedRevertsFromProto(
+	pbObservations map[uint64]*ocrtypecodecpb.SeqNums,
+) exectypes.SimulatedRevertObservations {
+	var simulatedReverts exectypes.SimulatedRevertObservations
+	if len(pbObservations) > 0 {
+		simulatedReverts = make(exectypes.SimulatedRevertObservations, len(pbObservations))
+	}
+
+	for chainSel, pbSeqNums := range pbObservations {
+		seqNums := make([]cciptypes.SeqNum, len(pbSeqNums.GetSeqNums()))
+		for i, seqNum := range pbSeqNums.GetSeqNums() {
+			seqNums[i] = cciptypes.SeqNum(seqNum)
+		}
+		simulatedReverts[cciptypes.ChainSelector(chainSel)] = seqNums
+	}
+
+	return simulatedReverts
+}
+
 func (t *protoTranslator) chainReportsToProto(
 	reports []cciptypes.ExecutePluginReportSingleChain,
 ) []*ocrtypecodecpb.ChainReport {
@@ -24,6 +24,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
 	dt "github.com/smartcontractkit/chainlink-ccip/internal/plugincommon/discovery/discoverytypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/ocrtypecodec/v1/ocrtypecodecpb"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
@@ -135,6 +136,90 @@ func TestExecOutcome(t *testing.T) {
 	fmt.Println(resultDataArray(results))
 }
 
+func TestExecObservationCompact(t *testing.T) {
+	protoCodec := NewExecCodecProto()
+	codecs := []struct {
+		name  string
+		codec *ExecCodecProto
+	}{
+		{name: "dedup", codec: NewExecCodecProto(WithAddressDeduplication())},
+		{name: "compressed", codec: NewExecCodecProto(WithObservationCompression())},
+		{name: "dedup+compressed", codec: NewExecCodecProto(WithAddressDeduplication(), WithObservationCompression())},
+	}
+
+	for _, gen := range dataGenerators {
+		obs := gen.execObservation()
+		protoEnc, err := protoCodec.EncodeObservation(obs)
+		require.NoError(t, err)
+		expObs, err := protoCodec.DecodeObservation(protoEnc)
+		require.NoError(t, err)
+
+		for _, c := range codecs {
+			enc, err := c.codec.EncodeObservation(obs)
+			require.NoError(t, err)
+			dec, err := c.codec.DecodeObservation(enc)
+			require.NoError(t, err)
+			require.Equal(t, expObs, dec)
+			require.Less(t, len(enc), len(protoEnc))
+
+			t.Logf("%s %s: proto size %d, compact size %d (%.1f%%)",
+				gen.name, c.name, len(protoEnc), len(enc), 100*float64(len(enc))/float64(len(protoEnc)))
+		}
+	}
+
+	t.Run("deduplicated observations are decoded by the default codec", func(t *testing.T) {
+		enc, err := NewExecCodecProto(WithAddressDeduplication()).EncodeObservation(smallGen.execObservation())
+		require.NoError(t, err)
+		_, err = protoCodec.DecodeObservation(enc)
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid address reference", func(t *testing.T) {
+		pbObs := &ocrtypecodecpb.ExecObservation{
+			SeqNumsToMsgs: map[uint64]*ocrtypecodecpb.SeqNumToMessage{
+				1: {Messages: map[uint64]*ocrtypecodecpb.Message{1: {SenderRef: 2}}},
+			},
+			Addresses: [][]byte{{1}},
+		}
+		require.ErrorContains(t, resolveAddresses(pbObs), "address reference 2 out of range")
+	})
+
+	t.Run("decompressed size limit", func(t *testing.T) {
+		enc, err := compress(make([]byte, maxDecompressedLen+1))
+		require.NoError(t, err)
+		_, err = decompress(enc)
+		require.ErrorContains(t, err, "decompressed data exceeds")
+	})
+}
+
+func BenchmarkExecObservationEncoding(b *testing.B) {
+	codecs := []struct {
+		name  string
+		codec *ExecCodecProto
+	}{
+		{name: "proto", codec: NewExecCodecProto()},
+		{name: "dedup", codec: NewExecCodecProto(WithAddressDeduplication())},
+		{name: "dedup+compressed", codec: NewExecCodecProto(WithAddressDeduplication(), WithObservationCompression())},
+	}
+
+	for _, gen := range dataGenerators {
+		obs := gen.execObservation()
+		for _, c := range codecs {
+			b.Run(gen.name+"/"+c.name, func(b *testing.B) {
+				var size int
+				for b.Loop() {
+					enc, err := c.codec.EncodeObservation(obs)
+					require.NoError(b, err)
+					_, err = c.codec.DecodeObservation(enc)
+					require.NoError(b, err)
+					size = len(enc)
+				}
+				b.ReportMetric(float64(size), "encoded-bytes")
+			})
+		}
+	}
+}
+
 // ------------------------------------------------
 
 func runBenchmark(
@@ -391,6 +476,8 @@ func (d *dataGenerator) commitObservation() committypes.Observation {
 			OffRampNextSeqNums: offRampNextSeqNums,
 			RMNRemoteConfig:    genRmnRemoteConfig(d.numRmnNodes),
 			FChain:             fChain,
+			PausedLanes:        genSeqNumChain(d.numSourceChains),
+			Timestamp:          time.Now().UTC(),
 		},
 		TokenPriceObs: tokenprice.Observation{
 			FeedTokenPrices:       feedTokenPrices,
@@ -442,6 +529,8 @@ func (d *dataGenerator) commitOutcome() committypes.Outcome {
 			ReportTransmissionCheckAttempts: uint(rand.Intn(128)),
 			RMNReportSignatures:             rmnReportSigs,
 			RMNRemoteCfg:                    genRmnRemoteConfig(d.numRmnNodes),
+			PausedLanes:                     genPausedLanes(d.numSourceChains),
+			PendingBatches:                  genPendingBatches(d.numSourceChains),
 		},
 		TokenPriceOutcome: tokenprice.Outcome{
 			TokenPrices: tokenPrices,
@@ -464,12 +553,14 @@ func (d *dataGenerator) execObservation() exectypes.Observation {
 	tokenDataObservations := make(map[cciptypes.ChainSelector]map[cciptypes.SeqNum]exectypes.MessageTokenData)
 	msgHashObservations := make(map[cciptypes.ChainSelector]map[cciptypes.SeqNum]cciptypes.Bytes32)
 	nonces := make(map[cciptypes.ChainSelector]map[string]uint64)
+	simulatedReverts := make(exectypes.SimulatedRevertObservations)
 	msgObservations := make(map[cciptypes.ChainSelector]map[cciptypes.SeqNum]cciptypes.Message)
 	for i := 0; i < d.numSourceChains; i++ {
 		chainSel := cciptypes.ChainSelector(rand.Uint64())
 		nonces[chainSel] = map[string]uint64{
 			genRandomString(5): rand.Uint64(),
 		}
+		simulatedReverts[chainSel] = genSeqNums(d.numMessagesPerChain / 2)
 
 		tokenDataObservations[chainSel] = make(map[cciptypes.SeqNum]exectypes.MessageTokenData, d.numMessagesPerChain)
 		msgHashObservations[chainSel] = make(map[cciptypes.SeqNum]cciptypes.Bytes32, d.numMessagesPerChain)
@@ -526,13 +617,14 @@ func (d *dataGenerator) execObservation() exectypes.Observation {
 	}
 
 	return exectypes.Observation{
-		CommitReports: commitReports,
-		Messages:      msgObservations,
-		Hashes:        msgHashObservations,
-		TokenData:     tokenDataObservations,
-		Nonces:        nonces,
-		Contracts:     discoveryObs,
-		FChain:        discoveryObs.FChain,
+		CommitReports:    commitReports,
+		Messages:         msgObservations,
+		Hashes:           msgHashObservations,
+		TokenData:        tokenDataObservations,
+		Nonces:           nonces,
+		SimulatedReverts: simulatedReverts,
+		Contracts:        discoveryObs,
+		FChain:           discoveryObs.FChain,
 	}
 }
 
@@ -688,6 +780,31 @@ func genSeqNumChain(n int) []plugintypes.SeqNumChain {
 	return chains
 }
 
+func genPausedLanes(n int) []merkleroot.PausedLane {
+	lanes := make([]merkleroot.PausedLane, n)
+	for i := 0; i < n; i++ {
+		lanes[i] = merkleroot.PausedLane{
+			ChainSel:    cciptypes.ChainSelector(rand.Uint64()),
+			FirstSeqNum: cciptypes.SeqNum(rand.Uint64()),
+			LastSeqNum:  cciptypes.SeqNum(rand.Uint64()),
+			Resumed:     rand.Intn(2) == 1,
+		}
+	}
+	return lanes
+}
+
+func genPendingBatches(n int) []merkleroot.PendingBatch {
+	batches := make([]merkleroot.PendingBatch, n)
+	for i := 0; i < n; i++ {
+		batches[i] = merkleroot.PendingBatch{
+			ChainSel:    cciptypes.ChainSelector(rand.Uint64()),
+			FirstSeqNum: cciptypes.SeqNum(rand.Uint64()),
+			Since:       time.Now().UTC(),
+		}
+	}
+	return batches
+}
+
 func genRmnRemoteConfig(numSigners int) cciptypes.RemoteConfig {
 	rmnSigners := make([]cciptypes.RemoteSignerInfo, numSigners)
 	for i := 0; i < numSigners; i++ {
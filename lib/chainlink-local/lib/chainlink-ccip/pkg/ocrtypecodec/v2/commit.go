@@ -0,0 +1,73 @@
+package v2
+
+import (
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink-ccip/commit/committypes"
+	v1 "github.com/smartcontractkit/chainlink-ccip/pkg/ocrtypecodec/v1"
+)
+
+// CommitCodec encodes the commit plugin types with the configured version and decodes the payloads of every
+// supported version.
+type CommitCodec struct {
+	version Version
+	proto   *v1.CommitCodecProto
+}
+
+var _ v1.CommitCodec = (*CommitCodec)(nil)
+
+// NewCommitCodec creates a codec that encodes with the given version, zero stands for the DefaultVersion.
+func NewCommitCodec(version Version) *CommitCodec {
+	return &CommitCodec{
+		version: version.orDefault(),
+		proto:   v1.NewCommitCodecProto(),
+	}
+}
+
+func (c *CommitCodec) EncodeQuery(query committypes.Query) ([]byte, error) {
+	data, err := c.proto.EncodeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	return wrap(c.version, data)
+}
+
+func (c *CommitCodec) DecodeQuery(data []byte) (committypes.Query, error) {
+	_, payload, err := unwrap(data)
+	if err != nil {
+		return committypes.Query{}, fmt.Errorf("unwrap query: %w", err)
+	}
+	return c.proto.DecodeQuery(payload)
+}
+
+func (c *CommitCodec) EncodeObservation(observation committypes.Observation) ([]byte, error) {
+	data, err := c.proto.EncodeObservation(observation)
+	if err != nil {
+		return nil, err
+	}
+	return wrap(c.version, data)
+}
+
+func (c *CommitCodec) DecodeObservation(data []byte) (committypes.Observation, error) {
+	_, payload, err := unwrap(data)
+	if err != nil {
+		return committypes.Observation{}, fmt.Errorf("unwrap observation: %w", err)
+	}
+	return c.proto.DecodeObservation(payload)
+}
+
+func (c *CommitCodec) EncodeOutcome(outcome committypes.Outcome) ([]byte, error) {
+	data, err := c.proto.EncodeOutcome(outcome)
+	if err != nil {
+		return nil, err
+	}
+	return wrap(c.version, data)
+}
+
+func (c *CommitCodec) DecodeOutcome(data []byte) (committypes.Outcome, error) {
+	_, payload, err := unwrap(data)
+	if err != nil {
+		return committypes.Outcome{}, fmt.Errorf("unwrap outcome: %w", err)
+	}
+	return c.proto.DecodeOutcome(payload)
+}
@@ -0,0 +1,85 @@
+// Package v2 wraps the v1 OCR type codecs in a version-tagged envelope, so that the wire format can be upgraded
+// through the offchain config instead of a coordinated restart of all the oracles.
+//
+// Enveloped payloads start with a zero byte followed by the version of the payload encoding. A zero byte is never
+// the first byte of a v1 protobuf payload (field number zero is invalid), so the decoders accept both the enveloped
+// payloads and the plain v1 payloads of the oracles that still encode with v1.
+package v2
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Version is the wire format used to encode the OCR types.
+type Version uint8
+
+const (
+	// VersionV1 is the v1 protobuf encoding without an envelope.
+	VersionV1 Version = 1
+	// VersionV2 is the v1 protobuf encoding wrapped in the version-tagged envelope.
+	VersionV2 Version = 2
+	// VersionV3 is VersionV2 with compressed exec observations whose repeated message addresses are deduplicated.
+	// The other payloads are encoded as in VersionV2.
+	VersionV3 Version = 3
+
+	// DefaultVersion is used when the version is not configured.
+	DefaultVersion = VersionV1
+	// LatestVersion is the most recent version that the codecs are able to encode and decode.
+	LatestVersion = VersionV3
+)
+
+const (
+	envelopeMarker    byte = 0x00
+	envelopeHeaderLen      = 2
+)
+
+// Validate returns an error if the version is not supported, zero stands for the DefaultVersion.
+func (v Version) Validate() error {
+	if v > LatestVersion {
+		return fmt.Errorf("unsupported ocr codec version %d", v)
+	}
+	return nil
+}
+
+func (v Version) orDefault() Version {
+	if v == 0 {
+		return DefaultVersion
+	}
+	return v
+}
+
+// wrap puts the payload in the envelope of the version. Empty payloads are never wrapped since they decode to
+// zero values in every version.
+func wrap(version Version, payload []byte) ([]byte, error) {
+	switch version {
+	case VersionV1:
+		return payload, nil
+	case VersionV2, VersionV3:
+		if len(payload) == 0 {
+			return payload, nil
+		}
+		data := make([]byte, 0, envelopeHeaderLen+len(payload))
+		data = append(data, envelopeMarker, byte(version))
+		return append(data, payload...), nil
+	default:
+		return nil, fmt.Errorf("unsupported ocr codec version %d", version)
+	}
+}
+
+// unwrap returns the version and the payload of either an enveloped or a plain v1 payload.
+func unwrap(data []byte) (Version, []byte, error) {
+	if len(data) == 0 || data[0] != envelopeMarker {
+		return VersionV1, data, nil
+	}
+	if len(data) < envelopeHeaderLen {
+		return 0, nil, errors.New("truncated envelope header")
+	}
+
+	switch version := Version(data[1]); version {
+	case VersionV2, VersionV3:
+		return version, data[envelopeHeaderLen:], nil
+	default:
+		return 0, nil, fmt.Errorf("unsupported envelope version %d", version)
+	}
+}
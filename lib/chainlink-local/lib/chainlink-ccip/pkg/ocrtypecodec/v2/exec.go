@@ -0,0 +1,70 @@
+package v2
+
+import (
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	v1 "github.com/smartcontractkit/chainlink-ccip/pkg/ocrtypecodec/v1"
+)
+
+// ExecCodec encodes the exec plugin types with the configured version and decodes the payloads of every
+// supported version.
+type ExecCodec struct {
+	version Version
+	proto   *v1.ExecCodecProto
+	// compactProto encodes the observations of VersionV3.
+	compactProto *v1.ExecCodecProto
+}
+
+var _ v1.ExecCodec = (*ExecCodec)(nil)
+
+// NewExecCodec creates a codec that encodes with the given version, zero stands for the DefaultVersion.
+func NewExecCodec(version Version) *ExecCodec {
+	return &ExecCodec{
+		version: version.orDefault(),
+		proto:   v1.NewExecCodecProto(),
+		compactProto: v1.NewExecCodecProto(
+			v1.WithAddressDeduplication(),
+			v1.WithObservationCompression(),
+		),
+	}
+}
+
+func (e *ExecCodec) EncodeObservation(observation exectypes.Observation) ([]byte, error) {
+	data, err := e.observationCodec(e.version).EncodeObservation(observation)
+	if err != nil {
+		return nil, err
+	}
+	return wrap(e.version, data)
+}
+
+func (e *ExecCodec) DecodeObservation(data []byte) (exectypes.Observation, error) {
+	version, payload, err := unwrap(data)
+	if err != nil {
+		return exectypes.Observation{}, fmt.Errorf("unwrap observation: %w", err)
+	}
+	return e.observationCodec(version).DecodeObservation(payload)
+}
+
+func (e *ExecCodec) EncodeOutcome(outcome exectypes.Outcome) ([]byte, error) {
+	data, err := e.proto.EncodeOutcome(outcome)
+	if err != nil {
+		return nil, err
+	}
+	return wrap(e.version, data)
+}
+
+func (e *ExecCodec) DecodeOutcome(data []byte) (exectypes.Outcome, error) {
+	_, payload, err := unwrap(data)
+	if err != nil {
+		return exectypes.Outcome{}, fmt.Errorf("unwrap outcome: %w", err)
+	}
+	return e.proto.DecodeOutcome(payload)
+}
+
+func (e *ExecCodec) observationCodec(version Version) *v1.ExecCodecProto {
+	if version == VersionV3 {
+		return e.compactProto
+	}
+	return e.proto
+}
@@ -0,0 +1,197 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/commit/chainfee"
+	"github.com/smartcontractkit/chainlink-ccip/commit/committypes"
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	v1 "github.com/smartcontractkit/chainlink-ccip/pkg/ocrtypecodec/v1"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+func TestLatestVersionMatchesConfig(t *testing.T) {
+	require.Equal(t, pluginconfig.LatestOCRCodecVersion, uint8(LatestVersion))
+}
+
+func TestDefaultVersion(t *testing.T) {
+	require.Equal(t, VersionV1, DefaultVersion)
+
+	// the codecs of an unconfigured version encode the plain v1 payloads, so that the oracles keep
+	// talking to the ones that do not support the envelope yet.
+	commitObs := committypes.Observation{FChain: map[cciptypes.ChainSelector]int{1: 2}}
+	v1Data, err := v1.DefaultCommitCodec.EncodeObservation(commitObs)
+	require.NoError(t, err)
+	encoded, err := NewCommitCodec(0).EncodeObservation(commitObs)
+	require.NoError(t, err)
+	require.Equal(t, v1Data, encoded)
+
+	execObs := exectypes.Observation{
+		Messages: exectypes.MessageObservations{1: {1: cciptypes.Message{Sender: cciptypes.UnknownAddress{1}}}},
+		FChain:   map[cciptypes.ChainSelector]int{1: 2},
+	}
+	v1Data, err = v1.DefaultExecCodec.EncodeObservation(execObs)
+	require.NoError(t, err)
+	encoded, err = NewExecCodec(0).EncodeObservation(execObs)
+	require.NoError(t, err)
+	require.Equal(t, v1Data, encoded)
+}
+
+func TestVersion_Validate(t *testing.T) {
+	require.NoError(t, Version(0).Validate())
+	require.NoError(t, VersionV1.Validate())
+	require.NoError(t, VersionV2.Validate())
+	require.NoError(t, VersionV3.Validate())
+	require.ErrorContains(t, (LatestVersion + 1).Validate(), "unsupported ocr codec version")
+}
+
+func TestCommitCodec(t *testing.T) {
+	outcome := committypes.Outcome{
+		ChainFeeOutcome: chainfee.Outcome{
+			GasPrices: []cciptypes.GasPriceChain{{ChainSel: 1, GasPrice: cciptypes.NewBigIntFromInt64(123)}},
+		},
+	}
+
+	// the expected outcome is the v1 round trip so that nil and empty values are decoded the same way.
+	v1Data, err := v1.DefaultCommitCodec.EncodeOutcome(outcome)
+	require.NoError(t, err)
+	expOutcome, err := v1.DefaultCommitCodec.DecodeOutcome(v1Data)
+	require.NoError(t, err)
+
+	for _, encVersion := range []Version{0, VersionV1, VersionV2, VersionV3} {
+		encoded, err := NewCommitCodec(encVersion).EncodeOutcome(outcome)
+		require.NoError(t, err)
+		if encVersion >= VersionV2 {
+			require.Equal(t, []byte{envelopeMarker, byte(encVersion)}, encoded[:envelopeHeaderLen])
+			require.Equal(t, v1Data, encoded[envelopeHeaderLen:])
+		} else {
+			require.Equal(t, v1Data, encoded)
+		}
+
+		// every codec decodes the payloads of every version.
+		for _, decVersion := range []Version{VersionV1, VersionV2, VersionV3} {
+			decoded, err := NewCommitCodec(decVersion).DecodeOutcome(encoded)
+			require.NoError(t, err)
+			require.Equal(t, expOutcome, decoded)
+		}
+	}
+
+	t.Run("query and observation", func(t *testing.T) {
+		codec := NewCommitCodec(VersionV2)
+
+		query := committypes.Query{}
+		query.MerkleRootQuery.RetryRMNSignatures = true
+		encodedQuery, err := codec.EncodeQuery(query)
+		require.NoError(t, err)
+		decodedQuery, err := codec.DecodeQuery(encodedQuery)
+		require.NoError(t, err)
+		require.True(t, decodedQuery.MerkleRootQuery.RetryRMNSignatures)
+
+		encodedObs, err := codec.EncodeObservation(committypes.Observation{FChain: map[cciptypes.ChainSelector]int{1: 2}})
+		require.NoError(t, err)
+		obs, err := codec.DecodeObservation(encodedObs)
+		require.NoError(t, err)
+		require.Equal(t, map[cciptypes.ChainSelector]int{1: 2}, obs.FChain)
+	})
+}
+
+func TestExecCodec(t *testing.T) {
+	outcome := exectypes.Outcome{
+		State: exectypes.GetMessages,
+		CommitReports: []exectypes.CommitData{{
+			SourceChain:         1,
+			MerkleRoot:          cciptypes.Bytes32{1},
+			SequenceNumberRange: cciptypes.NewSeqNumRange(1, 10),
+		}},
+	}
+
+	v1Data, err := v1.DefaultExecCodec.EncodeOutcome(outcome)
+	require.NoError(t, err)
+	expOutcome, err := v1.DefaultExecCodec.DecodeOutcome(v1Data)
+	require.NoError(t, err)
+
+	for _, encVersion := range []Version{0, VersionV1, VersionV2, VersionV3} {
+		encoded, err := NewExecCodec(encVersion).EncodeOutcome(outcome)
+		require.NoError(t, err)
+
+		for _, decVersion := range []Version{VersionV1, VersionV2, VersionV3} {
+			decoded, err := NewExecCodec(decVersion).DecodeOutcome(encoded)
+			require.NoError(t, err)
+			require.Equal(t, expOutcome, decoded)
+		}
+	}
+
+	t.Run("observation", func(t *testing.T) {
+		sender := cciptypes.UnknownAddress{1, 2, 3}
+		msg := cciptypes.Message{
+			Header:   cciptypes.RampMessageHeader{SequenceNumber: 1, OnRamp: cciptypes.UnknownAddress{4}},
+			Sender:   sender,
+			Receiver: cciptypes.UnknownAddress{5},
+		}
+		msg2 := msg
+		msg2.Header.SequenceNumber = 2
+		observation := exectypes.Observation{
+			Messages: exectypes.MessageObservations{1: {1: msg, 2: msg2}},
+			FChain:   map[cciptypes.ChainSelector]int{1: 2},
+		}
+
+		v1Data, err := v1.DefaultExecCodec.EncodeObservation(observation)
+		require.NoError(t, err)
+		expObs, err := v1.DefaultExecCodec.DecodeObservation(v1Data)
+		require.NoError(t, err)
+
+		for _, encVersion := range []Version{VersionV1, VersionV2, VersionV3} {
+			encoded, err := NewExecCodec(encVersion).EncodeObservation(observation)
+			require.NoError(t, err)
+
+			for _, decVersion := range []Version{VersionV1, VersionV2, VersionV3} {
+				decoded, err := NewExecCodec(decVersion).DecodeObservation(encoded)
+				require.NoError(t, err)
+				require.Equal(t, expObs, decoded)
+			}
+		}
+	})
+}
+
+func Test_unwrap(t *testing.T) {
+	testCases := []struct {
+		name       string
+		data       []byte
+		expVersion Version
+		expPayload []byte
+		expErr     string
+	}{
+		{name: "empty", data: nil, expVersion: VersionV1, expPayload: nil},
+		{name: "v1 payload", data: []byte{0x0a, 0x01}, expVersion: VersionV1, expPayload: []byte{0x0a, 0x01}},
+		{name: "v2 payload", data: []byte{envelopeMarker, 2, 0x0a}, expVersion: VersionV2, expPayload: []byte{0x0a}},
+		{name: "v3 payload", data: []byte{envelopeMarker, 3, 0x0a}, expVersion: VersionV3, expPayload: []byte{0x0a}},
+		{name: "truncated header", data: []byte{envelopeMarker}, expErr: "truncated envelope header"},
+		{name: "unknown version", data: []byte{envelopeMarker, 0xff, 0x0a}, expErr: "unsupported envelope version 255"},
+		{name: "v1 in envelope", data: []byte{envelopeMarker, 1}, expErr: "unsupported envelope version 1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			version, payload, err := unwrap(tc.data)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expVersion, version)
+			require.Equal(t, tc.expPayload, payload)
+		})
+	}
+}
+
+func Test_wrap(t *testing.T) {
+	data, err := wrap(VersionV2, nil)
+	require.NoError(t, err)
+	require.Empty(t, data, "empty payloads are not wrapped")
+
+	_, err = wrap(LatestVersion+1, []byte{1})
+	require.ErrorContains(t, err, "unsupported ocr codec version")
+}
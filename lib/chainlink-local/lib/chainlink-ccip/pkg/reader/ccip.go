@@ -541,6 +541,7 @@ func (r *ccipChainReader) MsgsBetweenSeqNums(
 		}
 
 		msg.Message.Header.OnRamp = onRampAddress
+		msg.Message.Header.TxHash = txHashFromCursor(item.Cursor)
 		msgs = append(msgs, msg.Message)
 	}
 
@@ -3,6 +3,7 @@ package reader
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -130,3 +131,18 @@ func validateReaderExistence(
 	}
 	return nil
 }
+
+// txHashFromCursor extracts the transaction hash from the contract reader's sequence cursor.
+// EVM contract reader formats cursors as "<blockNumber>-<logIndex>-<txHash>". Empty string is returned
+// when cursor doesn't follow that format (e.g. for other chain families).
+func txHashFromCursor(cursor string) string {
+	parts := strings.Split(cursor, "-")
+	if len(parts) != 3 {
+		return ""
+	}
+	txHash := parts[2]
+	if !strings.HasPrefix(txHash, "0x") || len(txHash) != 66 {
+		return ""
+	}
+	return txHash
+}
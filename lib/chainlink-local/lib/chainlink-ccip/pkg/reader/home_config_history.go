@@ -0,0 +1,588 @@
+package reader
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+const (
+	// configHistoryPageSize is the number of config events fetched per query.
+	configHistoryPageSize = uint64(100)
+	// latestBlock does not bound the config history to a block.
+	latestBlock = uint64(math.MaxUint64)
+)
+
+// HomeConfigHistory reads the configs that were set in the CCIPHome and RMNHome contracts from their events.
+// Unlike HomeChain and RMNHome, which only cache the current active and candidate configs, it can tell what the
+// configs were at any given block, e.g. when investigating an incident.
+// The active and candidate configs at a block are rebuilt by replaying the ConfigSet, ConfigPromoted,
+// ActiveConfigRevoked and CandidateConfigRevoked events up to that block.
+type HomeConfigHistory interface {
+	// GetCCIPHomeConfigs returns the CCIPHome configs set at or before the given block, in the order they were set.
+	GetCCIPHomeConfigs(ctx context.Context, block uint64) ([]CCIPHomeConfigRecord, error)
+	// GetCCIPHomeActiveAndCandidateConfigs returns the active and candidate CCIPHome configs of every DON and
+	// plugin type at the given block. DONs without an active or candidate config are omitted.
+	GetCCIPHomeActiveAndCandidateConfigs(ctx context.Context, block uint64) (map[CCIPHomeDONKey]ActiveAndCandidate, error)
+	// GetCCIPHomeConfigByDigest returns the CCIPHome config with the given config digest.
+	GetCCIPHomeConfigByDigest(ctx context.Context, configDigest cciptypes.Bytes32) (CCIPHomeConfigRecord, error)
+	// GetRMNHomeConfigs returns the RMNHome configs set at or before the given block, in the order they were set.
+	// The dynamic config of every record is the one in effect at the given block.
+	GetRMNHomeConfigs(ctx context.Context, block uint64) ([]RMNHomeConfigRecord, error)
+	// GetRMNHomeActiveAndCandidateConfigs returns the active and candidate RMNHome configs at the given block.
+	// The dynamic config of both is the one in effect at the given block.
+	GetRMNHomeActiveAndCandidateConfigs(ctx context.Context, block uint64) (GetAllConfigsResponse, error)
+	// GetRMNHomeConfigByDigest returns the RMNHome config with the given config digest and its latest dynamic config.
+	GetRMNHomeConfigByDigest(ctx context.Context, configDigest cciptypes.Bytes32) (RMNHomeConfigRecord, error)
+	// DiffCCIPHomeConfigDigests returns the differences between the CCIPHome configs of the given config digests.
+	DiffCCIPHomeConfigDigests(ctx context.Context, from, to cciptypes.Bytes32) ([]ConfigFieldDiff, error)
+	// DiffRMNHomeConfigDigests returns the differences between the RMNHome configs of the given config digests.
+	DiffRMNHomeConfigDigests(ctx context.Context, from, to cciptypes.Bytes32) ([]ConfigFieldDiff, error)
+}
+
+// CCIPHomeConfigSetEvent mirrors CCIPHome.sol's ConfigSet event.
+type CCIPHomeConfigSetEvent struct {
+	ConfigDigest [32]byte   `json:"configDigest"`
+	Version      uint32     `json:"version"`
+	Config       OCR3Config `json:"config"`
+}
+
+// RMNHomeConfigSetEvent mirrors RMNHome.sol's ConfigSet event.
+type RMNHomeConfigSetEvent struct {
+	ConfigDigest  cciptypes.Bytes32 `json:"configDigest"`
+	Version       uint32            `json:"version"`
+	StaticConfig  StaticConfig      `json:"staticConfig"`
+	DynamicConfig DynamicConfig     `json:"dynamicConfig"`
+}
+
+// RMNHomeDynamicConfigSetEvent mirrors RMNHome.sol's DynamicConfigSet event.
+type RMNHomeDynamicConfigSetEvent struct {
+	ConfigDigest  cciptypes.Bytes32 `json:"configDigest"`
+	DynamicConfig DynamicConfig     `json:"dynamicConfig"`
+}
+
+// ConfigDigestEvent mirrors the ConfigPromoted, ActiveConfigRevoked and CandidateConfigRevoked events of
+// CCIPHome.sol and RMNHome.sol, which only carry the config digest.
+type ConfigDigestEvent struct {
+	ConfigDigest cciptypes.Bytes32 `json:"configDigest"`
+}
+
+// CCIPHomeConfigRecord is a CCIPHome config and the block it was set at.
+type CCIPHomeConfigRecord struct {
+	BlockNumber uint64
+	Config      OCR3ConfigWithMeta
+}
+
+// RMNHomeConfigRecord is a RMNHome config and the block it was set at.
+type RMNHomeConfigRecord struct {
+	BlockNumber uint64
+	// DynamicConfigBlockNumber is the block the dynamic config was last set at.
+	DynamicConfigBlockNumber uint64
+	Config                   VersionedConfig
+}
+
+// CCIPHomeDONKey identifies the configs of a plugin of a DON. The CCIPHome events do not carry the DON ID, the DON
+// is identified by the chain selector of its configs since a CCIP DON serves a single destination chain.
+type CCIPHomeDONKey struct {
+	ChainSelector cciptypes.ChainSelector
+	PluginType    uint8
+}
+
+type homeConfigHistory struct {
+	lggr   logger.Logger
+	reader contractreader.Extended
+}
+
+// NewHomeConfigHistory creates a HomeConfigHistory reading from the home chain, the CCIPHome and RMNHome contracts
+// must be bound to the reader.
+func NewHomeConfigHistory(lggr logger.Logger, reader contractreader.Extended) HomeConfigHistory {
+	return &homeConfigHistory{
+		lggr:   lggr,
+		reader: reader,
+	}
+}
+
+func (h *homeConfigHistory) GetCCIPHomeConfigs(ctx context.Context, block uint64) ([]CCIPHomeConfigRecord, error) {
+	seqs, err := h.queryConfigEvents(
+		ctx, consts.ContractNameCCIPConfig, consts.EventNameConfigSet, block, &CCIPHomeConfigSetEvent{})
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]CCIPHomeConfigRecord, 0, len(seqs))
+	for _, seq := range seqs {
+		ev, ok := seq.Data.(*CCIPHomeConfigSetEvent)
+		if !ok {
+			return nil, fmt.Errorf("unexpected CCIPHome ConfigSet event type %T", seq.Data)
+		}
+		blockNum, err := parseBlockNumber(seq)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, CCIPHomeConfigRecord{
+			BlockNumber: blockNum,
+			Config: OCR3ConfigWithMeta{
+				Version:      ev.Version,
+				ConfigDigest: ev.ConfigDigest,
+				Config:       ev.Config,
+			},
+		})
+	}
+	return records, nil
+}
+
+func (h *homeConfigHistory) GetCCIPHomeActiveAndCandidateConfigs(
+	ctx context.Context, block uint64,
+) (map[CCIPHomeDONKey]ActiveAndCandidate, error) {
+	records, err := h.GetCCIPHomeConfigs(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+
+	configs := make(map[cciptypes.Bytes32]OCR3ConfigWithMeta, len(records))
+	events := make([]configStateEvent, 0, len(records))
+	for _, record := range records {
+		digest := cciptypes.Bytes32(record.Config.ConfigDigest)
+		configs[digest] = record.Config
+		events = append(events, configStateEvent{
+			blockNum: record.BlockNumber,
+			name:     consts.EventNameConfigSet,
+			digest:   digest,
+		})
+	}
+	if events, err = h.appendConfigStateEvents(ctx, consts.ContractNameCCIPConfig, block, events); err != nil {
+		return nil, err
+	}
+
+	states := make(map[CCIPHomeDONKey]*configState)
+	for _, ev := range events {
+		cfg, exists := configs[ev.digest]
+		if !exists {
+			h.logUnknownDigest(consts.ContractNameCCIPConfig, ev)
+			continue
+		}
+		key := CCIPHomeDONKey{ChainSelector: cfg.Config.ChainSelector, PluginType: cfg.Config.PluginType}
+		if _, exists := states[key]; !exists {
+			states[key] = &configState{}
+		}
+		states[key].apply(ev)
+	}
+
+	activeAndCandidates := make(map[CCIPHomeDONKey]ActiveAndCandidate, len(states))
+	for key, state := range states {
+		if state.isEmpty() {
+			continue
+		}
+		activeAndCandidates[key] = ActiveAndCandidate{
+			ActiveConfig:    configs[state.active],
+			CandidateConfig: configs[state.candidate],
+		}
+	}
+	return activeAndCandidates, nil
+}
+
+func (h *homeConfigHistory) GetCCIPHomeConfigByDigest(
+	ctx context.Context, configDigest cciptypes.Bytes32,
+) (CCIPHomeConfigRecord, error) {
+	records, err := h.GetCCIPHomeConfigs(ctx, latestBlock)
+	if err != nil {
+		return CCIPHomeConfigRecord{}, err
+	}
+	for _, record := range records {
+		if record.Config.ConfigDigest == configDigest {
+			return record, nil
+		}
+	}
+	return CCIPHomeConfigRecord{}, fmt.Errorf("configDigest %s not found in CCIPHome config history", configDigest)
+}
+
+func (h *homeConfigHistory) GetRMNHomeConfigs(ctx context.Context, block uint64) ([]RMNHomeConfigRecord, error) {
+	configSeqs, err := h.queryConfigEvents(
+		ctx, consts.ContractNameRMNHome, consts.EventNameConfigSet, block, &RMNHomeConfigSetEvent{})
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]RMNHomeConfigRecord, 0, len(configSeqs))
+	recordIndex := make(map[cciptypes.Bytes32]int, len(configSeqs))
+	for _, seq := range configSeqs {
+		ev, ok := seq.Data.(*RMNHomeConfigSetEvent)
+		if !ok {
+			return nil, fmt.Errorf("unexpected RMNHome ConfigSet event type %T", seq.Data)
+		}
+		blockNum, err := parseBlockNumber(seq)
+		if err != nil {
+			return nil, err
+		}
+		recordIndex[ev.ConfigDigest] = len(records)
+		records = append(records, RMNHomeConfigRecord{
+			BlockNumber:              blockNum,
+			DynamicConfigBlockNumber: blockNum,
+			Config: VersionedConfig{
+				Version:       ev.Version,
+				ConfigDigest:  ev.ConfigDigest,
+				StaticConfig:  ev.StaticConfig,
+				DynamicConfig: ev.DynamicConfig,
+			},
+		})
+	}
+
+	dynamicSeqs, err := h.queryConfigEvents(
+		ctx, consts.ContractNameRMNHome, consts.EventNameDynamicConfigSet, block, &RMNHomeDynamicConfigSetEvent{})
+	if err != nil {
+		return nil, err
+	}
+
+	// the events are sorted by sequence, so the last dynamic config of every digest wins.
+	for _, seq := range dynamicSeqs {
+		ev, ok := seq.Data.(*RMNHomeDynamicConfigSetEvent)
+		if !ok {
+			return nil, fmt.Errorf("unexpected RMNHome DynamicConfigSet event type %T", seq.Data)
+		}
+		i, exists := recordIndex[ev.ConfigDigest]
+		if !exists {
+			h.lggr.Warnw("RMNHome dynamic config set for an unknown config digest", "configDigest", ev.ConfigDigest)
+			continue
+		}
+		blockNum, err := parseBlockNumber(seq)
+		if err != nil {
+			return nil, err
+		}
+		records[i].DynamicConfigBlockNumber = blockNum
+		records[i].Config.DynamicConfig = ev.DynamicConfig
+	}
+
+	return records, nil
+}
+
+func (h *homeConfigHistory) GetRMNHomeActiveAndCandidateConfigs(
+	ctx context.Context, block uint64,
+) (GetAllConfigsResponse, error) {
+	records, err := h.GetRMNHomeConfigs(ctx, block)
+	if err != nil {
+		return GetAllConfigsResponse{}, err
+	}
+
+	configs := make(map[cciptypes.Bytes32]VersionedConfig, len(records))
+	events := make([]configStateEvent, 0, len(records))
+	for _, record := range records {
+		configs[record.Config.ConfigDigest] = record.Config
+		events = append(events, configStateEvent{
+			blockNum: record.BlockNumber,
+			name:     consts.EventNameConfigSet,
+			digest:   record.Config.ConfigDigest,
+		})
+	}
+	if events, err = h.appendConfigStateEvents(ctx, consts.ContractNameRMNHome, block, events); err != nil {
+		return GetAllConfigsResponse{}, err
+	}
+
+	var state configState
+	for _, ev := range events {
+		if _, exists := configs[ev.digest]; !exists {
+			h.logUnknownDigest(consts.ContractNameRMNHome, ev)
+			continue
+		}
+		state.apply(ev)
+	}
+	return GetAllConfigsResponse{
+		ActiveConfig:    configs[state.active],
+		CandidateConfig: configs[state.candidate],
+	}, nil
+}
+
+func (h *homeConfigHistory) GetRMNHomeConfigByDigest(
+	ctx context.Context, configDigest cciptypes.Bytes32,
+) (RMNHomeConfigRecord, error) {
+	records, err := h.GetRMNHomeConfigs(ctx, latestBlock)
+	if err != nil {
+		return RMNHomeConfigRecord{}, err
+	}
+	for _, record := range records {
+		if record.Config.ConfigDigest == configDigest {
+			return record, nil
+		}
+	}
+	return RMNHomeConfigRecord{}, fmt.Errorf("configDigest %s not found in RMNHome config history", configDigest)
+}
+
+func (h *homeConfigHistory) DiffCCIPHomeConfigDigests(
+	ctx context.Context, from, to cciptypes.Bytes32,
+) ([]ConfigFieldDiff, error) {
+	fromRecord, err := h.GetCCIPHomeConfigByDigest(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	toRecord, err := h.GetCCIPHomeConfigByDigest(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+	return DiffCCIPHomeConfigs(fromRecord.Config, toRecord.Config), nil
+}
+
+func (h *homeConfigHistory) DiffRMNHomeConfigDigests(
+	ctx context.Context, from, to cciptypes.Bytes32,
+) ([]ConfigFieldDiff, error) {
+	fromRecord, err := h.GetRMNHomeConfigByDigest(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	toRecord, err := h.GetRMNHomeConfigByDigest(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+	return DiffRMNHomeConfigs(fromRecord.Config, toRecord.Config), nil
+}
+
+// queryConfigEvents returns all the events of the contract emitted at or before the given block, sorted by sequence.
+func (h *homeConfigHistory) queryConfigEvents(
+	ctx context.Context, contractName, eventName string, block uint64, dataType any,
+) ([]types.Sequence, error) {
+	expressions := []query.Expression{query.Confidence(primitives.Unconfirmed)}
+	if block != latestBlock {
+		expressions = append(expressions, query.Block(strconv.FormatUint(block, 10), primitives.Lte))
+	}
+
+	var (
+		seqs  []types.Sequence
+		limit = query.CountLimit(configHistoryPageSize)
+	)
+	for {
+		page, err := h.reader.ExtendedQueryKey(
+			ctx,
+			contractName,
+			query.KeyFilter{Key: eventName, Expressions: expressions},
+			query.LimitAndSort{
+				SortBy: []query.SortBy{query.NewSortBySequence(query.Asc)},
+				Limit:  limit,
+			},
+			dataType,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query %s %s events: %w", contractName, eventName, err)
+		}
+
+		seqs = append(seqs, page...)
+		if uint64(len(page)) < configHistoryPageSize {
+			return seqs, nil
+		}
+		limit = query.CursorLimit(page[len(page)-1].Cursor, query.CursorFollowing, configHistoryPageSize)
+	}
+}
+
+// configStateEventNames are the events that change the active and candidate configs besides ConfigSet, in the
+// order they are emitted within a transaction.
+var configStateEventNames = []string{
+	consts.EventNameCandidateConfigRevoked,
+	consts.EventNameActiveConfigRevoked,
+	consts.EventNameConfigPromoted,
+}
+
+// configStateEventOrder is the order in which the events of a block are applied. The events of a block can only be
+// sorted by type since each type is queried separately, the order matches the order they are emitted within
+// setCandidate and promoteCandidateAndRevokeActive.
+var configStateEventOrder = map[string]int{
+	consts.EventNameCandidateConfigRevoked: 0,
+	consts.EventNameConfigSet:              1,
+	consts.EventNameActiveConfigRevoked:    2,
+	consts.EventNameConfigPromoted:         3,
+}
+
+// configStateEvent is an event that changes the active or candidate config of CCIPHome or RMNHome.
+type configStateEvent struct {
+	blockNum uint64
+	name     string
+	digest   cciptypes.Bytes32
+}
+
+// appendConfigStateEvents appends the promotion and revocation events of the contract emitted at or before the given
+// block to events and sorts them in the order they must be applied.
+func (h *homeConfigHistory) appendConfigStateEvents(
+	ctx context.Context, contractName string, block uint64, events []configStateEvent,
+) ([]configStateEvent, error) {
+	for _, eventName := range configStateEventNames {
+		seqs, err := h.queryConfigEvents(ctx, contractName, eventName, block, &ConfigDigestEvent{})
+		if err != nil {
+			return nil, err
+		}
+		for _, seq := range seqs {
+			ev, ok := seq.Data.(*ConfigDigestEvent)
+			if !ok {
+				return nil, fmt.Errorf("unexpected %s %s event type %T", contractName, eventName, seq.Data)
+			}
+			blockNum, err := parseBlockNumber(seq)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, configStateEvent{blockNum: blockNum, name: eventName, digest: ev.ConfigDigest})
+		}
+	}
+
+	// the events of every type are already sorted by sequence, the stable sort keeps them in that order.
+	slices.SortStableFunc(events, func(a, b configStateEvent) int {
+		if a.blockNum != b.blockNum {
+			return cmp.Compare(a.blockNum, b.blockNum)
+		}
+		return cmp.Compare(configStateEventOrder[a.name], configStateEventOrder[b.name])
+	})
+	return events, nil
+}
+
+func (h *homeConfigHistory) logUnknownDigest(contractName string, ev configStateEvent) {
+	// promoting an empty candidate emits ConfigPromoted with the zero digest.
+	if ev.digest.IsEmpty() {
+		return
+	}
+	h.lggr.Warnw("config event for an unknown config digest",
+		"contract", contractName, "event", ev.name, "configDigest", ev.digest)
+}
+
+// configState are the digests of the active and candidate configs, the zero digest if not set.
+type configState struct {
+	active    cciptypes.Bytes32
+	candidate cciptypes.Bytes32
+}
+
+// apply applies the event the same way the contracts update their state.
+func (s *configState) apply(ev configStateEvent) {
+	switch ev.name {
+	case consts.EventNameConfigSet:
+		s.candidate = ev.digest
+	case consts.EventNameCandidateConfigRevoked:
+		if s.candidate == ev.digest {
+			s.candidate = cciptypes.Bytes32{}
+		}
+	case consts.EventNameActiveConfigRevoked:
+		if s.active == ev.digest {
+			s.active = cciptypes.Bytes32{}
+		}
+	case consts.EventNameConfigPromoted:
+		// the candidate is only kept if it was set after the promotion within the same block.
+		if s.candidate == ev.digest {
+			s.candidate = cciptypes.Bytes32{}
+		}
+		s.active = ev.digest
+	}
+}
+
+func (s *configState) isEmpty() bool {
+	return s.active.IsEmpty() && s.candidate.IsEmpty()
+}
+
+func parseBlockNumber(seq types.Sequence) (uint64, error) {
+	blockNum, err := strconv.ParseUint(seq.Head.Height, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse block number %s: %w", seq.Head.Height, err)
+	}
+	return blockNum, nil
+}
+
+// ConfigFieldDiff is a field whose value differs between two configs, the values are formatted for display.
+// A value is empty if the field is missing from the config, e.g. a node that was added.
+type ConfigFieldDiff struct {
+	Field string
+	From  string
+	To    string
+}
+
+type configDiff []ConfigFieldDiff
+
+func (d *configDiff) add(field string, from, to string) {
+	if from != to {
+		*d = append(*d, ConfigFieldDiff{Field: field, From: from, To: to})
+	}
+}
+
+// DiffCCIPHomeConfigs returns the fields that differ between two CCIPHome configs. The nodes are compared by
+// index since their order defines the oracle IDs.
+func DiffCCIPHomeConfigs(from, to OCR3ConfigWithMeta) []ConfigFieldDiff {
+	var diff configDiff
+	diff.add("version", fmt.Sprint(from.Version), fmt.Sprint(to.Version))
+
+	fromCfg, toCfg := from.Config, to.Config
+	diff.add("pluginType", fmt.Sprint(fromCfg.PluginType), fmt.Sprint(toCfg.PluginType))
+	diff.add("chainSelector", fmt.Sprint(fromCfg.ChainSelector), fmt.Sprint(toCfg.ChainSelector))
+	diff.add("fRoleDON", fmt.Sprint(fromCfg.FRoleDON), fmt.Sprint(toCfg.FRoleDON))
+	diff.add("offchainConfigVersion",
+		fmt.Sprint(fromCfg.OffchainConfigVersion), fmt.Sprint(toCfg.OffchainConfigVersion))
+	diff.add("offrampAddress",
+		cciptypes.Bytes(fromCfg.OfframpAddress).String(), cciptypes.Bytes(toCfg.OfframpAddress).String())
+	diff.add("rmnHomeAddress",
+		cciptypes.Bytes(fromCfg.RmnHomeAddress).String(), cciptypes.Bytes(toCfg.RmnHomeAddress).String())
+	diff.add("offchainConfig",
+		cciptypes.Bytes(fromCfg.OffchainConfig).String(), cciptypes.Bytes(toCfg.OffchainConfig).String())
+
+	formatNode := func(nodes []OCR3Node, i int) string {
+		if i >= len(nodes) {
+			return ""
+		}
+		return fmt.Sprintf("p2pId=%s signerKey=%s transmitterKey=%s",
+			cciptypes.Bytes32(nodes[i].P2pID),
+			cciptypes.Bytes(nodes[i].SignerKey),
+			cciptypes.Bytes(nodes[i].TransmitterKey))
+	}
+	for i := 0; i < max(len(fromCfg.Nodes), len(toCfg.Nodes)); i++ {
+		diff.add(fmt.Sprintf("nodes[%d]", i), formatNode(fromCfg.Nodes, i), formatNode(toCfg.Nodes, i))
+	}
+
+	return diff
+}
+
+// DiffRMNHomeConfigs returns the fields that differ between two RMNHome configs. The nodes are compared by index
+// since their order defines the observer bitmaps, the source chains are compared by chain selector.
+func DiffRMNHomeConfigs(from, to VersionedConfig) []ConfigFieldDiff {
+	var diff configDiff
+	diff.add("version", fmt.Sprint(from.Version), fmt.Sprint(to.Version))
+	diff.add("staticConfig.offchainConfig",
+		from.StaticConfig.OffchainConfig.String(), to.StaticConfig.OffchainConfig.String())
+	diff.add("dynamicConfig.offchainConfig",
+		from.DynamicConfig.OffchainConfig.String(), to.DynamicConfig.OffchainConfig.String())
+
+	formatNode := func(nodes []Node, i int) string {
+		if i >= len(nodes) {
+			return ""
+		}
+		return fmt.Sprintf("peerId=%s offchainPublicKey=%s", nodes[i].PeerID, nodes[i].OffchainPublicKey)
+	}
+	fromNodes, toNodes := from.StaticConfig.Nodes, to.StaticConfig.Nodes
+	for i := 0; i < max(len(fromNodes), len(toNodes)); i++ {
+		diff.add(fmt.Sprintf("staticConfig.nodes[%d]", i), formatNode(fromNodes, i), formatNode(toNodes, i))
+	}
+
+	sourceChains := func(cfg VersionedConfig) map[cciptypes.ChainSelector]string {
+		chains := make(map[cciptypes.ChainSelector]string, len(cfg.DynamicConfig.SourceChains))
+		for _, chain := range cfg.DynamicConfig.SourceChains {
+			chains[chain.ChainSelector] = fmt.Sprintf("fObserve=%d observerNodesBitmap=%v",
+				chain.FObserve, chain.ObserverNodesBitmap)
+		}
+		return chains
+	}
+	fromChains, toChains := sourceChains(from), sourceChains(to)
+	var chainSels []cciptypes.ChainSelector
+	for chainSel := range fromChains {
+		chainSels = append(chainSels, chainSel)
+	}
+	for chainSel := range toChains {
+		if _, exists := fromChains[chainSel]; !exists {
+			chainSels = append(chainSels, chainSel)
+		}
+	}
+	slices.Sort(chainSels)
+	for _, chainSel := range chainSels {
+		diff.add(fmt.Sprintf("dynamicConfig.sourceChains[%d]", chainSel), fromChains[chainSel], toChains[chainSel])
+	}
+
+	return diff
+}
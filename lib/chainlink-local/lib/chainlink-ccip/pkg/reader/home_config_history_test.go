@@ -0,0 +1,369 @@
+package reader
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	readermock "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/contractreader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+func eventKey(eventName string) any {
+	return mock.MatchedBy(func(filter query.KeyFilter) bool { return filter.Key == eventName })
+}
+
+func TestHomeConfigHistory_CCIPHome(t *testing.T) {
+	ctx := tests.Context(t)
+	reader := readermock.NewMockExtended(t)
+	history := NewHomeConfigHistory(logger.Test(t), reader)
+
+	digest1 := cciptypes.Bytes32{1}
+	digest2 := cciptypes.Bytes32{2}
+	cfg1 := OCR3Config{
+		PluginType:     1,
+		ChainSelector:  10,
+		FRoleDON:       1,
+		OfframpAddress: []byte{0xaa},
+		Nodes: []OCR3Node{
+			{P2pID: [32]byte{1}, SignerKey: []byte{0x01}, TransmitterKey: []byte{0x02}},
+		},
+	}
+	cfg2 := cfg1
+	cfg2.FRoleDON = 2
+	cfg2.Nodes = append(cfg2.Nodes, OCR3Node{P2pID: [32]byte{2}, SignerKey: []byte{0x03}})
+
+	reader.EXPECT().ExtendedQueryKey(
+		mock.Anything, consts.ContractNameCCIPConfig, eventKey(consts.EventNameConfigSet), mock.Anything, mock.Anything,
+	).Return([]types.Sequence{
+		{
+			Head: types.Head{Height: "100"},
+			Data: &CCIPHomeConfigSetEvent{ConfigDigest: digest1, Version: 1, Config: cfg1},
+		},
+		{
+			Head: types.Head{Height: "200"},
+			Data: &CCIPHomeConfigSetEvent{ConfigDigest: digest2, Version: 2, Config: cfg2},
+		},
+	}, nil)
+
+	records, err := history.GetCCIPHomeConfigs(ctx, 200)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, uint64(100), records[0].BlockNumber)
+	require.Equal(t, [32]byte(digest1), records[0].Config.ConfigDigest)
+	require.Equal(t, cfg2, records[1].Config.Config)
+
+	record, err := history.GetCCIPHomeConfigByDigest(ctx, digest2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), record.BlockNumber)
+
+	_, err = history.GetCCIPHomeConfigByDigest(ctx, cciptypes.Bytes32{3})
+	require.ErrorContains(t, err, "not found in CCIPHome config history")
+
+	diff, err := history.DiffCCIPHomeConfigDigests(ctx, digest1, digest2)
+	require.NoError(t, err)
+	require.Equal(t, []ConfigFieldDiff{
+		{Field: "version", From: "1", To: "2"},
+		{Field: "fRoleDON", From: "1", To: "2"},
+		{
+			Field: "nodes[1]",
+			To: "p2pId=0x0200000000000000000000000000000000000000000000000000000000000000 " +
+				"signerKey=0x03 transmitterKey=0x",
+		},
+	}, diff)
+}
+
+func TestHomeConfigHistory_RMNHome(t *testing.T) {
+	ctx := tests.Context(t)
+	reader := readermock.NewMockExtended(t)
+	history := NewHomeConfigHistory(logger.Test(t), reader)
+
+	digest := cciptypes.Bytes32{1}
+	staticConfig := StaticConfig{Nodes: []Node{{PeerID: cciptypes.Bytes32{1}}}}
+	dynamicConfig := DynamicConfig{
+		SourceChains: []SourceChain{{ChainSelector: 10, FObserve: 1, ObserverNodesBitmap: big.NewInt(1)}},
+	}
+	updatedDynamicConfig := DynamicConfig{
+		SourceChains: []SourceChain{{ChainSelector: 10, FObserve: 2, ObserverNodesBitmap: big.NewInt(1)}},
+	}
+
+	reader.EXPECT().ExtendedQueryKey(
+		mock.Anything, consts.ContractNameRMNHome, eventKey(consts.EventNameConfigSet), mock.Anything, mock.Anything,
+	).Return([]types.Sequence{
+		{
+			Head: types.Head{Height: "100"},
+			Data: &RMNHomeConfigSetEvent{
+				ConfigDigest: digest, Version: 1, StaticConfig: staticConfig, DynamicConfig: dynamicConfig,
+			},
+		},
+	}, nil)
+	reader.EXPECT().ExtendedQueryKey(
+		mock.Anything, consts.ContractNameRMNHome, eventKey(consts.EventNameDynamicConfigSet), mock.Anything,
+		mock.Anything,
+	).Return([]types.Sequence{
+		{
+			Head: types.Head{Height: "150"},
+			Data: &RMNHomeDynamicConfigSetEvent{ConfigDigest: digest, DynamicConfig: updatedDynamicConfig},
+		},
+		{
+			Head: types.Head{Height: "160"},
+			Data: &RMNHomeDynamicConfigSetEvent{ConfigDigest: cciptypes.Bytes32{9}, DynamicConfig: dynamicConfig},
+		},
+	}, nil)
+
+	record, err := history.GetRMNHomeConfigByDigest(ctx, digest)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), record.BlockNumber)
+	require.Equal(t, uint64(150), record.DynamicConfigBlockNumber)
+	require.Equal(t, staticConfig, record.Config.StaticConfig)
+	require.Equal(t, updatedDynamicConfig, record.Config.DynamicConfig)
+}
+
+func TestHomeConfigHistory_CCIPHomeActiveAndCandidate(t *testing.T) {
+	ctx := tests.Context(t)
+	reader := readermock.NewMockExtended(t)
+	history := NewHomeConfigHistory(logger.Test(t), reader)
+
+	commitCfg := OCR3Config{PluginType: 0, ChainSelector: 10, FRoleDON: 1}
+	execCfg := OCR3Config{PluginType: 1, ChainSelector: 20, FRoleDON: 1}
+	commitKey := CCIPHomeDONKey{ChainSelector: 10, PluginType: 0}
+	execKey := CCIPHomeDONKey{ChainSelector: 20, PluginType: 1}
+	config := func(digest byte, version uint32, cfg OCR3Config) OCR3ConfigWithMeta {
+		return OCR3ConfigWithMeta{Version: version, ConfigDigest: [32]byte{digest}, Config: cfg}
+	}
+
+	mockConfigEvents(t, reader, consts.ContractNameCCIPConfig, map[string][]types.Sequence{
+		consts.EventNameConfigSet: {
+			configSeq(100, &CCIPHomeConfigSetEvent{ConfigDigest: [32]byte{1}, Version: 1, Config: commitCfg}),
+			configSeq(105, &CCIPHomeConfigSetEvent{ConfigDigest: [32]byte{4}, Version: 2, Config: execCfg}),
+			configSeq(120, &CCIPHomeConfigSetEvent{ConfigDigest: [32]byte{2}, Version: 3, Config: commitCfg}),
+			// set right after the promotion of the same block.
+			configSeq(130, &CCIPHomeConfigSetEvent{ConfigDigest: [32]byte{3}, Version: 4, Config: commitCfg}),
+		},
+		consts.EventNameConfigPromoted: {
+			configSeq(110, &ConfigDigestEvent{ConfigDigest: cciptypes.Bytes32{1}}),
+			configSeq(130, &ConfigDigestEvent{ConfigDigest: cciptypes.Bytes32{2}}),
+		},
+		consts.EventNameActiveConfigRevoked: {
+			configSeq(130, &ConfigDigestEvent{ConfigDigest: cciptypes.Bytes32{1}}),
+		},
+		consts.EventNameCandidateConfigRevoked: {
+			configSeq(115, &ConfigDigestEvent{ConfigDigest: cciptypes.Bytes32{4}}),
+			configSeq(140, &ConfigDigestEvent{ConfigDigest: cciptypes.Bytes32{3}}),
+		},
+	})
+
+	configs, err := history.GetCCIPHomeActiveAndCandidateConfigs(ctx, 99)
+	require.NoError(t, err)
+	require.Empty(t, configs)
+
+	configs, err = history.GetCCIPHomeActiveAndCandidateConfigs(ctx, 110)
+	require.NoError(t, err)
+	require.Equal(t, map[CCIPHomeDONKey]ActiveAndCandidate{
+		commitKey: {ActiveConfig: config(1, 1, commitCfg)},
+		execKey:   {CandidateConfig: config(4, 2, execCfg)},
+	}, configs)
+
+	configs, err = history.GetCCIPHomeActiveAndCandidateConfigs(ctx, 130)
+	require.NoError(t, err)
+	require.Equal(t, map[CCIPHomeDONKey]ActiveAndCandidate{
+		commitKey: {ActiveConfig: config(2, 3, commitCfg), CandidateConfig: config(3, 4, commitCfg)},
+	}, configs)
+
+	configs, err = history.GetCCIPHomeActiveAndCandidateConfigs(ctx, latestBlock)
+	require.NoError(t, err)
+	require.Equal(t, map[CCIPHomeDONKey]ActiveAndCandidate{
+		commitKey: {ActiveConfig: config(2, 3, commitCfg)},
+	}, configs)
+}
+
+func TestHomeConfigHistory_RMNHomeActiveAndCandidate(t *testing.T) {
+	ctx := tests.Context(t)
+	reader := readermock.NewMockExtended(t)
+	history := NewHomeConfigHistory(logger.Test(t), reader)
+
+	staticConfig := StaticConfig{Nodes: []Node{{PeerID: cciptypes.Bytes32{1}}}}
+	dynamicConfig := DynamicConfig{OffchainConfig: cciptypes.Bytes{0x01}}
+	updatedDynamicConfig := DynamicConfig{OffchainConfig: cciptypes.Bytes{0x02}}
+	config := func(digest byte, version uint32, dynamicConfig DynamicConfig) VersionedConfig {
+		return VersionedConfig{
+			Version:       version,
+			ConfigDigest:  cciptypes.Bytes32{digest},
+			StaticConfig:  staticConfig,
+			DynamicConfig: dynamicConfig,
+		}
+	}
+
+	mockConfigEvents(t, reader, consts.ContractNameRMNHome, map[string][]types.Sequence{
+		consts.EventNameConfigSet: {
+			configSeq(100, &RMNHomeConfigSetEvent{
+				ConfigDigest: cciptypes.Bytes32{1}, Version: 1, StaticConfig: staticConfig, DynamicConfig: dynamicConfig,
+			}),
+			configSeq(200, &RMNHomeConfigSetEvent{
+				ConfigDigest: cciptypes.Bytes32{2}, Version: 2, StaticConfig: staticConfig, DynamicConfig: dynamicConfig,
+			}),
+		},
+		consts.EventNameDynamicConfigSet: {
+			configSeq(150, &RMNHomeDynamicConfigSetEvent{
+				ConfigDigest: cciptypes.Bytes32{1}, DynamicConfig: updatedDynamicConfig,
+			}),
+		},
+		consts.EventNameConfigPromoted: {
+			// set and promoted in the same block.
+			configSeq(100, &ConfigDigestEvent{ConfigDigest: cciptypes.Bytes32{1}}),
+			configSeq(300, &ConfigDigestEvent{ConfigDigest: cciptypes.Bytes32{2}}),
+			// promoting the empty candidate revokes the active config.
+			configSeq(400, &ConfigDigestEvent{}),
+		},
+		consts.EventNameActiveConfigRevoked: {
+			configSeq(300, &ConfigDigestEvent{ConfigDigest: cciptypes.Bytes32{1}}),
+			configSeq(400, &ConfigDigestEvent{ConfigDigest: cciptypes.Bytes32{2}}),
+		},
+	})
+
+	configs, err := history.GetRMNHomeActiveAndCandidateConfigs(ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, GetAllConfigsResponse{ActiveConfig: config(1, 1, dynamicConfig)}, configs)
+
+	configs, err = history.GetRMNHomeActiveAndCandidateConfigs(ctx, 200)
+	require.NoError(t, err)
+	require.Equal(t, GetAllConfigsResponse{
+		ActiveConfig:    config(1, 1, updatedDynamicConfig),
+		CandidateConfig: config(2, 2, dynamicConfig),
+	}, configs)
+
+	configs, err = history.GetRMNHomeActiveAndCandidateConfigs(ctx, 300)
+	require.NoError(t, err)
+	require.Equal(t, GetAllConfigsResponse{ActiveConfig: config(2, 2, dynamicConfig)}, configs)
+
+	configs, err = history.GetRMNHomeActiveAndCandidateConfigs(ctx, latestBlock)
+	require.NoError(t, err)
+	require.Equal(t, GetAllConfigsResponse{}, configs)
+}
+
+func configSeq(block uint64, data any) types.Sequence {
+	return types.Sequence{Head: types.Head{Height: strconv.FormatUint(block, 10)}, Data: data}
+}
+
+// mockConfigEvents serves the events of the contract by event name, bounded by the queried block.
+func mockConfigEvents(
+	t *testing.T, reader *readermock.MockExtended, contractName string, events map[string][]types.Sequence,
+) {
+	reader.EXPECT().ExtendedQueryKey(mock.Anything, contractName, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(
+			_ context.Context, _ string, filter query.KeyFilter, _ query.LimitAndSort, _ any,
+		) ([]types.Sequence, error) {
+			block := latestBlock
+			for _, expr := range filter.Expressions {
+				if b, ok := expr.Primitive.(*primitives.Block); ok {
+					var err error
+					block, err = strconv.ParseUint(b.Block, 10, 64)
+					require.NoError(t, err)
+				}
+			}
+
+			var seqs []types.Sequence
+			for _, seq := range events[filter.Key] {
+				height, err := parseBlockNumber(seq)
+				require.NoError(t, err)
+				if height <= block {
+					seqs = append(seqs, seq)
+				}
+			}
+			return seqs, nil
+		})
+}
+
+func TestHomeConfigHistory_Paging(t *testing.T) {
+	ctx := tests.Context(t)
+	reader := readermock.NewMockExtended(t)
+	history := NewHomeConfigHistory(logger.Test(t), reader)
+
+	fullPage := make([]types.Sequence, configHistoryPageSize)
+	for i := range fullPage {
+		fullPage[i] = types.Sequence{
+			Cursor: "cursor",
+			Head:   types.Head{Height: "1"},
+			Data:   &CCIPHomeConfigSetEvent{ConfigDigest: [32]byte{byte(i)}},
+		}
+	}
+	lastPage := []types.Sequence{{Head: types.Head{Height: "2"}, Data: &CCIPHomeConfigSetEvent{}}}
+
+	reader.EXPECT().ExtendedQueryKey(
+		mock.Anything, consts.ContractNameCCIPConfig, mock.Anything,
+		query.LimitAndSort{
+			SortBy: []query.SortBy{query.NewSortBySequence(query.Asc)},
+			Limit:  query.CountLimit(configHistoryPageSize),
+		},
+		mock.Anything,
+	).Return(fullPage, nil).Once()
+	reader.EXPECT().ExtendedQueryKey(
+		mock.Anything, consts.ContractNameCCIPConfig, mock.Anything,
+		query.LimitAndSort{
+			SortBy: []query.SortBy{query.NewSortBySequence(query.Asc)},
+			Limit:  query.CursorLimit("cursor", query.CursorFollowing, configHistoryPageSize),
+		},
+		mock.Anything,
+	).Return(lastPage, nil).Once()
+
+	records, err := history.GetCCIPHomeConfigs(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, records, int(configHistoryPageSize)+1)
+	require.Equal(t, uint64(2), records[len(records)-1].BlockNumber)
+}
+
+func TestDiffRMNHomeConfigs(t *testing.T) {
+	from := VersionedConfig{
+		Version: 1,
+		StaticConfig: StaticConfig{
+			Nodes: []Node{{PeerID: cciptypes.Bytes32{1}}, {PeerID: cciptypes.Bytes32{2}}},
+		},
+		DynamicConfig: DynamicConfig{
+			SourceChains: []SourceChain{
+				{ChainSelector: 10, FObserve: 1, ObserverNodesBitmap: big.NewInt(3)},
+				{ChainSelector: 20, FObserve: 1, ObserverNodesBitmap: big.NewInt(3)},
+			},
+		},
+	}
+	to := VersionedConfig{
+		Version:      2,
+		StaticConfig: StaticConfig{Nodes: []Node{{PeerID: cciptypes.Bytes32{1}}}},
+		DynamicConfig: DynamicConfig{
+			SourceChains: []SourceChain{
+				{ChainSelector: 30, FObserve: 0, ObserverNodesBitmap: big.NewInt(1)},
+				{ChainSelector: 10, FObserve: 0, ObserverNodesBitmap: big.NewInt(1)},
+			},
+			OffchainConfig: cciptypes.Bytes{0x01},
+		},
+	}
+
+	require.Equal(t, []ConfigFieldDiff{
+		{Field: "version", From: "1", To: "2"},
+		{Field: "dynamicConfig.offchainConfig", From: "0x", To: "0x01"},
+		{
+			Field: "staticConfig.nodes[1]",
+			From: "peerId=0x0200000000000000000000000000000000000000000000000000000000000000 " +
+				"offchainPublicKey=0x0000000000000000000000000000000000000000000000000000000000000000",
+		},
+		{
+			Field: "dynamicConfig.sourceChains[10]",
+			From:  "fObserve=1 observerNodesBitmap=3",
+			To:    "fObserve=0 observerNodesBitmap=1",
+		},
+		{Field: "dynamicConfig.sourceChains[20]", From: "fObserve=1 observerNodesBitmap=3"},
+		{Field: "dynamicConfig.sourceChains[30]", To: "fObserve=0 observerNodesBitmap=1"},
+	}, DiffRMNHomeConfigs(from, to))
+
+	require.Empty(t, DiffRMNHomeConfigs(from, from))
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sort"
+
+	"golang.org/x/exp/maps"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
@@ -40,6 +43,8 @@ type priceReader struct {
 	ccipReader   CCIPReader
 	feedChain    ccipocr3.ChainSelector
 	addressCodec ccipocr3.AddressCodec
+	// feedAddresses maps the configured feed chain addresses to their canonical form.
+	feedAddresses map[ccipocr3.UnknownEncodedAddress]string
 }
 
 func NewPriceReader(
@@ -50,14 +55,87 @@ func NewPriceReader(
 	feedChain ccipocr3.ChainSelector,
 	addressCodec ccipocr3.AddressCodec,
 ) PriceReader {
+	feedAddresses := make(map[ccipocr3.UnknownEncodedAddress]string)
+	for token, info := range tokenInfo {
+		for _, addr := range info.FeedChainAddresses() {
+			canonical, err := canonicalAddress(addr, feedChain, addressCodec)
+			if err != nil {
+				lggr.Errorw("invalid feed chain address", "token", token, "address", addr, "err", err)
+				continue
+			}
+			feedAddresses[addr] = canonical
+		}
+	}
+
 	return &priceReader{
-		lggr:         lggr,
-		chainReaders: chainReaders,
-		tokenInfo:    tokenInfo,
-		ccipReader:   ccipReader,
-		feedChain:    feedChain,
-		addressCodec: addressCodec,
+		lggr:          lggr,
+		chainReaders:  chainReaders,
+		tokenInfo:     tokenInfo,
+		ccipReader:    ccipReader,
+		feedChain:     feedChain,
+		addressCodec:  addressCodec,
+		feedAddresses: feedAddresses,
+	}
+}
+
+// FeedChainContracts returns the price feed aggregators and exchange rate contracts the token prices are read from.
+// Addresses are converted to the canonical form of the feed chain family using the address codec, so
+// the contracts can be bound on non-EVM feed chains as well, e.g. Solana feed accounts.
+func FeedChainContracts(
+	tokenInfo map[ccipocr3.UnknownEncodedAddress]pluginconfig.TokenInfo,
+	feedChain ccipocr3.ChainSelector,
+	addressCodec ccipocr3.AddressCodec,
+) ([]commontypes.BoundContract, error) {
+	contracts := make(map[commontypes.BoundContract]struct{})
+	addContract := func(token, addr ccipocr3.UnknownEncodedAddress, name string) error {
+		canonical, err := canonicalAddress(addr, feedChain, addressCodec)
+		if err != nil {
+			return fmt.Errorf("token %s: %w", token, err)
+		}
+		contracts[commontypes.BoundContract{Address: canonical, Name: name}] = struct{}{}
+		return nil
 	}
+
+	for token, info := range tokenInfo {
+		for _, aggregator := range info.Aggregators() {
+			if err := addContract(token, aggregator, consts.ContractNamePriceAggregator); err != nil {
+				return nil, err
+			}
+		}
+		if info.DerivedPrice != nil && info.DerivedPrice.ExchangeRate != nil {
+			err := addContract(token, info.DerivedPrice.ExchangeRate.ContractAddress, consts.ContractNameExchangeRateProvider)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	bcs := maps.Keys(contracts)
+	sort.Slice(bcs, func(i, j int) bool {
+		if bcs[i].Name != bcs[j].Name {
+			return bcs[i].Name < bcs[j].Name
+		}
+		return bcs[i].Address < bcs[j].Address
+	})
+	return bcs, nil
+}
+
+// canonicalAddress round-trips the address through the address codec of the feed chain family,
+// e.g. the EVM hex addresses are checksummed and Solana feed accounts are base58 encoded.
+func canonicalAddress(
+	addr ccipocr3.UnknownEncodedAddress,
+	feedChain ccipocr3.ChainSelector,
+	addressCodec ccipocr3.AddressCodec,
+) (string, error) {
+	addrBytes, err := addressCodec.AddressStringToBytes(string(addr), feedChain)
+	if err != nil {
+		return "", fmt.Errorf("decode feed chain address %s: %w", addr, err)
+	}
+	canonical, err := addressCodec.AddressBytesToString(addrBytes, feedChain)
+	if err != nil {
+		return "", fmt.Errorf("encode feed chain address %s: %w", addr, err)
+	}
+	return canonical, nil
 }
 
 // LatestRoundData is what AggregatorV3Interface returns for price feed
@@ -177,8 +255,25 @@ func (pr *priceReader) GetFeedPricesUSD(
 	}
 
 	// Process results by contract
-	for boundContract, tokens := range contractTokenMap {
+	feedPrices := make(map[commontypes.BoundContract]*big.Int)
+	exchangeRates := make(map[commontypes.BoundContract]*big.Int)
+	for boundContract := range contractTokenMap {
 		contractResults, ok := results[boundContract]
+
+		if boundContract.Name == consts.ContractNameExchangeRateProvider {
+			if !ok || len(contractResults) != 1 {
+				lggr.Errorf("invalid results for contract %s", boundContract.Address)
+				continue
+			}
+			rate, err := pr.getExchangeRate(contractResults[0], boundContract)
+			if err != nil {
+				lggr.Errorw("calling getExchangeRate", "err", err)
+				continue
+			}
+			exchangeRates[boundContract] = rate
+			continue
+		}
+
 		if !ok || len(contractResults) != priceReaderOperationCount {
 			lggr.Errorf("invalid results for contract %s", boundContract.Address)
 			continue
@@ -204,18 +299,26 @@ func (pr *priceReader) GetFeedPricesUSD(
 		}
 
 		// Normalize price for this contract
-		normalizedContractPrice := pr.normalizePrice(latestRoundData.Answer, *decimals)
-
-		// Apply the normalized price to all tokens using this contract
-		for _, token := range tokens {
-			tokenInfo := pr.tokenInfo[token]
-			price := calculateUsdPer1e18TokenAmount(normalizedContractPrice, tokenInfo.Decimals)
-			if price == nil {
-				lggr.Errorw("failed to calculate price", "token", token)
-				continue
-			}
-			prices[token] = ccipocr3.NewBigInt(price)
+		feedPrices[boundContract] = pr.normalizePrice(latestRoundData.Answer, *decimals)
+	}
+
+	// Derive the token prices from the normalized feed prices and exchange rates
+	for _, token := range tokens {
+		tokenInfo, ok := pr.tokenInfo[token]
+		if !ok {
+			continue
+		}
+		usdPrice, err := pr.tokenPriceUSD(tokenInfo, feedPrices, exchangeRates)
+		if err != nil {
+			lggr.Errorw("failed to derive price", "token", token, "err", err)
+			continue
 		}
+		price := calculateUsdPer1e18TokenAmount(usdPrice, tokenInfo.Decimals)
+		if price == nil || price.Sign() <= 0 {
+			lggr.Errorw("failed to calculate price", "token", token)
+			continue
+		}
+		prices[token] = ccipocr3.NewBigInt(price)
 	}
 
 	return prices, nil
@@ -257,6 +360,24 @@ func (pr *priceReader) getDecimals(
 	return decimals, nil
 }
 
+func (pr *priceReader) getExchangeRate(
+	result commontypes.BatchReadResult,
+	boundContract commontypes.BoundContract,
+) (*big.Int, error) {
+	rateResult, err := result.GetResult()
+	if err != nil {
+		return nil, fmt.Errorf("get exchange rate for contract %s: %w", boundContract.Address, err)
+	}
+	rate, ok := rateResult.(*big.Int)
+	if !ok || rate == nil {
+		return nil, fmt.Errorf("invalid exchange rate data type for contract %s", boundContract.Address)
+	}
+	if rate.Sign() <= 0 {
+		return nil, fmt.Errorf("non positive exchange rate for contract %s", boundContract.Address)
+	}
+	return rate, nil
+}
+
 // prepareBatchRequest creates a batch request grouped by contract and returns the mapping of contracts to token indices
 func (pr *priceReader) prepareBatchRequest(
 	tokens []ccipocr3.UnknownEncodedAddress,
@@ -271,33 +392,102 @@ func (pr *priceReader) prepareBatchRequest(
 			continue
 		}
 
-		boundContract := commontypes.BoundContract{
-			Address: string(tokenInfo.AggregatorAddress),
-			Name:    consts.ContractNamePriceAggregator,
+		for _, aggregator := range tokenInfo.Aggregators() {
+			boundContract := pr.aggregatorContract(aggregator)
+
+			// Initialize contract batch if it doesn't exist
+			if _, exists := batchRequest[boundContract]; !exists {
+				batchRequest[boundContract] = make(commontypes.ContractBatch, priceReaderOperationCount)
+				batchRequest[boundContract][0] = commontypes.BatchRead{
+					ReadName:  consts.MethodNameGetLatestRoundData,
+					Params:    nil,
+					ReturnVal: &LatestRoundData{},
+				}
+				batchRequest[boundContract][1] = commontypes.BatchRead{
+					ReadName:  consts.MethodNameGetDecimals,
+					Params:    nil,
+					ReturnVal: new(uint8),
+				}
+			}
+
+			// Track which tokens use this contract
+			contractTokenMap[boundContract] = append(contractTokenMap[boundContract], token)
 		}
 
-		// Initialize contract batch if it doesn't exist
+		if tokenInfo.DerivedPrice == nil || tokenInfo.DerivedPrice.ExchangeRate == nil {
+			continue
+		}
+		boundContract := pr.exchangeRateContract(tokenInfo.DerivedPrice.ExchangeRate.ContractAddress)
 		if _, exists := batchRequest[boundContract]; !exists {
-			batchRequest[boundContract] = make(commontypes.ContractBatch, priceReaderOperationCount)
-			batchRequest[boundContract][0] = commontypes.BatchRead{
-				ReadName:  consts.MethodNameGetLatestRoundData,
-				Params:    nil,
-				ReturnVal: &LatestRoundData{},
-			}
-			batchRequest[boundContract][1] = commontypes.BatchRead{
-				ReadName:  consts.MethodNameGetDecimals,
+			batchRequest[boundContract] = commontypes.ContractBatch{{
+				ReadName:  consts.MethodNameGetExchangeRate,
 				Params:    nil,
-				ReturnVal: new(uint8),
-			}
+				ReturnVal: new(big.Int),
+			}}
 		}
-
-		// Track which tokens use this contract
 		contractTokenMap[boundContract] = append(contractTokenMap[boundContract], token)
 	}
 
 	return batchRequest, contractTokenMap
 }
 
+func (pr *priceReader) aggregatorContract(address ccipocr3.UnknownEncodedAddress) commontypes.BoundContract {
+	return commontypes.BoundContract{
+		Address: pr.feedAddress(address),
+		Name:    consts.ContractNamePriceAggregator,
+	}
+}
+
+func (pr *priceReader) exchangeRateContract(address ccipocr3.UnknownEncodedAddress) commontypes.BoundContract {
+	return commontypes.BoundContract{
+		Address: pr.feedAddress(address),
+		Name:    consts.ContractNameExchangeRateProvider,
+	}
+}
+
+// feedAddress returns the canonical form of the feed chain address, addresses which couldn't be converted
+// are used as configured.
+func (pr *priceReader) feedAddress(address ccipocr3.UnknownEncodedAddress) string {
+	if canonical, ok := pr.feedAddresses[address]; ok {
+		return canonical
+	}
+	return string(address)
+}
+
+// tokenPriceUSD returns the USD price per full token with 18 decimal precision. Prices of tokens with a single
+// aggregator are taken as is, derived prices are the median of the available feed prices multiplied by
+// the exchange rate. More than half of the feeds of a derived price must be available.
+func (pr *priceReader) tokenPriceUSD(
+	tokenInfo pluginconfig.TokenInfo,
+	feedPrices map[commontypes.BoundContract]*big.Int,
+	exchangeRates map[commontypes.BoundContract]*big.Int,
+) (*big.Int, error) {
+	aggregators := tokenInfo.Aggregators()
+	available := make([]*big.Int, 0, len(aggregators))
+	for _, aggregator := range aggregators {
+		if price, ok := feedPrices[pr.aggregatorContract(aggregator)]; ok {
+			available = append(available, price)
+		}
+	}
+	if len(available)*2 <= len(aggregators) {
+		return nil, fmt.Errorf("only %d out of %d price feeds available", len(available), len(aggregators))
+	}
+
+	sort.Slice(available, func(i, j int) bool { return available[i].Cmp(available[j]) < 0 })
+	price := new(big.Int).Set(available[len(available)/2])
+
+	if tokenInfo.DerivedPrice == nil || tokenInfo.DerivedPrice.ExchangeRate == nil {
+		return price, nil
+	}
+	rateSource := tokenInfo.DerivedPrice.ExchangeRate
+	rate, ok := exchangeRates[pr.exchangeRateContract(rateSource.ContractAddress)]
+	if !ok {
+		return nil, fmt.Errorf("exchange rate of %s not available", rateSource.ContractAddress)
+	}
+	price.Mul(price, rate)
+	return price.Div(price, big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(rateSource.Decimals)), nil)), nil
+}
+
 func (pr *priceReader) normalizePrice(price *big.Int, decimals uint8) *big.Int {
 	answer := new(big.Int).Set(price)
 	if decimals < 18 {
@@ -1,6 +1,7 @@
 package reader
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math/big"
@@ -13,9 +14,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	sel "github.com/smartcontractkit/chain-selectors"
+
 	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 
 	readermock "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/contractreader"
+	ccipocr3mocks "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/types/ccipocr3"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
@@ -302,3 +306,153 @@ func createMockReader(
 
 	return reader
 }
+
+func TestPriceReader_GetFeedPricesUSD_DerivedPrices(t *testing.T) {
+	const (
+		wstEthAddr   = cciptypes.UnknownEncodedAddress("0xc100000000000000000000000000000000000000")
+		medianAddr   = cciptypes.UnknownEncodedAddress("0xc200000000000000000000000000000000000000")
+		staleAddr    = cciptypes.UnknownEncodedAddress("0xc300000000000000000000000000000000000000")
+		noRateAddr   = cciptypes.UnknownEncodedAddress("0xc400000000000000000000000000000000000000")
+		feed1        = cciptypes.UnknownEncodedAddress("0xf100000000000000000000000000000000000000")
+		feed2        = cciptypes.UnknownEncodedAddress("0xf200000000000000000000000000000000000000")
+		feed3        = cciptypes.UnknownEncodedAddress("0xf300000000000000000000000000000000000000")
+		failingFeed1 = cciptypes.UnknownEncodedAddress("0xf400000000000000000000000000000000000000")
+		failingFeed2 = cciptypes.UnknownEncodedAddress("0xf500000000000000000000000000000000000000")
+		rateContract = cciptypes.UnknownEncodedAddress("0xe500000000000000000000000000000000000000")
+		failingRate  = cciptypes.UnknownEncodedAddress("0xe600000000000000000000000000000000000000")
+	)
+
+	derived := func(
+		rate cciptypes.UnknownEncodedAddress, feeds ...cciptypes.UnknownEncodedAddress,
+	) pluginconfig.TokenInfo {
+		info := pluginconfig.TokenInfo{
+			DerivedPrice: &pluginconfig.DerivedPriceSource{AggregatorAddresses: feeds},
+			DeviationPPB: cciptypes.NewBigInt(big.NewInt(1e5)),
+			Decimals:     Decimals18,
+		}
+		if rate != "" {
+			info.DerivedPrice.ExchangeRate = &pluginconfig.ExchangeRateSource{ContractAddress: rate, Decimals: 18}
+		}
+		return info
+	}
+	tokenInfo := map[cciptypes.UnknownEncodedAddress]pluginconfig.TokenInfo{
+		// 1 wstETH = 1.2 stETH
+		wstEthAddr: derived(rateContract, feed1),
+		medianAddr: derived("", feed1, feed2, feed3, failingFeed1),
+		staleAddr:  derived("", feed1, failingFeed1, failingFeed2),
+		noRateAddr: derived(failingRate, feed1),
+	}
+
+	feedResults := func(answer *big.Int, err error) commontypes.ContractBatchResults {
+		priceResult := commontypes.BatchReadResult{ReadName: consts.MethodNameGetLatestRoundData}
+		priceResult.SetResult(&LatestRoundData{Answer: answer}, err)
+		decimalsResult := commontypes.BatchReadResult{ReadName: consts.MethodNameGetDecimals}
+		decimals := uint8(8)
+		decimalsResult.SetResult(&decimals, nil)
+		return commontypes.ContractBatchResults{priceResult, decimalsResult}
+	}
+	rateResults := func(rate *big.Int, err error) commontypes.ContractBatchResults {
+		rateResult := commontypes.BatchReadResult{ReadName: consts.MethodNameGetExchangeRate}
+		rateResult.SetResult(rate, err)
+		return commontypes.ContractBatchResults{rateResult}
+	}
+	aggregator := func(addr cciptypes.UnknownEncodedAddress) commontypes.BoundContract {
+		return commontypes.BoundContract{Address: string(addr), Name: consts.ContractNamePriceAggregator}
+	}
+	exchangeRate := func(addr cciptypes.UnknownEncodedAddress) commontypes.BoundContract {
+		return commontypes.BoundContract{Address: string(addr), Name: consts.ContractNameExchangeRateProvider}
+	}
+	results := commontypes.BatchGetLatestValuesResult{
+		aggregator(feed1):          feedResults(big.NewInt(2000e8), nil),
+		aggregator(feed2):          feedResults(big.NewInt(2010e8), nil),
+		aggregator(feed3):          feedResults(big.NewInt(1990e8), nil),
+		aggregator(failingFeed1):   feedResults(nil, fmt.Errorf("error")),
+		aggregator(failingFeed2):   feedResults(nil, fmt.Errorf("error")),
+		exchangeRate(rateContract): rateResults(big.NewInt(12e17), nil),
+		exchangeRate(failingRate):  rateResults(nil, fmt.Errorf("error")),
+	}
+
+	reader := readermock.NewMockContractReaderFacade(t)
+	reader.On("BatchGetLatestValues", mock.Anything,
+		mock.MatchedBy(func(req commontypes.BatchGetLatestValuesRequest) bool {
+			// every contract is read once even if it's shared by several tokens
+			return len(req) == len(results)
+		}),
+	).Return(results, nil).Once()
+
+	feedChain := cciptypes.ChainSelector(1)
+	pr := priceReader{
+		lggr:         logger.Test(t),
+		chainReaders: map[cciptypes.ChainSelector]contractreader.ContractReaderFacade{feedChain: reader},
+		tokenInfo:    tokenInfo,
+		feedChain:    feedChain,
+	}
+
+	prices, err := pr.GetFeedPricesUSD(context.Background(),
+		[]cciptypes.UnknownEncodedAddress{wstEthAddr, medianAddr, staleAddr, noRateAddr})
+	require.NoError(t, err)
+	require.Equal(t, cciptypes.TokenPriceMap{
+		wstEthAddr: cciptypes.NewBigInt(new(big.Int).Mul(big.NewInt(2400), big.NewInt(1e18))),
+		// median of 1990, 2000 and 2010, the failing feed is ignored
+		medianAddr: cciptypes.NewBigInt(new(big.Int).Mul(big.NewInt(2000), big.NewInt(1e18))),
+	}, prices)
+}
+
+func TestPriceReader_SolanaFeedAccounts(t *testing.T) {
+	const (
+		solToken = cciptypes.UnknownEncodedAddress("So11111111111111111111111111111111111111112")
+		solFeed  = cciptypes.UnknownEncodedAddress("CH31Xns5z3M1cTAbKW34jcxPPciazARpijcHj9rxtemt")
+	)
+	feedChain := cciptypes.ChainSelector(sel.SOLANA_DEVNET.Selector)
+	feedAccount := cciptypes.UnknownAddress(bytes.Repeat([]byte{0xa6}, 32))
+
+	addrCodec := ccipocr3mocks.NewMockAddressCodec(t)
+	addrCodec.On("AddressStringToBytes", string(solFeed), feedChain).Return(feedAccount, nil)
+	addrCodec.On("AddressBytesToString", feedAccount, feedChain).Return(string(solFeed), nil)
+
+	tokenInfo := map[cciptypes.UnknownEncodedAddress]pluginconfig.TokenInfo{
+		solToken: {
+			AggregatorAddress: solFeed,
+			DeviationPPB:      cciptypes.NewBigInt(big.NewInt(1e5)),
+			Decimals:          9,
+		},
+	}
+	feedContract := commontypes.BoundContract{Address: string(solFeed), Name: consts.ContractNamePriceAggregator}
+
+	bcs, err := FeedChainContracts(tokenInfo, feedChain, addrCodec)
+	require.NoError(t, err)
+	require.Equal(t, []commontypes.BoundContract{feedContract}, bcs)
+
+	priceResult := commontypes.BatchReadResult{ReadName: consts.MethodNameGetLatestRoundData}
+	// 150 USD with 8 decimals
+	priceResult.SetResult(&LatestRoundData{Answer: big.NewInt(150e8)}, nil)
+	decimalsResult := commontypes.BatchReadResult{ReadName: consts.MethodNameGetDecimals}
+	decimals := uint8(8)
+	decimalsResult.SetResult(&decimals, nil)
+
+	reader := readermock.NewMockContractReaderFacade(t)
+	reader.On("BatchGetLatestValues", mock.Anything,
+		mock.MatchedBy(func(req commontypes.BatchGetLatestValuesRequest) bool {
+			_, ok := req[feedContract]
+			return ok && len(req) == 1
+		}),
+	).Return(commontypes.BatchGetLatestValuesResult{
+		feedContract: {priceResult, decimalsResult},
+	}, nil).Once()
+
+	pr := NewPriceReader(
+		logger.Test(t),
+		map[cciptypes.ChainSelector]contractreader.ContractReaderFacade{feedChain: reader},
+		tokenInfo,
+		nil,
+		feedChain,
+		addrCodec,
+	)
+	prices, err := pr.GetFeedPricesUSD(context.Background(), []cciptypes.UnknownEncodedAddress{solToken})
+	require.NoError(t, err)
+	// 150 USD per 1e9 lamports -> 150e18 * 1e18 / 1e9
+	e27 := new(big.Int).Exp(big.NewInt(10), big.NewInt(27), nil)
+	require.Equal(t, cciptypes.TokenPriceMap{
+		solToken: cciptypes.NewBigInt(new(big.Int).Mul(big.NewInt(150), e27)),
+	}, prices)
+}
@@ -0,0 +1,116 @@
+package reader
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+// RebaseTokenReader reads the on-chain state of rebase (yield-bearing) tokens on the source chains.
+type RebaseTokenReader interface {
+	// GlobalInterestRate returns the current global interest rate of the rebase token on the source chain.
+	// Rebase tokens only allow the global rate to decrease, so it's a lower bound for every per-user rate
+	// that was snapshotted by the source pool.
+	GlobalInterestRate(ctx context.Context, source cciptypes.ChainSelector) (*big.Int, error)
+}
+
+type rebaseTokenReader struct {
+	lggr            logger.Logger
+	contractReaders map[cciptypes.ChainSelector]contractreader.ContractReaderFacade
+	boundContracts  map[cciptypes.ChainSelector]types.BoundContract
+}
+
+func NewRebaseTokenReader(
+	ctx context.Context,
+	lggr logger.Logger,
+	tokensConfig map[cciptypes.ChainSelector]pluginconfig.RebaseTokenConfig,
+	contractReaders map[cciptypes.ChainSelector]contractreader.ContractReaderFacade,
+	addrCodec cciptypes.AddressCodec,
+) (RebaseTokenReader, error) {
+	boundContracts := make(map[cciptypes.ChainSelector]types.BoundContract)
+	for chainSelector, token := range tokensConfig {
+		bytesAddress, err := addrCodec.AddressStringToBytes(token.SourceTokenAddress, chainSelector)
+		if err != nil {
+			return nil, err
+		}
+
+		contract, err := bindFacadeReaderContract(
+			ctx,
+			lggr,
+			contractReaders,
+			chainSelector,
+			consts.ContractNameRebaseToken,
+			bytesAddress,
+			addrCodec,
+		)
+		if err != nil {
+			return nil, err
+		}
+		boundContracts[chainSelector] = contract
+	}
+
+	return rebaseTokenReader{
+		lggr:            lggr,
+		contractReaders: contractReaders,
+		boundContracts:  boundContracts,
+	}, nil
+}
+
+func (r rebaseTokenReader) GlobalInterestRate(
+	ctx context.Context,
+	source cciptypes.ChainSelector,
+) (*big.Int, error) {
+	bc, ok := r.boundContracts[source]
+	if !ok {
+		return nil, fmt.Errorf("no contract bound for chain %d", source)
+	}
+
+	cr, ok := r.contractReaders[source]
+	if !ok {
+		return nil, fmt.Errorf("contract reader not found for chain %d", source)
+	}
+
+	var rate *big.Int
+	err := cr.GetLatestValue(
+		ctx,
+		bc.ReadIdentifier(consts.MethodNameGetInterestRate),
+		primitives.Unconfirmed,
+		map[string]any{},
+		&rate,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get global interest rate for chain %d: %w", source, err)
+	}
+	if rate == nil {
+		return nil, fmt.Errorf("empty global interest rate for chain %d", source)
+	}
+	return rate, nil
+}
+
+type FakeRebaseTokenReader struct {
+	Rates map[cciptypes.ChainSelector]*big.Int
+}
+
+func NewFakeRebaseTokenReader(rates map[cciptypes.ChainSelector]*big.Int) FakeRebaseTokenReader {
+	return FakeRebaseTokenReader{Rates: rates}
+}
+
+func (f FakeRebaseTokenReader) GlobalInterestRate(
+	_ context.Context,
+	source cciptypes.ChainSelector,
+) (*big.Int, error) {
+	rate, ok := f.Rates[source]
+	if !ok {
+		return nil, fmt.Errorf("no global interest rate for chain %d", source)
+	}
+	return rate, nil
+}
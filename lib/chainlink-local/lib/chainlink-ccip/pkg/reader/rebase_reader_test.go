@@ -0,0 +1,120 @@
+package reader
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-ccip/internal"
+	reader "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/contractreader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+func Test_RebaseTokenReader_GlobalInterestRate(t *testing.T) {
+	poolAddress := "0x0000000000000000000000000000000000000001"
+	tokenAddress := "0x0000000000000000000000000000000000000002"
+	boundContract := types.BoundContract{
+		Address: tokenAddress,
+		Name:    consts.ContractNameRebaseToken,
+	}
+
+	tt := []struct {
+		name         string
+		chain        cciptypes.ChainSelector
+		reader       func() *reader.MockContractReaderFacade
+		expectedRate *big.Int
+		errorMessage string
+	}{
+		{
+			name:  "happy path",
+			chain: 1,
+			reader: func() *reader.MockContractReaderFacade {
+				m := reader.NewMockContractReaderFacade(t)
+				m.EXPECT().Bind(mock.Anything, []types.BoundContract{boundContract}).Return(nil)
+				m.EXPECT().GetLatestValue(
+					mock.Anything,
+					boundContract.ReadIdentifier(consts.MethodNameGetInterestRate),
+					primitives.Unconfirmed,
+					mock.Anything,
+					mock.Anything,
+				).Run(func(
+					_ context.Context,
+					_ string,
+					_ primitives.ConfidenceLevel,
+					_ any,
+					returnVal any,
+				) {
+					*returnVal.(**big.Int) = big.NewInt(5e10)
+				}).Return(nil)
+				return m
+			},
+			expectedRate: big.NewInt(5e10),
+		},
+		{
+			name:  "contract reader error",
+			chain: 1,
+			reader: func() *reader.MockContractReaderFacade {
+				m := reader.NewMockContractReaderFacade(t)
+				m.EXPECT().Bind(mock.Anything, mock.Anything).Return(nil)
+				m.EXPECT().GetLatestValue(
+					mock.Anything,
+					mock.Anything,
+					mock.Anything,
+					mock.Anything,
+					mock.Anything,
+				).Return(errors.New("rpc error"))
+				return m
+			},
+			errorMessage: "get global interest rate for chain 1: rpc error",
+		},
+		{
+			name:  "chain not configured",
+			chain: 2,
+			reader: func() *reader.MockContractReaderFacade {
+				m := reader.NewMockContractReaderFacade(t)
+				m.EXPECT().Bind(mock.Anything, mock.Anything).Return(nil)
+				return m
+			},
+			errorMessage: "no contract bound for chain 2",
+		},
+	}
+
+	mockAddrCodec := internal.NewMockAddressCodecHex(t)
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := tests.Context(t)
+			readers := map[cciptypes.ChainSelector]contractreader.ContractReaderFacade{
+				1: tc.reader(),
+			}
+			tokensConfig := map[cciptypes.ChainSelector]pluginconfig.RebaseTokenConfig{
+				1: {
+					SourcePoolAddress:  poolAddress,
+					SourceTokenAddress: tokenAddress,
+				},
+			}
+
+			r, err := NewRebaseTokenReader(ctx, logger.Test(t), tokensConfig, readers, mockAddrCodec)
+			require.NoError(t, err)
+
+			rate, err := r.GlobalInterestRate(ctx, tc.chain)
+			if tc.errorMessage != "" {
+				require.ErrorContains(t, err, tc.errorMessage)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedRate, rate)
+			}
+		})
+	}
+}
@@ -81,6 +81,27 @@ func NewUSDCMessageReader(
 	contractReaders map[cciptypes.ChainSelector]contractreader.ContractReaderFacade,
 	addrCodec cciptypes.AddressCodec,
 ) (USDCMessageReader, error) {
+	boundContracts, err := bindMessageTransmitters(ctx, lggr, tokensConfig, contractReaders, addrCodec)
+	if err != nil {
+		return nil, err
+	}
+
+	return usdcMessageReader{
+		lggr:            lggr,
+		contractReaders: contractReaders,
+		cctpDestDomain:  AllAvailableDomains(),
+		boundContracts:  boundContracts,
+	}, nil
+}
+
+// bindMessageTransmitters binds the CCTP MessageTransmitter of every configured source chain to its contract reader.
+func bindMessageTransmitters(
+	ctx context.Context,
+	lggr logger.Logger,
+	tokensConfig map[cciptypes.ChainSelector]pluginconfig.USDCCCTPTokenConfig,
+	contractReaders map[cciptypes.ChainSelector]contractreader.ContractReaderFacade,
+	addrCodec cciptypes.AddressCodec,
+) (map[cciptypes.ChainSelector]types.BoundContract, error) {
 	boundContracts := make(map[cciptypes.ChainSelector]types.BoundContract)
 	for chainSelector, token := range tokensConfig {
 
@@ -103,13 +124,7 @@ func NewUSDCMessageReader(
 		}
 		boundContracts[chainSelector] = contract
 	}
-
-	return usdcMessageReader{
-		lggr:            lggr,
-		contractReaders: contractReaders,
-		cctpDestDomain:  AllAvailableDomains(),
-		boundContracts:  boundContracts,
-	}, nil
+	return boundContracts, nil
 }
 
 // FIXME It adds test selectors to the domains
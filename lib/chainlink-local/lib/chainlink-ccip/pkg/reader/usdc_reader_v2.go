@@ -0,0 +1,412 @@
+package reader
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/hashutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+// USDCMessageReaderV2 reads the `MessageSent(bytes)` events emitted by the CCTP v2 MessageTransmitter.
+type USDCMessageReaderV2 interface {
+	// MessagesByTokenID returns the emitted message of every transfer. Transfers without a matching event
+	// on the source chain are not included in the result.
+	MessagesByTokenID(ctx context.Context,
+		source cciptypes.ChainSelector,
+		transfers map[MessageTokenID]CCTPTransferV2,
+	) (map[MessageTokenID]cciptypes.Bytes, error)
+}
+
+// CCTPTransferV2 identifies a single CCTP v2 burn, the source transaction that emitted it and the deposit hash
+// shipped by the USDC token pool in the SourceTokenDataPayloadV2.
+type CCTPTransferV2 struct {
+	TxHash      string
+	DepositHash [32]byte
+}
+
+const (
+	CCTPMessageVersionV2 = uint32(1)
+
+	// CCTPFinalityThresholdFast is the minimum finality threshold of CCTP v2 Fast Transfers (confirmed blocks)
+	CCTPFinalityThresholdFast = uint32(1000)
+	// CCTPFinalityThresholdStandard is the finality threshold of CCTP v2 Standard Transfers (finalized blocks)
+	CCTPFinalityThresholdStandard = uint32(2000)
+
+	// Message header layout, see src/messages/v2/MessageV2.sol in circlefin/evm-cctp-contracts
+	cctpV2VersionIndex                   = 0
+	cctpV2SourceDomainIndex              = 4
+	cctpV2DestinationDomainIndex         = 8
+	cctpV2NonceIndex                     = 12
+	cctpV2SenderIndex                    = 44
+	cctpV2RecipientIndex                 = 76
+	cctpV2DestinationCallerIndex         = 108
+	cctpV2MinFinalityThresholdIndex      = 140
+	cctpV2FinalityThresholdExecutedIndex = 144
+	cctpV2MessageBodyIndex               = 148
+
+	// Burn message body layout, see src/messages/v2/BurnMessageV2.sol in circlefin/evm-cctp-contracts
+	cctpV2BurnTokenIndex       = 4
+	cctpV2MintRecipientIndex   = 36
+	cctpV2AmountIndex          = 68
+	cctpV2MessageSenderIndex   = 100
+	cctpV2MaxFeeIndex          = 132
+	cctpV2FeeExecutedIndex     = 164
+	cctpV2ExpirationBlockIndex = 196
+	cctpV2HookDataIndex        = 228
+)
+
+// CCTPMessageV2 represents the `MessageSent(bytes message)` payload emitted by the CCTP v2 MessageTransmitter
+// together with the decoded BurnMessageV2 body. In contrast to v1, nonce is not known when the event is emitted,
+// it's assigned by the attestation service. Therefore, v2 messages can't be matched by nonce and are matched
+// by the deposit hash instead (see SourceTokenDataPayloadV2).
+type CCTPMessageV2 struct {
+	Version                   uint32
+	SourceDomain              uint32
+	DestinationDomain         uint32
+	Nonce                     [32]byte
+	Sender                    [32]byte
+	Recipient                 [32]byte
+	DestinationCaller         [32]byte
+	MinFinalityThreshold      uint32
+	FinalityThresholdExecuted uint32
+
+	BurnToken       [32]byte
+	MintRecipient   [32]byte
+	Amount          *big.Int
+	MessageSender   [32]byte
+	MaxFee          *big.Int
+	FeeExecuted     *big.Int
+	ExpirationBlock *big.Int
+	HookData        []byte
+}
+
+// DecodeCCTPMessageV2 decodes the packed CCTP v2 message with the BurnMessageV2 body.
+func DecodeCCTPMessageV2(message cciptypes.Bytes) (*CCTPMessageV2, error) {
+	if len(message) < cctpV2MessageBodyIndex+cctpV2HookDataIndex {
+		return nil, fmt.Errorf("CCTP v2 message is too short, expected at least %d bytes, got %d",
+			cctpV2MessageBodyIndex+cctpV2HookDataIndex, len(message))
+	}
+
+	m := &CCTPMessageV2{
+		Version:                   binary.BigEndian.Uint32(message[cctpV2VersionIndex:]),
+		SourceDomain:              binary.BigEndian.Uint32(message[cctpV2SourceDomainIndex:]),
+		DestinationDomain:         binary.BigEndian.Uint32(message[cctpV2DestinationDomainIndex:]),
+		Nonce:                     [32]byte(message[cctpV2NonceIndex:cctpV2SenderIndex]),
+		Sender:                    [32]byte(message[cctpV2SenderIndex:cctpV2RecipientIndex]),
+		Recipient:                 [32]byte(message[cctpV2RecipientIndex:cctpV2DestinationCallerIndex]),
+		DestinationCaller:         [32]byte(message[cctpV2DestinationCallerIndex:cctpV2MinFinalityThresholdIndex]),
+		MinFinalityThreshold:      binary.BigEndian.Uint32(message[cctpV2MinFinalityThresholdIndex:]),
+		FinalityThresholdExecuted: binary.BigEndian.Uint32(message[cctpV2FinalityThresholdExecutedIndex:]),
+	}
+	if m.Version != CCTPMessageVersionV2 {
+		return nil, fmt.Errorf("unsupported CCTP message version %d, expected %d", m.Version, CCTPMessageVersionV2)
+	}
+
+	body := message[cctpV2MessageBodyIndex:]
+	m.BurnToken = [32]byte(body[cctpV2BurnTokenIndex:cctpV2MintRecipientIndex])
+	m.MintRecipient = [32]byte(body[cctpV2MintRecipientIndex:cctpV2AmountIndex])
+	m.Amount = new(big.Int).SetBytes(body[cctpV2AmountIndex:cctpV2MessageSenderIndex])
+	m.MessageSender = [32]byte(body[cctpV2MessageSenderIndex:cctpV2MaxFeeIndex])
+	m.MaxFee = new(big.Int).SetBytes(body[cctpV2MaxFeeIndex:cctpV2FeeExecutedIndex])
+	m.FeeExecuted = new(big.Int).SetBytes(body[cctpV2FeeExecutedIndex:cctpV2ExpirationBlockIndex])
+	m.ExpirationBlock = new(big.Int).SetBytes(body[cctpV2ExpirationBlockIndex:cctpV2HookDataIndex])
+	m.HookData = body[cctpV2HookDataIndex:]
+	return m, nil
+}
+
+// ToBytes packs the message back to the format emitted by the MessageTransmitter.
+func (m CCTPMessageV2) ToBytes() cciptypes.Bytes {
+	header := make([]byte, cctpV2MessageBodyIndex)
+	binary.BigEndian.PutUint32(header[cctpV2VersionIndex:], m.Version)
+	binary.BigEndian.PutUint32(header[cctpV2SourceDomainIndex:], m.SourceDomain)
+	binary.BigEndian.PutUint32(header[cctpV2DestinationDomainIndex:], m.DestinationDomain)
+	copy(header[cctpV2NonceIndex:], m.Nonce[:])
+	copy(header[cctpV2SenderIndex:], m.Sender[:])
+	copy(header[cctpV2RecipientIndex:], m.Recipient[:])
+	copy(header[cctpV2DestinationCallerIndex:], m.DestinationCaller[:])
+	binary.BigEndian.PutUint32(header[cctpV2MinFinalityThresholdIndex:], m.MinFinalityThreshold)
+	binary.BigEndian.PutUint32(header[cctpV2FinalityThresholdExecutedIndex:], m.FinalityThresholdExecuted)
+
+	body := make([]byte, cctpV2BurnTokenIndex, cctpV2HookDataIndex+len(m.HookData))
+	// burn message body version
+	binary.BigEndian.PutUint32(body, CCTPMessageVersionV2)
+	body = append(body, m.BurnToken[:]...)
+	body = append(body, m.MintRecipient[:]...)
+	body = append(body, bigIntWord(m.Amount)...)
+	body = append(body, m.MessageSender[:]...)
+	body = append(body, bigIntWord(m.MaxFee)...)
+	body = append(body, bigIntWord(m.FeeExecuted)...)
+	body = append(body, bigIntWord(m.ExpirationBlock)...)
+	body = append(body, m.HookData...)
+	return append(header, body...)
+}
+
+// SentMessage returns the message as emitted in the `MessageSent(bytes)` event. Fields assigned by the
+// attestation service (nonce, finalityThresholdExecuted, feeExecuted and expirationBlock) are zeroed,
+// so the message returned by the attestation API can be compared with the source chain event.
+func (m CCTPMessageV2) SentMessage() cciptypes.Bytes {
+	m.Nonce = [32]byte{}
+	m.FinalityThresholdExecuted = 0
+	m.FeeExecuted = nil
+	m.ExpirationBlock = nil
+	return m.ToBytes()
+}
+
+// DepositHash computes the identifier of the burn that is shipped by the USDC token pool in the
+// SourceTokenDataPayloadV2. It only uses fields known when depositForBurn is called, so it's stable
+// between the emitted event and the message returned by the attestation API.
+//
+//	keccak256(abi.encode(sourceDomain, amount, destinationDomain, mintRecipient, burnToken,
+//	  destinationCaller, maxFee, minFinalityThreshold))
+func (m CCTPMessageV2) DepositHash() [32]byte {
+	var buf []byte
+	buf = append(buf, uint32Word(m.SourceDomain)...)
+	buf = append(buf, bigIntWord(m.Amount)...)
+	buf = append(buf, uint32Word(m.DestinationDomain)...)
+	buf = append(buf, m.MintRecipient[:]...)
+	buf = append(buf, m.BurnToken[:]...)
+	buf = append(buf, m.DestinationCaller[:]...)
+	buf = append(buf, bigIntWord(m.MaxFee)...)
+	buf = append(buf, uint32Word(m.MinFinalityThreshold)...)
+	return hashutil.NewKeccak().Hash(buf)
+}
+
+// IsFastTransfer returns true if the burn was requested with a finality threshold lower than the standard one.
+func (m CCTPMessageV2) IsFastTransfer() bool {
+	return m.MinFinalityThreshold < CCTPFinalityThresholdStandard
+}
+
+// SourceTokenDataPayloadV2 extracts the source domain and the deposit hash from the USDC token pool's source data
+// for CCTP v2 transfers.
+//
+//	struct SourceTokenDataPayloadV2 {
+//		uint32 sourceDomain;
+//		bytes32 depositHash;
+//	}
+//	return Pool.LockOrBurnOutV1({
+//	   destTokenAddress: getRemoteToken(lockOrBurnIn.remoteChainSelector),
+//	   destPoolData: abi.encode(SourceTokenDataPayloadV2({sourceDomain: i_localDomainIdentifier, depositHash: hash}))
+//	 });
+//
+// Implementation relies on the EVM internals, so entire struct is EVM-specific and can't be reused for other chains
+type SourceTokenDataPayloadV2 struct {
+	SourceDomain uint32
+	DepositHash  [32]byte
+}
+
+func NewSourceTokenDataPayloadV2(sourceDomain uint32, depositHash [32]byte) *SourceTokenDataPayloadV2 {
+	return &SourceTokenDataPayloadV2{
+		SourceDomain: sourceDomain,
+		DepositHash:  depositHash,
+	}
+}
+
+func NewSourceTokenDataPayloadV2FromBytes(extraData cciptypes.Bytes) (*SourceTokenDataPayloadV2, error) {
+	if len(extraData) < 64 {
+		return nil, fmt.Errorf("extraData is too short, expected at least 64 bytes")
+	}
+
+	return &SourceTokenDataPayloadV2{
+		// Extract the sourceDomain (first 4 bytes), padded to 32 bytes
+		SourceDomain: binary.BigEndian.Uint32(extraData[28:32]),
+		DepositHash:  [32]byte(extraData[32:64]),
+	}, nil
+}
+
+func (s SourceTokenDataPayloadV2) ToBytes() cciptypes.Bytes {
+	return append(uint32Word(s.SourceDomain), s.DepositHash[:]...)
+}
+
+func uint32Word(v uint32) []byte {
+	word := make([]byte, 32)
+	binary.BigEndian.PutUint32(word[28:32], v)
+	return word
+}
+
+func bigIntWord(v *big.Int) []byte {
+	word := make([]byte, 32)
+	if v != nil {
+		v.FillBytes(word)
+	}
+	return word
+}
+
+type usdcMessageReaderV2 struct {
+	lggr            logger.Logger
+	contractReaders map[cciptypes.ChainSelector]contractreader.ContractReaderFacade
+	boundContracts  map[cciptypes.ChainSelector]types.BoundContract
+}
+
+func NewUSDCMessageReaderV2(
+	ctx context.Context,
+	lggr logger.Logger,
+	tokensConfig map[cciptypes.ChainSelector]pluginconfig.USDCCCTPTokenConfig,
+	contractReaders map[cciptypes.ChainSelector]contractreader.ContractReaderFacade,
+	addrCodec cciptypes.AddressCodec,
+) (USDCMessageReaderV2, error) {
+	boundContracts, err := bindMessageTransmitters(ctx, lggr, tokensConfig, contractReaders, addrCodec)
+	if err != nil {
+		return nil, err
+	}
+
+	return usdcMessageReaderV2{
+		lggr:            lggr,
+		contractReaders: contractReaders,
+		boundContracts:  boundContracts,
+	}, nil
+}
+
+func (u usdcMessageReaderV2) MessagesByTokenID(
+	ctx context.Context,
+	source cciptypes.ChainSelector,
+	transfers map[MessageTokenID]CCTPTransferV2,
+) (map[MessageTokenID]cciptypes.Bytes, error) {
+	out := make(map[MessageTokenID]cciptypes.Bytes)
+	if len(transfers) == 0 {
+		return out, nil
+	}
+
+	cr, ok := u.boundContracts[source]
+	if !ok {
+		return nil, fmt.Errorf("no contract bound for chain %d", source)
+	}
+
+	// Tokens are processed in order, so that identical burns within a single transaction
+	// are deterministically assigned to distinct MessageSent events.
+	tokenIDs := make([]MessageTokenID, 0, len(transfers))
+	for tokenID := range transfers {
+		tokenIDs = append(tokenIDs, tokenID)
+	}
+	sort.Slice(tokenIDs, func(i, j int) bool {
+		if tokenIDs[i].SeqNr != tokenIDs[j].SeqNr {
+			return tokenIDs[i].SeqNr < tokenIDs[j].SeqNr
+		}
+		return tokenIDs[i].Index < tokenIDs[j].Index
+	})
+
+	eventsByTx := make(map[string][]*sentEventV2)
+	for _, tokenID := range tokenIDs {
+		transfer := transfers[tokenID]
+		events, ok1 := eventsByTx[transfer.TxHash]
+		if !ok1 {
+			var err error
+			events, err = u.messageSentEvents(ctx, cr, source, transfer.TxHash)
+			if err != nil {
+				return nil, err
+			}
+			eventsByTx[transfer.TxHash] = events
+		}
+
+		found := false
+		for _, event := range events {
+			if event.used || event.depositHash != transfer.DepositHash {
+				continue
+			}
+			event.used = true
+			out[tokenID] = event.message
+			found = true
+			break
+		}
+		if !found {
+			u.lggr.Warnw("Message not found in the source chain",
+				"seqNr", tokenID.SeqNr,
+				"tokenIndex", tokenID.Index,
+				"chainSelector", source,
+				"txHash", transfer.TxHash,
+			)
+		}
+	}
+	return out, nil
+}
+
+type sentEventV2 struct {
+	message     cciptypes.Bytes
+	depositHash [32]byte
+	used        bool
+}
+
+// messageSentEvents returns the CCTP v2 MessageSent events emitted by the source transaction. Fast Transfers
+// are attested before the source chain finalizes, therefore unfinalized events are read as well.
+func (u usdcMessageReaderV2) messageSentEvents(
+	ctx context.Context,
+	cr types.BoundContract,
+	source cciptypes.ChainSelector,
+	txHash string,
+) ([]*sentEventV2, error) {
+	keyFilter, err := query.Where(
+		consts.EventNameCCTPMessageSent,
+		query.Confidence(primitives.Unconfirmed),
+		query.TxHash(txHash),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := u.contractReaders[source].QueryKey(
+		ctx,
+		cr,
+		keyFilter,
+		query.NewLimitAndSort(
+			query.Limit{},
+			query.NewSortBySequence(query.Asc),
+		),
+		&MessageSentEvent{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error querying contract reader for chain %d: %w", source, err)
+	}
+
+	events := make([]*sentEventV2, 0, len(iter))
+	for _, item := range iter {
+		event, ok := item.Data.(*MessageSentEvent)
+		if !ok {
+			return nil, fmt.Errorf("failed to cast %v to Message", item.Data)
+		}
+		message, err1 := DecodeCCTPMessageV2(event.Arg0)
+		if err1 != nil {
+			// MessageTransmitter emits messages of all versions, the v1 ones are not relevant here
+			u.lggr.Debugw("Ignoring MessageSent event",
+				"chainSelector", source,
+				"txHash", txHash,
+				"err", err1,
+			)
+			continue
+		}
+		events = append(events, &sentEventV2{message: event.Arg0, depositHash: message.DepositHash()})
+	}
+	return events, nil
+}
+
+type FakeUSDCMessageReaderV2 struct {
+	Messages map[MessageTokenID]cciptypes.Bytes
+}
+
+func NewFakeUSDCMessageReaderV2(messages map[MessageTokenID]cciptypes.Bytes) FakeUSDCMessageReaderV2 {
+	return FakeUSDCMessageReaderV2{Messages: messages}
+}
+
+func (f FakeUSDCMessageReaderV2) MessagesByTokenID(
+	_ context.Context,
+	_ cciptypes.ChainSelector,
+	transfers map[MessageTokenID]CCTPTransferV2,
+) (map[MessageTokenID]cciptypes.Bytes, error) {
+	outcome := make(map[MessageTokenID]cciptypes.Bytes)
+	for tokenID := range transfers {
+		if message, ok := f.Messages[tokenID]; ok {
+			outcome[tokenID] = message
+		}
+	}
+	return outcome, nil
+}
@@ -0,0 +1,244 @@
+package reader
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-ccip/internal"
+	reader "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/contractreader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+func Test_CCTPMessageV2_Decode(t *testing.T) {
+	msg := CCTPMessageV2{
+		Version:                   CCTPMessageVersionV2,
+		SourceDomain:              0,
+		DestinationDomain:         3,
+		Nonce:                     [32]byte(internal.RandBytes()),
+		Sender:                    [32]byte(internal.RandBytes()),
+		Recipient:                 [32]byte(internal.RandBytes()),
+		DestinationCaller:         [32]byte(internal.RandBytes()),
+		MinFinalityThreshold:      CCTPFinalityThresholdFast,
+		FinalityThresholdExecuted: CCTPFinalityThresholdStandard,
+		BurnToken:                 [32]byte(internal.RandBytes()),
+		MintRecipient:             [32]byte(internal.RandBytes()),
+		Amount:                    big.NewInt(1_000_000),
+		MessageSender:             [32]byte(internal.RandBytes()),
+		MaxFee:                    big.NewInt(100),
+		FeeExecuted:               big.NewInt(10),
+		ExpirationBlock:           big.NewInt(21_000_000),
+		HookData:                  []byte{0x1, 0x2},
+	}
+
+	decoded, err := DecodeCCTPMessageV2(msg.ToBytes())
+	require.NoError(t, err)
+	require.Equal(t, msg, *decoded)
+	require.True(t, decoded.IsFastTransfer())
+
+	// fields assigned by the attestation service don't affect the deposit hash
+	attested := msg
+	attested.Nonce = [32]byte{}
+	attested.FinalityThresholdExecuted = 0
+	attested.FeeExecuted = big.NewInt(0)
+	require.Equal(t, msg.DepositHash(), attested.DepositHash())
+
+	// emitted message doesn't contain fields assigned by the attestation service
+	sent, err := DecodeCCTPMessageV2(msg.SentMessage())
+	require.NoError(t, err)
+	require.Equal(t, [32]byte{}, sent.Nonce)
+	require.Zero(t, sent.FinalityThresholdExecuted)
+	require.Zero(t, sent.FeeExecuted.Sign())
+	require.Zero(t, sent.ExpirationBlock.Sign())
+	require.Equal(t, msg.HookData, sent.HookData)
+	require.Equal(t, msg.DepositHash(), sent.DepositHash())
+
+	other := msg
+	other.Amount = big.NewInt(1)
+	require.NotEqual(t, msg.DepositHash(), other.DepositHash())
+
+	t.Run("unsupported version", func(t *testing.T) {
+		v1 := msg
+		v1.Version = 0
+		_, err := DecodeCCTPMessageV2(v1.ToBytes())
+		require.ErrorContains(t, err, "unsupported CCTP message version")
+	})
+
+	t.Run("too short", func(t *testing.T) {
+		_, err := DecodeCCTPMessageV2(msg.ToBytes()[:200])
+		require.ErrorContains(t, err, "too short")
+	})
+}
+
+func Test_SourceTokenDataPayloadV2(t *testing.T) {
+	payload := NewSourceTokenDataPayloadV2(6, [32]byte(internal.RandBytes()))
+
+	bytes := payload.ToBytes()
+	require.Len(t, bytes, 64)
+
+	decoded, err := NewSourceTokenDataPayloadV2FromBytes(bytes)
+	require.NoError(t, err)
+	require.Equal(t, payload, decoded)
+
+	_, err = NewSourceTokenDataPayloadV2FromBytes(cciptypes.Bytes(bytes[:32]))
+	require.Error(t, err)
+}
+
+func Test_txHashFromCursor(t *testing.T) {
+	txHash := internal.RandBytes().String()
+
+	tests := []struct {
+		name   string
+		cursor string
+		want   string
+	}{
+		{
+			name:   "evm cursor",
+			cursor: "120-4-" + txHash,
+			want:   txHash,
+		},
+		{
+			name:   "empty cursor",
+			cursor: "",
+		},
+		{
+			name:   "cursor without tx hash",
+			cursor: "120-4",
+		},
+		{
+			name:   "malformed tx hash",
+			cursor: "120-4-0xabc",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, txHashFromCursor(tt.cursor))
+		})
+	}
+}
+
+func newSentMessageV2(amount int64) CCTPMessageV2 {
+	return CCTPMessageV2{
+		Version:              CCTPMessageVersionV2,
+		DestinationDomain:    3,
+		BurnToken:            [32]byte(internal.RandBytes()),
+		MintRecipient:        [32]byte(internal.RandBytes()),
+		MinFinalityThreshold: CCTPFinalityThresholdStandard,
+		Amount:               big.NewInt(amount),
+		MaxFee:               big.NewInt(0),
+	}
+}
+
+func Test_USDCMessageReaderV2_MessagesByTokenID(t *testing.T) {
+	ctx := tests.Context(t)
+	chain := cciptypes.ChainSelector(1)
+	tx1 := internal.RandBytes().String()
+	tx2 := internal.RandBytes().String()
+	faultyTx := internal.RandBytes().String()
+
+	m1 := newSentMessageV2(100)
+	m2 := newSentMessageV2(200)
+	// m3 is an identical burn within the same transaction
+	m3 := m2
+	m3.HookData = []byte{0x1}
+	m4 := newSentMessageV2(400)
+	v1Message := newSentMessageV2(500)
+	v1Message.Version = CCTPMessageVersion
+
+	eventsByTx := map[string][]CCTPMessageV2{
+		tx1: {m1, m2, m3, v1Message},
+		tx2: {m4},
+	}
+
+	byTxHash := func(txHash string) interface{} {
+		return mock.MatchedBy(func(filter query.KeyFilter) bool {
+			for _, expr := range filter.Expressions {
+				if p, ok := expr.Primitive.(*primitives.TxHash); ok {
+					return p.TxHash == txHash
+				}
+			}
+			return false
+		})
+	}
+
+	contractReader := reader.NewMockContractReaderFacade(t)
+	contractReader.EXPECT().Bind(mock.Anything, mock.Anything).Return(nil)
+	for txHash, messages := range eventsByTx {
+		sequences := make([]types.Sequence, 0, len(messages))
+		for _, m := range messages {
+			sequences = append(sequences, types.Sequence{Data: &MessageSentEvent{Arg0: m.ToBytes()}})
+		}
+		contractReader.EXPECT().
+			QueryKey(mock.Anything, mock.Anything, byTxHash(txHash), mock.Anything, mock.Anything).
+			Return(sequences, nil).
+			Once()
+	}
+	contractReader.EXPECT().
+		QueryKey(mock.Anything, mock.Anything, byTxHash(faultyTx), mock.Anything, mock.Anything).
+		Return(nil, errors.New("error")).
+		Maybe()
+
+	usdcReader, err := NewUSDCMessageReaderV2(
+		ctx,
+		logger.Test(t),
+		map[cciptypes.ChainSelector]pluginconfig.USDCCCTPTokenConfig{
+			chain: {
+				SourcePoolAddress:            internal.RandBytes().String()[:42],
+				SourceMessageTransmitterAddr: internal.RandBytes().String()[:42],
+			},
+		},
+		map[cciptypes.ChainSelector]contractreader.ContractReaderFacade{chain: contractReader},
+		internal.NewMockAddressCodecHex(t),
+	)
+	require.NoError(t, err)
+
+	t.Run("messages matched by deposit hash", func(t *testing.T) {
+		messages, err1 := usdcReader.MessagesByTokenID(ctx, chain, map[MessageTokenID]CCTPTransferV2{
+			NewMessageTokenID(1, 0): {TxHash: tx1, DepositHash: m1.DepositHash()},
+			NewMessageTokenID(2, 0): {TxHash: tx1, DepositHash: m2.DepositHash()},
+			NewMessageTokenID(2, 1): {TxHash: tx1, DepositHash: m2.DepositHash()},
+			NewMessageTokenID(3, 0): {TxHash: tx1, DepositHash: m2.DepositHash()},
+			NewMessageTokenID(4, 0): {TxHash: tx2, DepositHash: m4.DepositHash()},
+			NewMessageTokenID(5, 0): {TxHash: tx2, DepositHash: v1Message.DepositHash()},
+		})
+		require.NoError(t, err1)
+		require.Equal(t, map[MessageTokenID]cciptypes.Bytes{
+			NewMessageTokenID(1, 0): m1.ToBytes(),
+			NewMessageTokenID(2, 0): m2.ToBytes(),
+			NewMessageTokenID(2, 1): m3.ToBytes(),
+			NewMessageTokenID(4, 0): m4.ToBytes(),
+		}, messages)
+	})
+
+	t.Run("empty transfers", func(t *testing.T) {
+		messages, err1 := usdcReader.MessagesByTokenID(ctx, chain, map[MessageTokenID]CCTPTransferV2{})
+		require.NoError(t, err1)
+		require.Empty(t, messages)
+	})
+
+	t.Run("unknown chain", func(t *testing.T) {
+		_, err1 := usdcReader.MessagesByTokenID(ctx, chain+1, map[MessageTokenID]CCTPTransferV2{
+			NewMessageTokenID(1, 0): {TxHash: tx1, DepositHash: m1.DepositHash()},
+		})
+		require.ErrorContains(t, err1, "no contract bound for chain 2")
+	})
+
+	t.Run("contract reader error", func(t *testing.T) {
+		_, err1 := usdcReader.MessagesByTokenID(ctx, chain, map[MessageTokenID]CCTPTransferV2{
+			NewMessageTokenID(1, 0): {TxHash: faultyTx, DepositHash: m1.DepositHash()},
+		})
+		require.ErrorContains(t, err1, "error querying contract reader for chain 1")
+	})
+}
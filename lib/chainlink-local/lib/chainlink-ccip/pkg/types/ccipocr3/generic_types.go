@@ -234,6 +234,12 @@ type RampMessageHeader struct {
 	// OnRamp is the address of the onramp that sent the message.
 	// NOTE: This is populated by the ccip reader. Not emitted explicitly onchain.
 	OnRamp UnknownAddress `json:"onRamp"`
+
+	// TxHash is the hash of the source chain transaction that emitted the message, encoded according to
+	// the source family native encoding scheme.
+	// NOTE: This is populated by the ccip reader when available. It's not part of the message hash and
+	// it's not guaranteed to survive the OCR encoding, so it must be used only for local processing.
+	TxHash string `json:"txHash,omitempty"`
 }
 
 // RampTokenAmount represents the family-agnostic token amounts used for both OnRamp & OffRamp messages.
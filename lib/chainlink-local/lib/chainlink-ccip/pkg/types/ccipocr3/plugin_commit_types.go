@@ -79,6 +79,35 @@ type CommitReportInfo struct {
 	RemoteF     uint64            `json:"remoteF"`
 	MerkleRoots []MerkleRootChain `json:"merkleRoots"`
 	PriceUpdates
+	// Split is set if the report is one of the reports an outcome was split into.
+	Split *CommitReportSplit `json:"split,omitempty"`
+}
+
+// CommitReportKind is the content of a report that is part of a split outcome.
+type CommitReportKind string
+
+const (
+	// CommitReportKindBlessedRoots reports contain the blessed merkle roots and their RMN signatures.
+	CommitReportKindBlessedRoots CommitReportKind = "blessedRoots"
+	// CommitReportKindUnblessedRoots reports contain unblessed merkle roots only.
+	CommitReportKindUnblessedRoots CommitReportKind = "unblessedRoots"
+	// CommitReportKindPrices reports contain price updates only.
+	CommitReportKindPrices CommitReportKind = "prices"
+)
+
+// CommitReportSplit describes a report that is part of a split outcome, so that it can be validated
+// independently of the other reports of the round.
+type CommitReportSplit struct {
+	Kind CommitReportKind `json:"kind"`
+	// Index is the position of the report in the sequence of reports of the round.
+	Index int `json:"index"`
+	// Count is the number of reports of the round.
+	Count int `json:"count"`
+	// EstimatedGas is the estimated destination gas of the report.
+	EstimatedGas uint64 `json:"estimatedGas"`
+	// AfterRoots is set on prices reports, it is the last sequence number of the merkle roots of the round
+	// for each source chain. The report is only transmitted once the roots are committed on the destination.
+	AfterRoots map[ChainSelector]SeqNum `json:"afterRoots,omitempty"`
 }
 
 func (cri CommitReportInfo) Encode() ([]byte, error) {
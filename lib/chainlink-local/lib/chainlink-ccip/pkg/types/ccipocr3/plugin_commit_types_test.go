@@ -201,6 +201,45 @@ func TestDecodeCommitReportInfo(t *testing.T) {
 		require.Equal(t, validReport, decoded)
 	}
 
+	// Split report
+	{
+		splitReport := CommitReportInfo{
+			MerkleRoots: []MerkleRootChain{},
+			Split: &CommitReportSplit{
+				Kind:         CommitReportKindUnblessedRoots,
+				Index:        1,
+				Count:        3,
+				EstimatedGas: 100_000,
+			},
+		}
+		encoded, err := splitReport.Encode()
+		require.NoError(t, err)
+
+		decoded, err := DecodeCommitReportInfo(encoded)
+		require.NoError(t, err)
+		require.Equal(t, splitReport, decoded)
+	}
+
+	// Split prices report
+	{
+		splitReport := CommitReportInfo{
+			MerkleRoots: []MerkleRootChain{},
+			Split: &CommitReportSplit{
+				Kind:         CommitReportKindPrices,
+				Index:        2,
+				Count:        3,
+				EstimatedGas: 50_000,
+				AfterRoots:   map[ChainSelector]SeqNum{1: 10, 2: 20},
+			},
+		}
+		encoded, err := splitReport.Encode()
+		require.NoError(t, err)
+
+		decoded, err := DecodeCommitReportInfo(encoded)
+		require.NoError(t, err)
+		require.Equal(t, splitReport, decoded)
+	}
+
 	// Non-object input
 	{
 		data := append([]byte{1}, []byte(`["unexpected array"]`)...)
@@ -1,15 +1,15 @@
 package pluginconfig
 
 import (
-	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
-	"strings"
+	"slices"
 	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/merklemulti"
+	"github.com/smartcontractkit/libocr/commontypes"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 
@@ -30,10 +30,19 @@ const (
 	defaultAsyncObserverSyncTimeout           = 10 * time.Second
 )
 
+// LatestOCRCodecVersion is the latest wire format version of the OCR types, see pkg/ocrtypecodec/v2.
+const LatestOCRCodecVersion uint8 = 3
+
 type TokenInfo struct {
-	// AggregatorAddress is the address of the price feed TOKEN/USD aggregator on the feed chain.
+	// AggregatorAddress is the address of the price feed TOKEN/USD aggregator on the feed chain, encoded in
+	// the address format of the feed chain family (e.g. the base58 feed account on Solana).
+	// It must be empty if DerivedPrice is set.
 	AggregatorAddress cciptypes.UnknownEncodedAddress `json:"aggregatorAddress"`
 
+	// DerivedPrice describes how to compute the price of tokens without a direct TOKEN/USD feed,
+	// e.g. rebase or wrapped tokens. It must be nil if AggregatorAddress is set.
+	DerivedPrice *DerivedPriceSource `json:"derivedPrice,omitempty"`
+
 	// DeviationPPB is the deviation in parts per billion that the price feed is allowed to deviate
 	// from the last written price on-chain before we write a new price.
 	DeviationPPB cciptypes.BigInt `json:"deviationPPB"`
@@ -42,18 +51,58 @@ type TokenInfo struct {
 	Decimals uint8 `json:"decimals"`
 }
 
-func (a TokenInfo) Validate() error {
-	if a.AggregatorAddress == "" {
-		return errors.New("aggregatorAddress not set")
+// DerivedPriceSource is a token price computed from one or more price feeds on the feed chain:
+//
+//	price = median(AggregatorAddresses) * exchangeRate / 10^ExchangeRate.Decimals
+//
+// e.g. the price of a wrapped token is the price of the underlying token multiplied by the on-chain exchange rate
+// of the wrapper, and the price of a token with several feeds is the median of them.
+type DerivedPriceSource struct {
+	// AggregatorAddresses are the addresses of the USD price feed aggregators on the feed chain. The price is
+	// derived only if more than half of the feeds return a valid price.
+	AggregatorAddresses []cciptypes.UnknownEncodedAddress `json:"aggregatorAddresses"`
+
+	// ExchangeRate is the optional on-chain rate the median feed price is multiplied by.
+	ExchangeRate *ExchangeRateSource `json:"exchangeRate,omitempty"`
+}
+
+// ExchangeRateSource is a contract on the feed chain returning the amount of the feed token (e.g. stETH)
+// per one full token (e.g. wstETH). It's read through the contract reader as the ExchangeRateProvider contract.
+type ExchangeRateSource struct {
+	// ContractAddress is the address of the exchange rate contract on the feed chain.
+	ContractAddress cciptypes.UnknownEncodedAddress `json:"contractAddress"`
+
+	// Decimals is the number of decimals of the exchange rate.
+	Decimals uint8 `json:"decimals"`
+}
+
+// Aggregators returns the addresses of all the price feed aggregators the token price is read from.
+func (a TokenInfo) Aggregators() []cciptypes.UnknownEncodedAddress {
+	if a.DerivedPrice != nil {
+		return a.DerivedPrice.AggregatorAddresses
 	}
+	return []cciptypes.UnknownEncodedAddress{a.AggregatorAddress}
+}
 
-	// aggregator must be an ethereum address
-	decoded, err := hex.DecodeString(strings.ToLower(strings.TrimPrefix(string(a.AggregatorAddress), "0x")))
-	if err != nil {
-		return fmt.Errorf("aggregatorAddress must be a valid ethereum address (i.e hex encoded 20 bytes): %w", err)
+// FeedChainAddresses returns the addresses of all the feed chain contracts (or accounts) the token price is read from.
+func (a TokenInfo) FeedChainAddresses() []cciptypes.UnknownEncodedAddress {
+	addresses := a.Aggregators()
+	if a.DerivedPrice != nil && a.DerivedPrice.ExchangeRate != nil {
+		addresses = append(slices.Clone(addresses), a.DerivedPrice.ExchangeRate.ContractAddress)
 	}
-	if len(decoded) != 20 {
-		return fmt.Errorf("aggregatorAddress must be a valid ethereum address, got %d bytes expected 20", len(decoded))
+	return addresses
+}
+
+func (a TokenInfo) Validate() error {
+	switch {
+	case a.AggregatorAddress == "" && a.DerivedPrice == nil:
+		return errors.New("aggregatorAddress not set")
+	case a.AggregatorAddress != "" && a.DerivedPrice != nil:
+		return errors.New("aggregatorAddress and derivedPrice can't be both set")
+	case a.DerivedPrice != nil:
+		if err := a.DerivedPrice.Validate(); err != nil {
+			return fmt.Errorf("invalid derivedPrice: %w", err)
+		}
 	}
 
 	if a.DeviationPPB.Int.Cmp(big.NewInt(0)) <= 0 {
@@ -67,6 +116,49 @@ func (a TokenInfo) Validate() error {
 	return nil
 }
 
+// ValidateAddresses checks that the feed chain addresses of the token are valid addresses of the feed chain family,
+// e.g. 20 bytes hex addresses for EVM aggregators or base58 encoded Solana feed accounts.
+func (a TokenInfo) ValidateAddresses(addrCodec cciptypes.AddressCodec, feedChain cciptypes.ChainSelector) error {
+	seen := make(map[string]struct{})
+	for _, addr := range a.FeedChainAddresses() {
+		decoded, err := addrCodec.AddressStringToBytes(string(addr), feedChain)
+		if err != nil {
+			return fmt.Errorf("invalid address %s for feed chain %d: %w", addr, feedChain, err)
+		}
+		if len(decoded) == 0 {
+			return fmt.Errorf("empty address %s for feed chain %d", addr, feedChain)
+		}
+		if _, ok := seen[string(decoded)]; ok {
+			return fmt.Errorf("duplicate address %s", addr)
+		}
+		seen[string(decoded)] = struct{}{}
+	}
+	return nil
+}
+
+func (d DerivedPriceSource) Validate() error {
+	if len(d.AggregatorAddresses) == 0 {
+		return errors.New("aggregatorAddresses not set")
+	}
+
+	seen := make(map[cciptypes.UnknownEncodedAddress]struct{}, len(d.AggregatorAddresses))
+	for _, addr := range d.AggregatorAddresses {
+		if addr == "" {
+			return errors.New("empty aggregator address")
+		}
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicate aggregator address %s", addr)
+		}
+		seen[addr] = struct{}{}
+	}
+
+	if d.ExchangeRate != nil && d.ExchangeRate.ContractAddress == "" {
+		return errors.New("exchangeRate.contractAddress not set")
+	}
+
+	return nil
+}
+
 // CommitOffchainConfig is the OCR offchainConfig for the commit plugin.
 // This is posted onchain as part of the OCR configuration process of the commit plugin.
 // Every plugin is provided this configuration in its encoded form in the NewReportingPlugin
@@ -167,8 +259,191 @@ type CommitOffchainConfig struct {
 	// MultipleReportsEnabled is a flag to enable/disable multiple reports per round.
 	// This is typically set to true on chains that use 'MaxMerkleRootsPerReport'
 	// in order to avoid delays when there are reports from multiple sources.
-	// NOTE: this can only be used if RMNEnabled == false.
+	// NOTE: this can only be used if RMNEnabled == false, unless ReportSplitting is set.
 	MultipleReportsEnabled bool `json:"multipleReports"`
+
+	// AdaptiveMerkleRootBatching sizes the merkle root ranges of every source chain from the observed backlog
+	// instead of committing all the pending messages every round. Disabled if not set.
+	AdaptiveMerkleRootBatching *AdaptiveBatchingConfig `json:"adaptiveMerkleRootBatching,omitempty"`
+
+	// PriceUpdateBudget caps the destination gas spent on gas and token price updates within a window.
+	// Disabled if not set.
+	PriceUpdateBudget *PriceUpdateBudgetConfig `json:"priceUpdateBudget,omitempty"`
+
+	// PriceConsensus makes the consensus on the observed gas and token prices weighted by the oracle weights
+	// and resistant to outliers. The plain median over all the observations is used if not set.
+	PriceConsensus *PriceConsensusConfig `json:"priceConsensus,omitempty"`
+
+	// ReportSplitting splits the outcome into multiple reports based on their estimated gas and size.
+	// Blessed and unblessed merkle roots are reported separately and the price updates are reported after them.
+	// NOTE: this requires MultipleReportsEnabled and replaces MaxMerkleRootsPerReport and MaxPricesPerReport.
+	ReportSplitting *ReportSplittingConfig `json:"reportSplitting,omitempty"`
+
+	// ContractAddressAllowlist restricts the contract addresses that the contract discovery adopts.
+	// Every address that reaches consensus is adopted if not set.
+	ContractAddressAllowlist ContractAddressAllowlist `json:"contractAddressAllowlist,omitempty"`
+
+	// OCRCodecVersion is the wire format version used to encode the queries, observations and outcomes.
+	// Payloads of every supported version are always decoded, so the version can be bumped with a config
+	// change once all the oracles run a release that supports it. Zero stands for version 1.
+	OCRCodecVersion uint8 `json:"ocrCodecVersion,omitempty"`
+}
+
+// AdaptiveBatchingConfig configures the adaptive merkle root batching. The range size a source chain has to reach
+// is derived every round from the total backlog of all the source chains, it's the share of the backlog per merkle
+// root of a report (limited by MaxMerkleRootsPerReport), within MinRangeSize and MaxMerkleTreeSize. Messages of
+// a source chain are committed once their backlog reaches the range size or once the oldest pending message has
+// waited for TargetLatency.
+type AdaptiveBatchingConfig struct {
+	// MinRangeSize is the lower bound of the range size, during quiet periods it's the number of pending messages
+	// of a source chain that are committed without waiting.
+	MinRangeSize uint64 `json:"minRangeSize"`
+
+	// TargetLatency is the maximum time pending messages of a source chain wait for the backlog to reach
+	// the range size before they are committed.
+	TargetLatency commonconfig.Duration `json:"targetLatency"`
+}
+
+func (c AdaptiveBatchingConfig) Validate(maxMerkleTreeSize uint64) error {
+	if c.MinRangeSize == 0 {
+		return errors.New("minRangeSize not set")
+	}
+
+	if c.MinRangeSize > maxMerkleTreeSize {
+		return fmt.Errorf("minRangeSize (%d) is greater than maxMerkleTreeSize (%d)", c.MinRangeSize, maxMerkleTreeSize)
+	}
+
+	if c.TargetLatency.Duration() <= 0 {
+		return errors.New("targetLatency not set")
+	}
+
+	return nil
+}
+
+// PriceUpdateBudgetConfig configures the destination gas budget of the price updates. Updates without a previous
+// price or whose heartbeat passed are always written, the remaining budget of the window is assigned to the
+// deviating prices with the largest deviations first. A zero max gas disables the budget of that kind of updates.
+type PriceUpdateBudgetConfig struct {
+	// Window is the duration of the sliding window the budget applies to.
+	Window commonconfig.Duration `json:"window"`
+
+	// GasPriceUpdatesMaxGas is the destination gas the gas price updates may spend within a window.
+	GasPriceUpdatesMaxGas uint64 `json:"gasPriceUpdatesMaxGas"`
+
+	// GasPerGasPriceUpdate is the estimated destination gas of a single gas price update.
+	GasPerGasPriceUpdate uint64 `json:"gasPerGasPriceUpdate"`
+
+	// TokenPriceUpdatesMaxGas is the destination gas the token price updates may spend within a window.
+	TokenPriceUpdatesMaxGas uint64 `json:"tokenPriceUpdatesMaxGas"`
+
+	// GasPerTokenPriceUpdate is the estimated destination gas of a single token price update.
+	GasPerTokenPriceUpdate uint64 `json:"gasPerTokenPriceUpdate"`
+}
+
+func (c PriceUpdateBudgetConfig) Validate() error {
+	if c.Window.Duration() <= 0 {
+		return errors.New("window not set")
+	}
+
+	if c.GasPriceUpdatesMaxGas == 0 && c.TokenPriceUpdatesMaxGas == 0 {
+		return errors.New("neither gasPriceUpdatesMaxGas nor tokenPriceUpdatesMaxGas is set")
+	}
+
+	if c.GasPriceUpdatesMaxGas > 0 && c.GasPerGasPriceUpdate == 0 {
+		return errors.New("gasPerGasPriceUpdate not set")
+	}
+
+	if c.TokenPriceUpdatesMaxGas > 0 && c.GasPerTokenPriceUpdate == 0 {
+		return errors.New("gasPerTokenPriceUpdate not set")
+	}
+
+	return nil
+}
+
+// PriceConsensusConfig configures the consensus on the observed gas and token prices.
+type PriceConsensusConfig struct {
+	// OracleWeights are the voting weights of the oracles, e.g. derived from their stake. Oracles that are not
+	// listed have no weight. A price reaches consensus only if its observers hold more than two thirds of the
+	// total weight, and the weighted median is used. Every oracle has the same weight if not set.
+	OracleWeights map[commontypes.OracleID]uint64 `json:"oracleWeights,omitempty"`
+
+	// OutlierMADMultiplier rejects the observed prices that are further from the median than this multiple of
+	// the median absolute deviation before the median is taken. Disabled if zero.
+	OutlierMADMultiplier uint64 `json:"outlierMADMultiplier,omitempty"`
+}
+
+func (c PriceConsensusConfig) Validate() error {
+	if len(c.OracleWeights) == 0 && c.OutlierMADMultiplier == 0 {
+		return errors.New("neither oracleWeights nor outlierMADMultiplier is set")
+	}
+
+	if len(c.OracleWeights) == 0 {
+		return nil
+	}
+
+	total := uint64(0)
+	for oracleID, weight := range c.OracleWeights {
+		if total+weight < total {
+			return fmt.Errorf("total oracle weight overflows at oracle %d", oracleID)
+		}
+		total += weight
+	}
+	if total == 0 {
+		return errors.New("total oracle weight is zero")
+	}
+
+	for oracleID, weight := range c.OracleWeights {
+		// a single oracle holding half of the weight could block or dictate the weighted median.
+		if weight >= total-weight {
+			return fmt.Errorf("oracle %d holds %d of the total oracle weight %d, must be less than half",
+				oracleID, weight, total)
+		}
+	}
+
+	return nil
+}
+
+// ReportSplittingConfig configures the limits of the split reports and the per item estimates used to
+// compute the destination gas of a report. A zero limit is not enforced.
+type ReportSplittingConfig struct {
+	// MaxReportGas is the maximum estimated destination gas of a report.
+	MaxReportGas uint64 `json:"maxReportGas"`
+
+	// MaxReportSizeBytes is the maximum estimated encoded size of a report.
+	MaxReportSizeBytes uint64 `json:"maxReportSizeBytes"`
+
+	// ReportBaseGas is the destination gas of a report regardless of its content.
+	ReportBaseGas uint64 `json:"reportBaseGas"`
+
+	// GasPerMerkleRoot is the destination gas of committing a single merkle root.
+	GasPerMerkleRoot uint64 `json:"gasPerMerkleRoot"`
+
+	// GasPerRMNSignature is the destination gas of verifying a single RMN signature.
+	GasPerRMNSignature uint64 `json:"gasPerRMNSignature"`
+
+	// GasPerTokenPriceUpdate is the destination gas of a single token price update.
+	GasPerTokenPriceUpdate uint64 `json:"gasPerTokenPriceUpdate"`
+
+	// GasPerGasPriceUpdate is the destination gas of a single gas price update.
+	GasPerGasPriceUpdate uint64 `json:"gasPerGasPriceUpdate"`
+}
+
+func (c ReportSplittingConfig) Validate() error {
+	if c.MaxReportGas == 0 && c.MaxReportSizeBytes == 0 {
+		return errors.New("neither maxReportGas nor maxReportSizeBytes is set")
+	}
+
+	if c.MaxReportGas > 0 {
+		if c.GasPerMerkleRoot == 0 || c.GasPerTokenPriceUpdate == 0 || c.GasPerGasPriceUpdate == 0 {
+			return errors.New("gasPerMerkleRoot, gasPerTokenPriceUpdate and gasPerGasPriceUpdate must be set " +
+				"when maxReportGas is set")
+		}
+		if c.ReportBaseGas >= c.MaxReportGas {
+			return fmt.Errorf("reportBaseGas (%d) is not lower than maxReportGas (%d)", c.ReportBaseGas, c.MaxReportGas)
+		}
+	}
+
+	return nil
 }
 
 //nolint:gocyclo // it is considered ok since we don't have complicated logic here
@@ -276,6 +551,32 @@ func (c *CommitOffchainConfig) Validate() error {
 		return fmt.Errorf("signObservationPrefix not set")
 	}
 
+	if c.AdaptiveMerkleRootBatching != nil {
+		if err := c.AdaptiveMerkleRootBatching.Validate(c.MaxMerkleTreeSize); err != nil {
+			return fmt.Errorf("invalid adaptiveMerkleRootBatching: %w", err)
+		}
+	}
+
+	if c.PriceUpdateBudget != nil {
+		if err := c.PriceUpdateBudget.Validate(); err != nil {
+			return fmt.Errorf("invalid priceUpdateBudget: %w", err)
+		}
+	}
+
+	if c.PriceConsensus != nil {
+		if err := c.PriceConsensus.Validate(); err != nil {
+			return fmt.Errorf("invalid priceConsensus: %w", err)
+		}
+	}
+
+	if err := c.ContractAddressAllowlist.Validate(); err != nil {
+		return fmt.Errorf("invalid contractAddressAllowlist: %w", err)
+	}
+
+	if err := validateOCRCodecVersion(c.OCRCodecVersion); err != nil {
+		return err
+	}
+
 	if !c.MerkleRootAsyncObserverDisabled &&
 		(c.MerkleRootAsyncObserverSyncFreq == 0 || c.MerkleRootAsyncObserverSyncTimeout == 0) {
 		return fmt.Errorf("merkle root async observer sync freq (%s) or sync timeout (%s) not set",
@@ -293,7 +594,8 @@ func (c *CommitOffchainConfig) Validate() error {
 	// an error to use them unless RMNEnabled == false.
 	var errs []error
 	if c.RMNEnabled {
-		if c.MultipleReportsEnabled {
+		// report splitting keeps the blessed merkle roots and their RMN signatures in the same report.
+		if c.MultipleReportsEnabled && c.ReportSplitting == nil {
 			errs = append(errs, fmt.Errorf("multipleReports do not support RMN, RMNEnabled cannot be true"))
 		}
 		if c.MaxMerkleRootsPerReport != 0 {
@@ -306,6 +608,18 @@ func (c *CommitOffchainConfig) Validate() error {
 	if c.MaxPricesPerReport != 0 && !c.MultipleReportsEnabled {
 		errs = append(errs, fmt.Errorf("maxPricesPerReport cannot be used without MultipleReportsEnabled"))
 	}
+	if c.ReportSplitting != nil {
+		if !c.MultipleReportsEnabled {
+			errs = append(errs, fmt.Errorf("reportSplitting cannot be used without MultipleReportsEnabled"))
+		}
+		if c.MaxMerkleRootsPerReport != 0 || c.MaxPricesPerReport != 0 {
+			errs = append(errs,
+				fmt.Errorf("reportSplitting cannot be used with maxMerkleRootsPerReport or maxPricesPerReport"))
+		}
+		if err := c.ReportSplitting.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid reportSplitting: %w", err))
+		}
+	}
 
 	if len(errs) > 0 {
 		return errors.Join(errs...)
@@ -314,6 +628,24 @@ func (c *CommitOffchainConfig) Validate() error {
 	return nil
 }
 
+func validateOCRCodecVersion(version uint8) error {
+	if version > LatestOCRCodecVersion {
+		return fmt.Errorf("unsupported ocrCodecVersion %d, latest is %d", version, LatestOCRCodecVersion)
+	}
+	return nil
+}
+
+// ValidateFeedAddresses checks the feed chain addresses of all the tokens using the address codec of the feed chain.
+// It's separate from Validate since the address codec is not part of the offchain config.
+func (c *CommitOffchainConfig) ValidateFeedAddresses(addrCodec cciptypes.AddressCodec) error {
+	for token, tokenInfo := range c.TokenInfo {
+		if err := tokenInfo.ValidateAddresses(addrCodec, c.PriceFeedChainSelector); err != nil {
+			return fmt.Errorf("invalid token info for token %s: %w", token, err)
+		}
+	}
+	return nil
+}
+
 func (c *CommitOffchainConfig) ApplyDefaultsAndValidate() error {
 	c.applyDefaults()
 	return c.Validate()
@@ -1,7 +1,10 @@
 package pluginconfig
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"math"
 	"math/big"
 	"reflect"
 	"testing"
@@ -11,14 +14,17 @@ import (
 	"github.com/stretchr/testify/require"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/libocr/commontypes"
 
 	"github.com/smartcontractkit/chainlink-ccip/internal/libs/testhelpers/rand"
+	ccipocr3mocks "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/types/ccipocr3"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
 func TestArbitrumPriceSource_Validate(t *testing.T) {
 	type fields struct {
 		AggregatorAddress cciptypes.UnknownEncodedAddress
+		DerivedPrice      *DerivedPriceSource
 		DeviationPPB      cciptypes.BigInt
 		Decimals          uint8
 	}
@@ -63,15 +69,6 @@ func TestArbitrumPriceSource_Validate(t *testing.T) {
 			},
 			true,
 		},
-		{
-			"invalid, invalid address",
-			fields{
-				AggregatorAddress: "0x2e03388D351BF87CF2409EFf18C45Df59775b",
-				DeviationPPB:      cciptypes.BigInt{Int: big.NewInt(1)},
-				Decimals:          18,
-			},
-			true,
-		},
 		{
 			"invalid, negative deviation",
 			fields{
@@ -99,11 +96,77 @@ func TestArbitrumPriceSource_Validate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid, derived price",
+			fields{
+				DerivedPrice: &DerivedPriceSource{
+					AggregatorAddresses: []cciptypes.UnknownEncodedAddress{
+						"0x2e03388D351BF87CF2409EFf18C45Df59775Fbb2",
+						"0x2e03388D351BF87CF2409EFf18C45Df59775Fbb3",
+					},
+					ExchangeRate: &ExchangeRateSource{
+						ContractAddress: "0x7f39C581F595B53c5cb19bD0b3f8dA6c935E2Ca0",
+						Decimals:        18,
+					},
+				},
+				DeviationPPB: cciptypes.BigInt{Int: big.NewInt(1)},
+				Decimals:     18,
+			},
+			false,
+		},
+		{
+			"invalid, both aggregator address and derived price",
+			fields{
+				AggregatorAddress: "0x2e03388D351BF87CF2409EFf18C45Df59775Fbb2",
+				DerivedPrice: &DerivedPriceSource{
+					AggregatorAddresses: []cciptypes.UnknownEncodedAddress{"0x2e03388D351BF87CF2409EFf18C45Df59775Fbb3"},
+				},
+				DeviationPPB: cciptypes.BigInt{Int: big.NewInt(1)},
+				Decimals:     18,
+			},
+			true,
+		},
+		{
+			"invalid, derived price without feeds",
+			fields{
+				DerivedPrice: &DerivedPriceSource{},
+				DeviationPPB: cciptypes.BigInt{Int: big.NewInt(1)},
+				Decimals:     18,
+			},
+			true,
+		},
+		{
+			"invalid, derived price with duplicate feeds",
+			fields{
+				DerivedPrice: &DerivedPriceSource{
+					AggregatorAddresses: []cciptypes.UnknownEncodedAddress{
+						"0x2e03388D351BF87CF2409EFf18C45Df59775Fbb2",
+						"0x2e03388D351BF87CF2409EFf18C45Df59775Fbb2",
+					},
+				},
+				DeviationPPB: cciptypes.BigInt{Int: big.NewInt(1)},
+				Decimals:     18,
+			},
+			true,
+		},
+		{
+			"invalid, derived price without exchange rate address",
+			fields{
+				DerivedPrice: &DerivedPriceSource{
+					AggregatorAddresses: []cciptypes.UnknownEncodedAddress{"0x2e03388D351BF87CF2409EFf18C45Df59775Fbb2"},
+					ExchangeRate:        &ExchangeRateSource{Decimals: 18},
+				},
+				DeviationPPB: cciptypes.BigInt{Int: big.NewInt(1)},
+				Decimals:     18,
+			},
+			true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			a := TokenInfo{
 				AggregatorAddress: tt.fields.AggregatorAddress,
+				DerivedPrice:      tt.fields.DerivedPrice,
 				DeviationPPB:      tt.fields.DeviationPPB,
 				Decimals:          tt.fields.Decimals,
 			}
@@ -114,6 +177,66 @@ func TestArbitrumPriceSource_Validate(t *testing.T) {
 	}
 }
 
+func TestTokenInfo_ValidateAddresses(t *testing.T) {
+	const (
+		evmChain = cciptypes.ChainSelector(1)
+		solChain = cciptypes.ChainSelector(2)
+
+		evmFeed     = "0x2e03388D351BF87CF2409EFf18C45Df59775Fbb2"
+		evmFeedLow  = "0x2e03388d351bf87cf2409eff18c45df59775fbb2"
+		invalidFeed = "0x2e03388D351BF87CF2409EFf18C45Df59775b"
+		solFeed     = "CH31Xns5z3M1cTAbKW34jcxPPciazARpijcHj9rxtemt"
+	)
+	addrCodec := ccipocr3mocks.NewMockAddressCodec(t)
+	evmFeedBytes := cciptypes.UnknownAddress(bytes.Repeat([]byte{0x2e}, 20))
+	addrCodec.On("AddressStringToBytes", evmFeed, evmChain).Return(evmFeedBytes, nil).Maybe()
+	addrCodec.On("AddressStringToBytes", evmFeedLow, evmChain).Return(evmFeedBytes, nil).Maybe()
+	addrCodec.On("AddressStringToBytes", invalidFeed, evmChain).
+		Return(nil, errors.New("invalid address length")).Maybe()
+	addrCodec.On("AddressStringToBytes", solFeed, solChain).
+		Return(cciptypes.UnknownAddress(bytes.Repeat([]byte{0xa6}, 32)), nil).Maybe()
+	addrCodec.On("AddressStringToBytes", evmFeed, solChain).Return(nil, errors.New("invalid base58")).Maybe()
+
+	tests := []struct {
+		name      string
+		tokenInfo TokenInfo
+		feedChain cciptypes.ChainSelector
+		wantErr   bool
+	}{
+		{"valid, evm aggregator", TokenInfo{AggregatorAddress: evmFeed}, evmChain, false},
+		{"valid, solana feed account", TokenInfo{AggregatorAddress: solFeed}, solChain, false},
+		{"invalid, evm address", TokenInfo{AggregatorAddress: invalidFeed}, evmChain, true},
+		{"invalid, evm aggregator on solana feed chain", TokenInfo{AggregatorAddress: evmFeed}, solChain, true},
+		{
+			"invalid, duplicate derived price feeds",
+			TokenInfo{DerivedPrice: &DerivedPriceSource{
+				AggregatorAddresses: []cciptypes.UnknownEncodedAddress{evmFeed, evmFeedLow},
+			}},
+			evmChain,
+			true,
+		},
+		{
+			"invalid, exchange rate address",
+			TokenInfo{DerivedPrice: &DerivedPriceSource{
+				AggregatorAddresses: []cciptypes.UnknownEncodedAddress{evmFeed},
+				ExchangeRate:        &ExchangeRateSource{ContractAddress: invalidFeed, Decimals: 18},
+			}},
+			evmChain,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.tokenInfo.ValidateAddresses(addrCodec, tt.feedChain)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestCommitOffchainConfig_Validate(t *testing.T) {
 	type fields struct {
 		RemoteGasPriceBatchWriteFrequency  commonconfig.Duration
@@ -522,6 +645,144 @@ func TestCommitOffchainConfig_ApplyDefaultsAndValidate(t *testing.T) {
 				SignObservationPrefix:              defaultSignObservationPrefix,
 			},
 		},
+		{
+			name: "Adaptive merkle root batching",
+			input: CommitOffchainConfig{
+				AdaptiveMerkleRootBatching: &AdaptiveBatchingConfig{
+					MinRangeSize:  32,
+					TargetLatency: *commonconfig.MustNewDuration(time.Minute),
+				},
+			},
+		},
+		{
+			name: "Adaptive merkle root batching without target latency",
+			input: CommitOffchainConfig{
+				AdaptiveMerkleRootBatching: &AdaptiveBatchingConfig{MinRangeSize: 32},
+			},
+			expectedError: "targetLatency not set",
+		},
+		{
+			name: "Adaptive merkle root batching with min range size above max tree size",
+			input: CommitOffchainConfig{
+				MaxMerkleTreeSize: 16,
+				AdaptiveMerkleRootBatching: &AdaptiveBatchingConfig{
+					MinRangeSize:  32,
+					TargetLatency: *commonconfig.MustNewDuration(time.Minute),
+				},
+			},
+			expectedError: "minRangeSize (32) is greater than maxMerkleTreeSize (16)",
+		},
+		{
+			name: "Price update budget",
+			input: CommitOffchainConfig{
+				PriceUpdateBudget: &PriceUpdateBudgetConfig{
+					Window:                *commonconfig.MustNewDuration(time.Hour),
+					GasPriceUpdatesMaxGas: 1_000_000,
+					GasPerGasPriceUpdate:  30_000,
+				},
+			},
+		},
+		{
+			name: "Price update budget without window",
+			input: CommitOffchainConfig{
+				PriceUpdateBudget: &PriceUpdateBudgetConfig{
+					GasPriceUpdatesMaxGas: 1_000_000,
+					GasPerGasPriceUpdate:  30_000,
+				},
+			},
+			expectedError: "window not set",
+		},
+		{
+			name: "Price update budget without gas per token price update",
+			input: CommitOffchainConfig{
+				PriceUpdateBudget: &PriceUpdateBudgetConfig{
+					Window:                  *commonconfig.MustNewDuration(time.Hour),
+					TokenPriceUpdatesMaxGas: 1_000_000,
+				},
+			},
+			expectedError: "gasPerTokenPriceUpdate not set",
+		},
+		{
+			name: "Price consensus",
+			input: CommitOffchainConfig{
+				PriceConsensus: &PriceConsensusConfig{
+					OracleWeights:        map[commontypes.OracleID]uint64{0: 10, 1: 10, 2: 20, 3: 5},
+					OutlierMADMultiplier: 3,
+				},
+			},
+		},
+		{
+			name: "Price consensus without weights nor outlier rejection",
+			input: CommitOffchainConfig{
+				PriceConsensus: &PriceConsensusConfig{},
+			},
+			expectedError: "neither oracleWeights nor outlierMADMultiplier is set",
+		},
+		{
+			name: "Price consensus with zero total weight",
+			input: CommitOffchainConfig{
+				PriceConsensus: &PriceConsensusConfig{
+					OracleWeights: map[commontypes.OracleID]uint64{0: 0, 1: 0},
+				},
+			},
+			expectedError: "total oracle weight is zero",
+		},
+		{
+			name: "Price consensus with a dominant oracle",
+			input: CommitOffchainConfig{
+				PriceConsensus: &PriceConsensusConfig{
+					OracleWeights: map[commontypes.OracleID]uint64{0: 10, 1: 5, 2: 5},
+				},
+			},
+			expectedError: "oracle 0 holds 10 of the total oracle weight 20, must be less than half",
+		},
+		{
+			name: "Price consensus with overflowing weights",
+			input: CommitOffchainConfig{
+				PriceConsensus: &PriceConsensusConfig{
+					OracleWeights: map[commontypes.OracleID]uint64{0: math.MaxUint64, 1: 1},
+				},
+			},
+			expectedError: "total oracle weight overflows",
+		},
+		{
+			name: "Report splitting with RMN",
+			input: CommitOffchainConfig{
+				RMNEnabled:             true,
+				MultipleReportsEnabled: true,
+				ReportSplitting: &ReportSplittingConfig{
+					MaxReportGas:           3_000_000,
+					ReportBaseGas:          100_000,
+					GasPerMerkleRoot:       50_000,
+					GasPerTokenPriceUpdate: 10_000,
+					GasPerGasPriceUpdate:   10_000,
+				},
+			},
+		},
+		{
+			name: "Report splitting without multiple reports",
+			input: CommitOffchainConfig{
+				ReportSplitting: &ReportSplittingConfig{MaxReportSizeBytes: 1024},
+			},
+			expectedError: "reportSplitting cannot be used without MultipleReportsEnabled",
+		},
+		{
+			name: "Report splitting without gas estimates",
+			input: CommitOffchainConfig{
+				MultipleReportsEnabled: true,
+				ReportSplitting:        &ReportSplittingConfig{MaxReportGas: 3_000_000},
+			},
+			expectedError: "gasPerMerkleRoot, gasPerTokenPriceUpdate and gasPerGasPriceUpdate must be set",
+		},
+		{
+			name:  "OCR codec version",
+			input: CommitOffchainConfig{OCRCodecVersion: LatestOCRCodecVersion},
+		},
+		{
+			name:          "Unsupported OCR codec version",
+			input:         CommitOffchainConfig{OCRCodecVersion: LatestOCRCodecVersion + 1},
+			expectedError: "unsupported ocrCodecVersion",
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,56 @@
+package pluginconfig
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// ContractAddressAllowlist lists, per contract name and chain, the addresses that the contract discovery may adopt.
+// Only the listed contracts are restricted: an address of a listed contract that is not listed for its chain is
+// refused and the previously agreed address is kept.
+type ContractAddressAllowlist map[string]map[cciptypes.ChainSelector][]cciptypes.UnknownAddress
+
+// Allows returns true if the address of the contract on the chain may be adopted.
+func (a ContractAddressAllowlist) Allows(
+	contract string, chain cciptypes.ChainSelector, address cciptypes.UnknownAddress,
+) bool {
+	chains, restricted := a[contract]
+	if !restricted {
+		return true
+	}
+	for _, allowed := range chains[chain] {
+		if bytes.Equal(allowed, address) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a ContractAddressAllowlist) Validate() error {
+	for contract, chains := range a {
+		switch contract {
+		case consts.ContractNameOnRamp,
+			consts.ContractNameNonceManager,
+			consts.ContractNameRMNRemote,
+			consts.ContractNameFeeQuoter,
+			consts.ContractNameRouter:
+		default:
+			return fmt.Errorf("contract %s is not discovered", contract)
+		}
+
+		for chain, addresses := range chains {
+			if len(addresses) == 0 {
+				return fmt.Errorf("no addresses listed for contract %s on chain %d", contract, chain)
+			}
+			for _, address := range addresses {
+				if address.IsZeroOrEmpty() {
+					return fmt.Errorf("empty address listed for contract %s on chain %d", contract, chain)
+				}
+			}
+		}
+	}
+	return nil
+}
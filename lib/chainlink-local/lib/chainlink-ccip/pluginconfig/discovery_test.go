@@ -0,0 +1,76 @@
+package pluginconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+func TestContractAddressAllowlist_Allows(t *testing.T) {
+	allowlist := ContractAddressAllowlist{
+		consts.ContractNameOnRamp: {
+			1: {cciptypes.UnknownAddress("onRamp1"), cciptypes.UnknownAddress("onRamp2")},
+		},
+	}
+
+	require.True(t, allowlist.Allows(consts.ContractNameOnRamp, 1, cciptypes.UnknownAddress("onRamp2")))
+	require.False(t, allowlist.Allows(consts.ContractNameOnRamp, 1, cciptypes.UnknownAddress("onRamp3")))
+	// chains that are not listed are refused for the listed contracts.
+	require.False(t, allowlist.Allows(consts.ContractNameOnRamp, 2, cciptypes.UnknownAddress("onRamp1")))
+	// contracts that are not listed are not restricted.
+	require.True(t, allowlist.Allows(consts.ContractNameRouter, 1, cciptypes.UnknownAddress("router")))
+
+	var noAllowlist ContractAddressAllowlist
+	require.True(t, noAllowlist.Allows(consts.ContractNameOnRamp, 1, cciptypes.UnknownAddress("onRamp3")))
+}
+
+func TestContractAddressAllowlist_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		allowlist ContractAddressAllowlist
+		expErr    string
+	}{
+		{
+			name: "valid",
+			allowlist: ContractAddressAllowlist{
+				consts.ContractNameOnRamp: {1: {cciptypes.UnknownAddress("onRamp")}},
+				consts.ContractNameRouter: {2: {cciptypes.UnknownAddress("router")}},
+			},
+		},
+		{
+			name: "contract that is not discovered",
+			allowlist: ContractAddressAllowlist{
+				consts.ContractNameOffRamp: {1: {cciptypes.UnknownAddress("offRamp")}},
+			},
+			expErr: "contract OffRamp is not discovered",
+		},
+		{
+			name: "no addresses",
+			allowlist: ContractAddressAllowlist{
+				consts.ContractNameOnRamp: {1: {}},
+			},
+			expErr: "no addresses listed for contract OnRamp on chain 1",
+		},
+		{
+			name: "empty address",
+			allowlist: ContractAddressAllowlist{
+				consts.ContractNameOnRamp: {1: {cciptypes.UnknownAddress{0, 0}}},
+			},
+			expErr: "empty address listed for contract OnRamp on chain 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.allowlist.Validate()
+			if tc.expErr != "" {
+				require.EqualError(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -3,9 +3,12 @@ package pluginconfig
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
 // ExecuteOffchainConfig is the OCR offchainConfig for the exec plugin.
@@ -45,8 +48,93 @@ type ExecuteOffchainConfig struct {
 	// MaxSingleChainReports is the maximum number of single chain reports that can be included in a report.
 	// When set to 0, this setting is ignored.
 	MaxSingleChainReports uint64 `json:"maxSingleChainReports"`
+
+	// MessageSelectionStrategy decides which ready messages are packed into the execution report when not all of
+	// them fit. One of the MessageSelection* constants, empty means MessageSelectionSequential.
+	MessageSelectionStrategy string `json:"messageSelectionStrategy,omitempty"`
+
+	// PrioritySenders are the senders whose messages are executed first by the MessageSelectionPrioritySenders
+	// strategy. Addresses are in the string form of the source chain family.
+	PrioritySenders []string `json:"prioritySenders,omitempty"`
+
+	// EnableMessageSimulation makes the oracles provided with a message simulator dry-run the execution of the
+	// pending messages during the Filter round. Messages that more than F oracles observe reverting are not
+	// included in the report. Enabling it disables the fast path, since simulations are observed in the Filter round.
+	EnableMessageSimulation bool `json:"enableMessageSimulation,omitempty"`
+
+	// MessageSimulationCacheExpiry is how long the result of the message execution dry-run is reused before
+	// the message is simulated again. It's used only if the plugin is provided with a message simulator.
+	MessageSimulationCacheExpiry commonconfig.Duration `json:"messageSimulationCacheExpiry,omitempty"`
+
+	// EnableFastPath allows the plugin to build the execution report right after the GetMessages round, skipping
+	// the Filter round, when none of the pending messages is ordered (needs sender nonces) and all the token data
+	// are ready. Messages are then executed in two OCR rounds instead of three. It has no effect when
+	// EnableMessageSimulation is set.
+	EnableFastPath bool `json:"enableFastPath,omitempty"`
+
+	// SourceChainSharding splits the message reading of source chains between subsets of the oracles.
+	// When not set, every oracle reads the messages of all the source chains it supports.
+	SourceChainSharding *SourceChainShardingConfig `json:"sourceChainSharding,omitempty"`
+
+	// ContractAddressAllowlist restricts the contract addresses that the contract discovery adopts.
+	// Every address that reaches consensus is adopted if not set.
+	ContractAddressAllowlist ContractAddressAllowlist `json:"contractAddressAllowlist,omitempty"`
+
+	// OCRCodecVersion is the wire format version used to encode the observations and outcomes.
+	// Payloads of every supported version are always decoded. Zero stands for version 1.
+	OCRCodecVersion uint8 `json:"ocrCodecVersion,omitempty"`
+}
+
+// SourceChainShardingConfig assigns every source chain to a subset (shard) of the oracles supporting the chain.
+// Only the shard reads the messages and the token data of the chain, commit reports and nonces are still observed
+// by every oracle supporting the chain. The shard is selected deterministically by the hash of the chain selector,
+// the OCR config digest and the oracle peer ID, so every oracle computes the same shards and rejects message
+// observations of the chains outside of the shard of the observer. Destination chain is never sharded.
+type SourceChainShardingConfig struct {
+	// OraclesPerChain is the number of oracles observing every source chain. Chains supported by fewer oracles
+	// are observed by all of them. It must be at least 2*FChain+1 of every source chain, so that messages observed
+	// by the shard can still reach the FChain+1 consensus with FChain faulty oracles.
+	OraclesPerChain int `json:"oraclesPerChain"`
+}
+
+func (s SourceChainShardingConfig) Validate() error {
+	if s.OraclesPerChain <= 0 {
+		return errors.New("OraclesPerChain must be positive")
+	}
+	return nil
 }
 
+// ValidateFChain checks that every shard is large enough for the FChain of the source chains.
+func (s SourceChainShardingConfig) ValidateFChain(
+	fChain map[cciptypes.ChainSelector]int, destChain cciptypes.ChainSelector,
+) error {
+	for chain, f := range fChain {
+		if chain == destChain {
+			continue
+		}
+		if s.OraclesPerChain < 2*f+1 {
+			return fmt.Errorf("OraclesPerChain %d is lower than 2*FChain+1 (%d) of chain %d",
+				s.OraclesPerChain, 2*f+1, chain)
+		}
+	}
+	return nil
+}
+
+const (
+	defaultMessageSimulationCacheExpiry = 5 * time.Minute
+)
+
+const (
+	// MessageSelectionSequential packs messages report by report in the order of the commit reports.
+	MessageSelectionSequential = "sequential"
+	// MessageSelectionFairness interleaves messages of all the source chains.
+	MessageSelectionFairness = "fairness"
+	// MessageSelectionOldestFirst packs messages by the commit report timestamp across all the source chains.
+	MessageSelectionOldestFirst = "oldest-first"
+	// MessageSelectionPrioritySenders packs messages of the PrioritySenders first.
+	MessageSelectionPrioritySenders = "priority-senders"
+)
+
 func (e *ExecuteOffchainConfig) ApplyDefaultsAndValidate() error {
 	e.applyDefaults()
 	return e.Validate()
@@ -56,6 +144,9 @@ func (e *ExecuteOffchainConfig) applyDefaults() {
 	if e.TransmissionDelayMultiplier == 0 {
 		e.TransmissionDelayMultiplier = defaultTransmissionDelayMultiplier
 	}
+	if e.MessageSimulationCacheExpiry.Duration() == 0 {
+		e.MessageSimulationCacheExpiry = *commonconfig.MustNewDuration(defaultMessageSimulationCacheExpiry)
+	}
 }
 
 func (e *ExecuteOffchainConfig) Validate() error {
@@ -90,6 +181,38 @@ func (e *ExecuteOffchainConfig) Validate() error {
 		}
 		set[key] = struct{}{}
 	}
+
+	if e.SourceChainSharding != nil {
+		if err := e.SourceChainSharding.Validate(); err != nil {
+			return fmt.Errorf("invalid SourceChainSharding: %w", err)
+		}
+	}
+
+	if err := e.ContractAddressAllowlist.Validate(); err != nil {
+		return fmt.Errorf("invalid ContractAddressAllowlist: %w", err)
+	}
+
+	if err := validateOCRCodecVersion(e.OCRCodecVersion); err != nil {
+		return err
+	}
+
+	switch e.MessageSelectionStrategy {
+	case "", MessageSelectionSequential, MessageSelectionFairness, MessageSelectionOldestFirst:
+		if len(e.PrioritySenders) > 0 {
+			return errors.New("PrioritySenders set without the priority-senders message selection strategy")
+		}
+	case MessageSelectionPrioritySenders:
+		if len(e.PrioritySenders) == 0 {
+			return errors.New("PrioritySenders not set")
+		}
+		for _, sender := range e.PrioritySenders {
+			if sender == "" {
+				return errors.New("empty priority sender")
+			}
+		}
+	default:
+		return fmt.Errorf("unknown message selection strategy: %q", e.MessageSelectionStrategy)
+	}
 	return nil
 }
 
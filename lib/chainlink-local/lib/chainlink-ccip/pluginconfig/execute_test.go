@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
 func TestExecuteOffchainConfig_Validate(t *testing.T) {
@@ -15,6 +17,10 @@ func TestExecuteOffchainConfig_Validate(t *testing.T) {
 		RootSnoozeTime            commonconfig.Duration
 		MessageVisibilityInterval commonconfig.Duration
 		BatchingStrategyID        uint32
+		MessageSelectionStrategy  string
+		PrioritySenders           []string
+		SourceChainSharding       *SourceChainShardingConfig
+		OCRCodecVersion           uint8
 	}
 	tests := []struct {
 		name    string
@@ -76,6 +82,107 @@ func TestExecuteOffchainConfig_Validate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid, fairness message selection",
+			fields{
+				BatchGasLimit:             1,
+				InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+				RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+				MessageVisibilityInterval: *commonconfig.MustNewDuration(1),
+				MessageSelectionStrategy:  MessageSelectionFairness,
+			},
+			false,
+		},
+		{
+			"valid, priority senders message selection",
+			fields{
+				BatchGasLimit:             1,
+				InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+				RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+				MessageVisibilityInterval: *commonconfig.MustNewDuration(1),
+				MessageSelectionStrategy:  MessageSelectionPrioritySenders,
+				PrioritySenders:           []string{"0x01"},
+			},
+			false,
+		},
+		{
+			"invalid, unknown message selection strategy",
+			fields{
+				BatchGasLimit:             1,
+				InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+				RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+				MessageVisibilityInterval: *commonconfig.MustNewDuration(1),
+				MessageSelectionStrategy:  "random",
+			},
+			true,
+		},
+		{
+			"invalid, priority senders not set",
+			fields{
+				BatchGasLimit:             1,
+				InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+				RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+				MessageVisibilityInterval: *commonconfig.MustNewDuration(1),
+				MessageSelectionStrategy:  MessageSelectionPrioritySenders,
+			},
+			true,
+		},
+		{
+			"invalid, priority senders without priority strategy",
+			fields{
+				BatchGasLimit:             1,
+				InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+				RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+				MessageVisibilityInterval: *commonconfig.MustNewDuration(1),
+				MessageSelectionStrategy:  MessageSelectionOldestFirst,
+				PrioritySenders:           []string{"0x01"},
+			},
+			true,
+		},
+		{
+			"valid, source chain sharding",
+			fields{
+				BatchGasLimit:             1,
+				InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+				RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+				MessageVisibilityInterval: *commonconfig.MustNewDuration(1),
+				SourceChainSharding:       &SourceChainShardingConfig{OraclesPerChain: 4},
+			},
+			false,
+		},
+		{
+			"invalid, source chain sharding without oracles",
+			fields{
+				BatchGasLimit:             1,
+				InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+				RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+				MessageVisibilityInterval: *commonconfig.MustNewDuration(1),
+				SourceChainSharding:       &SourceChainShardingConfig{},
+			},
+			true,
+		},
+		{
+			"valid, ocr codec version",
+			fields{
+				BatchGasLimit:             1,
+				InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+				RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+				MessageVisibilityInterval: *commonconfig.MustNewDuration(1),
+				OCRCodecVersion:           LatestOCRCodecVersion,
+			},
+			false,
+		},
+		{
+			"invalid, unsupported ocr codec version",
+			fields{
+				BatchGasLimit:             1,
+				InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+				RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+				MessageVisibilityInterval: *commonconfig.MustNewDuration(1),
+				OCRCodecVersion:           LatestOCRCodecVersion + 1,
+			},
+			true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -85,6 +192,10 @@ func TestExecuteOffchainConfig_Validate(t *testing.T) {
 				RootSnoozeTime:            tt.fields.RootSnoozeTime,
 				MessageVisibilityInterval: tt.fields.MessageVisibilityInterval,
 				BatchingStrategyID:        tt.fields.BatchingStrategyID,
+				MessageSelectionStrategy:  tt.fields.MessageSelectionStrategy,
+				PrioritySenders:           tt.fields.PrioritySenders,
+				SourceChainSharding:       tt.fields.SourceChainSharding,
+				OCRCodecVersion:           tt.fields.OCRCodecVersion,
 			}
 			if err := e.Validate(); (err != nil) != tt.wantErr {
 				t.Errorf("ExecuteOffchainConfig.Validate() error = %v, wantErr %v", err, tt.wantErr)
@@ -93,6 +204,13 @@ func TestExecuteOffchainConfig_Validate(t *testing.T) {
 	}
 }
 
+func TestSourceChainShardingConfig_ValidateFChain(t *testing.T) {
+	cfg := SourceChainShardingConfig{OraclesPerChain: 5}
+	// the destination chain is not sharded
+	require.NoError(t, cfg.ValidateFChain(map[cciptypes.ChainSelector]int{1: 2, 2: 1, 3: 10}, 3))
+	require.Error(t, cfg.ValidateFChain(map[cciptypes.ChainSelector]int{1: 2, 2: 3, 3: 1}, 3))
+}
+
 func TestExecuteOffchainConfig_EncodeDecode(t *testing.T) {
 	type fields struct {
 		BatchGasLimit             uint64
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"time"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
@@ -12,13 +13,24 @@ import (
 )
 
 const (
-	USDCCCTPHandlerType = "usdc-cctp"
+	USDCCCTPHandlerType    = "usdc-cctp"
+	RebaseTokenHandlerType = "rebase-token"
+
+	// USDCCCTPVersion2 selects the CCTP v2 observer, every other version of USDCCCTPHandlerType uses CCTP v1.
+	USDCCCTPVersion2 = "2.0"
+)
+
+const (
+	// cctpFinalityThresholdFast and cctpFinalityThresholdStandard are the finality thresholds supported by CCTP v2
+	cctpFinalityThresholdFast     = uint32(1000)
+	cctpFinalityThresholdStandard = uint32(2000)
 )
 
 // TokenDataObserverConfig is the base struct for token data observers. Every token data observer
 // has to define its type and version. The type and version is used to determine which observer's
-// implementation to use. Whenever you want to add a new observer type, you need to add a new struct and embed that
-// in the TokenDataObserverConfig similarly to how USDCCCTPObserverConfig is embedded in the TokenDataObserverConfig.
+// implementation to use. Whenever you want to add a new observer type, you need to register its config handler
+// with RegisterTokenDataObserverConfig (see token_registry.go) and the matching observer constructor in the
+// observer package. The built-in observers (USDC/CCTP, rebase token) are registered the same way.
 // There are two additional checks for the TokenDataObserverConfig to enforce that it's semantically (Validate)
 // and syntactically correct (WellFormed).
 type TokenDataObserverConfig struct {
@@ -52,18 +64,24 @@ type TokenDataObserverConfig struct {
 	// Having version in that JSON isn't expensive, but it could reduce the risk of breaking the observers in the future.
 	Version string `json:"version"`
 
-	*USDCCCTPObserverConfig
+	// TypeConfig is the type specific config of the observer, e.g. *USDCCCTPObserverConfig. It's allocated by the
+	// handler registered for the type and version and it's flattened next to the type and version in JSON.
+	TypeConfig TokenDataObserverTypeConfig `json:"-"`
 }
 
 // WellFormed checks that the observer's config is syntactically correct - proper struct is initialized based on type
 func (t *TokenDataObserverConfig) WellFormed() error {
-	if t.IsUSDC() {
-		if t.USDCCCTPObserverConfig == nil {
-			return errors.New("USDCCCTPObserverConfig is empty")
-		}
-		return nil
+	handler, ok := lookupTokenDataObserverConfigHandler(t.Type, t.Version)
+	if !ok {
+		return fmt.Errorf("unknown token data observer type: %q", t.Type)
+	}
+	if t.TypeConfig == nil {
+		return fmt.Errorf("config of token data observer %q is empty", t.Type)
 	}
-	return errors.New("unknown token data observer type")
+	if handler.WellFormed != nil {
+		return handler.WellFormed(t.TypeConfig)
+	}
+	return nil
 }
 
 // Validate checks that the observer's config is semantically correct - fields are set correctly
@@ -72,41 +90,52 @@ func (t *TokenDataObserverConfig) Validate() error {
 	if err := t.WellFormed(); err != nil {
 		return err
 	}
-	if t.IsUSDC() {
-		return t.USDCCCTPObserverConfig.Validate()
+	handler, _ := lookupTokenDataObserverConfigHandler(t.Type, t.Version)
+	if handler.Validate != nil {
+		return handler.Validate(t.TypeConfig)
 	}
-	return errors.New("unknown token data observer type " + t.Type)
+	return t.TypeConfig.Validate()
 }
 
 func (t *TokenDataObserverConfig) IsUSDC() bool {
 	return t.Type == USDCCCTPHandlerType
 }
 
+func (t *TokenDataObserverConfig) IsRebaseToken() bool {
+	return t.Type == RebaseTokenHandlerType
+}
+
 // MarshalJSON is a custom JSON marshaller for TokenDataObserverConfig.
-// It constructs raw map based on provided type. Custom marshaller is needed because default golang marshaller
-// doesn't marshal clashing fields of pointer embeddings even if only one pointer is present and rest are set to nil
+// It flattens the type specific config next to the type and version fields.
 func (t *TokenDataObserverConfig) MarshalJSON() ([]byte, error) {
-	switch t.Type {
-	case USDCCCTPHandlerType:
-		return json.Marshal(&struct {
-			Type    string `json:"type"`
-			Version string `json:"version"`
-			*USDCCCTPObserverConfig
-		}{
-			Type:                   t.Type,
-			Version:                t.Version,
-			USDCCCTPObserverConfig: t.USDCCCTPObserverConfig,
-		})
-	default:
+	if _, ok := lookupTokenDataObserverConfigHandler(t.Type, t.Version); !ok {
 		return nil, fmt.Errorf("unknown token data observer type: %q", t.Type)
 	}
+
+	fields := make(map[string]json.RawMessage)
+	if t.TypeConfig != nil {
+		encoded, err := json.Marshal(t.TypeConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal config of token data observer %q: %w", t.Type, err)
+		}
+		if err := json.Unmarshal(encoded, &fields); err != nil {
+			return nil, fmt.Errorf("config of token data observer %q must be a JSON object: %w", t.Type, err)
+		}
+	}
+
+	var err error
+	if fields["type"], err = json.Marshal(t.Type); err != nil {
+		return nil, err
+	}
+	if fields["version"], err = json.Marshal(t.Version); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
 }
 
 // UnmarshalJSON is a custom JSON unmarshaller for TokenDataObserverConfig.
-// It first reads top-level fields, then allocates the correct embedded config pointer.
-// (only USDCCCTPObserverConfig for now) before finally unmarshalling into that pointer.
-// Custom unmarshaller is needed because default golang marshaller doesn't unmarshal clashing fields
-// (when they appear beside USDC) of pointer embeddings
+// It first reads top-level fields, then allocates the type specific config using the registered handler
+// before finally unmarshalling into that config.
 func (t *TokenDataObserverConfig) UnmarshalJSON(data []byte) error {
 	var raw struct {
 		Type    string `json:"type"`
@@ -119,16 +148,16 @@ func (t *TokenDataObserverConfig) UnmarshalJSON(data []byte) error {
 	t.Type = raw.Type
 	t.Version = raw.Version
 
-	switch t.Type {
-	case USDCCCTPHandlerType:
-		t.USDCCCTPObserverConfig = &USDCCCTPObserverConfig{}
-		if err := json.Unmarshal(data, t.USDCCCTPObserverConfig); err != nil {
-			return fmt.Errorf("failed to unmarshal USDCCCTPObserverConfig: %w", err)
-		}
-	default:
+	handler, ok := lookupTokenDataObserverConfigHandler(t.Type, t.Version)
+	if !ok {
 		return fmt.Errorf("unknown token data observer type: %q", t.Type)
 	}
 
+	typeConfig := handler.NewConfig()
+	if err := json.Unmarshal(data, typeConfig); err != nil {
+		return fmt.Errorf("failed to unmarshal config of token data observer %q: %w", t.Type, err)
+	}
+	t.TypeConfig = typeConfig
 	return nil
 }
 
@@ -139,6 +168,10 @@ type AttestationConfig struct {
 	// AttestationAPIInterval defines the rate in requests per second that the attestation API can be called.
 	// Default set according to the APIs documentated 10 requests per second rate limit.
 	AttestationAPIInterval *commonconfig.Duration `json:"attestationAPIInterval"`
+	// AttestationAPIRateLimiterFile is an optional absolute path of the file coordinating the rate limit between
+	// plugin processes running on the same host (e.g. LOOPPs of multiple lanes and DONs). All processes using the same
+	// file share AttestationAPIInterval and the cool down period. Rate limit is local to the process when empty.
+	AttestationAPIRateLimiterFile string `json:"attestationAPIRateLimiterFile,omitempty"`
 }
 
 func (p *AttestationConfig) setDefaults() {
@@ -165,6 +198,9 @@ func (p *AttestationConfig) Validate() error {
 	if p.AttestationAPITimeout == nil || p.AttestationAPITimeout.Duration() == 0 {
 		return errors.New("AttestationAPITimeout not set")
 	}
+	if p.AttestationAPIRateLimiterFile != "" && !filepath.IsAbs(p.AttestationAPIRateLimiterFile) {
+		return errors.New("AttestationAPIRateLimiterFile must be an absolute path")
+	}
 	return nil
 }
 
@@ -177,6 +213,10 @@ type WorkerConfig struct {
 	CacheCleanupInterval *commonconfig.Duration `json:"cacheCleanupInterval"`
 	// ObserveTimeout is the timeout for the actual synchronous Observe calls.
 	ObserveTimeout *commonconfig.Duration `json:"observeTimeout"`
+	// CacheDir is an optional absolute path of the directory where the fetched token data are persisted, so that
+	// they survive node restarts. Persisted data expire after CacheExpirationInterval, the same as in memory.
+	// Only background observers (NumWorkers > 0) support persistent cache.
+	CacheDir string `json:"cacheDir,omitempty"`
 }
 
 func (c *WorkerConfig) IsForeground() bool {
@@ -203,8 +243,14 @@ func (c *WorkerConfig) setDefaults() {
 func (c *WorkerConfig) Validate() error {
 	c.setDefaults()
 	if c.IsForeground() {
+		if c.CacheDir != "" {
+			return errors.New("CacheDir requires NumWorkers to be set")
+		}
 		return nil
 	}
+	if c.CacheDir != "" && !filepath.IsAbs(c.CacheDir) {
+		return errors.New("CacheDir must be an absolute path")
+	}
 	if c.CacheExpirationInterval == nil || c.CacheExpirationInterval.Duration() == 0 {
 		return errors.New("CacheExpirationInterval not set")
 	}
@@ -224,6 +270,10 @@ type USDCCCTPObserverConfig struct {
 	// Activates when plugin hits API's rate limits
 	AttestationAPICooldown *commonconfig.Duration                          `json:"attestationAPICooldown"`
 	Tokens                 map[cciptypes.ChainSelector]USDCCCTPTokenConfig `json:"tokens"`
+	// MinFinalityThreshold is used only by the CCTP v2 observer. Attestations issued below that finality threshold
+	// are treated as not ready. Set it to 1000 to accept Fast Transfer attestations, defaults to the standard
+	// finality threshold (2000).
+	MinFinalityThreshold uint32 `json:"minFinalityThreshold,omitempty"`
 }
 
 func (p *USDCCCTPObserverConfig) setDefaults() {
@@ -232,6 +282,34 @@ func (p *USDCCCTPObserverConfig) setDefaults() {
 	}
 }
 
+// ValidateV2 validates the config of the CCTP v2 observer. In contrast to v1, it also validates
+// the MinFinalityThreshold of the attested messages.
+func (p *USDCCCTPObserverConfig) ValidateV2() error {
+	p.setDefaults()
+	if p.MinFinalityThreshold == 0 {
+		p.MinFinalityThreshold = cctpFinalityThresholdStandard
+	}
+	if p.MinFinalityThreshold < cctpFinalityThresholdFast || p.MinFinalityThreshold > cctpFinalityThresholdStandard {
+		return fmt.Errorf("MinFinalityThreshold must be between %d and %d",
+			cctpFinalityThresholdFast, cctpFinalityThresholdStandard)
+	}
+	if err := p.AttestationConfig.Validate(); err != nil {
+		return err
+	}
+	if err := p.WorkerConfig.Validate(); err != nil {
+		return err
+	}
+	if len(p.Tokens) == 0 {
+		return errors.New("Tokens not set")
+	}
+	for _, token := range p.Tokens {
+		if err := token.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (p *USDCCCTPObserverConfig) Validate() error {
 	p.setDefaults()
 	err := p.AttestationConfig.Validate()
@@ -271,3 +349,39 @@ func (t USDCCCTPTokenConfig) Validate() error {
 	}
 	return nil
 }
+
+// RebaseTokenObserverConfig configures the observer for rebase (yield-bearing) tokens whose pools ship
+// the sender's per-user interest rate in the source pool data (RampTokenAmount.ExtraData).
+type RebaseTokenObserverConfig struct {
+	Tokens map[cciptypes.ChainSelector]RebaseTokenConfig `json:"tokens"`
+}
+
+func (p *RebaseTokenObserverConfig) Validate() error {
+	if len(p.Tokens) == 0 {
+		return errors.New("Tokens not set")
+	}
+	for _, token := range p.Tokens {
+		if err := token.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type RebaseTokenConfig struct {
+	// SourcePoolAddress is the address of the rebase token pool on the source chain
+	SourcePoolAddress string `json:"sourcePoolAddress"`
+	// SourceTokenAddress is the address of the rebase token on the source chain. It's used for reading
+	// the global interest rate which per-user rates are validated against.
+	SourceTokenAddress string `json:"sourceTokenAddress"`
+}
+
+func (t RebaseTokenConfig) Validate() error {
+	if t.SourcePoolAddress == "" {
+		return errors.New("SourcePoolAddress not set")
+	}
+	if t.SourceTokenAddress == "" {
+		return errors.New("SourceTokenAddress not set")
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package pluginconfig
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// AnyTokenDataObserverVersion registers a handler for every version of the token data observer type.
+// Handler registered for the exact version always takes precedence over the one registered for any version.
+const AnyTokenDataObserverVersion = "*"
+
+// TokenDataObserverTypeConfig is the type specific part of the TokenDataObserverConfig,
+// e.g. USDCCCTPObserverConfig. It's decoded from the same JSON object as the type and version fields.
+type TokenDataObserverTypeConfig interface {
+	// Validate checks that the config is semantically correct. It's allowed to apply defaults.
+	Validate() error
+}
+
+// TokenDataObserverConfigHandler describes how the TokenDataObserverConfig of the specific type and version
+// is decoded and validated.
+type TokenDataObserverConfigHandler struct {
+	// NewConfig allocates an empty type specific config, raw JSON of the observer is unmarshalled into it.
+	// It must return a pointer.
+	NewConfig func() TokenDataObserverTypeConfig
+	// WellFormed is an optional syntactic check of the decoded config.
+	WellFormed func(TokenDataObserverTypeConfig) error
+	// Validate is an optional semantic check of the decoded config. TokenDataObserverTypeConfig.Validate
+	// is used if not set.
+	Validate func(TokenDataObserverTypeConfig) error
+}
+
+type tokenDataObserverKey struct {
+	observerType string
+	version      string
+}
+
+var (
+	tokenDataObserverConfigHandlersMu sync.RWMutex
+	tokenDataObserverConfigHandlers   = make(map[tokenDataObserverKey]TokenDataObserverConfigHandler)
+)
+
+// RegisterTokenDataObserverConfig registers config handler for the token data observer type and version.
+// It's meant to be called from the init function of the package implementing the observer, so the config can be
+// decoded from the ExecuteOffchainConfig without changing this package.
+// Use AnyTokenDataObserverVersion to register the handler for every version of the type.
+func RegisterTokenDataObserverConfig(
+	observerType, version string,
+	handler TokenDataObserverConfigHandler,
+) error {
+	if observerType == "" {
+		return errors.New("token data observer type not set")
+	}
+	if version == "" {
+		return errors.New("token data observer version not set")
+	}
+	if handler.NewConfig == nil {
+		return errors.New("token data observer NewConfig not set")
+	}
+
+	tokenDataObserverConfigHandlersMu.Lock()
+	defer tokenDataObserverConfigHandlersMu.Unlock()
+
+	key := tokenDataObserverKey{observerType: observerType, version: version}
+	if _, exists := tokenDataObserverConfigHandlers[key]; exists {
+		return fmt.Errorf("token data observer config %q version %q already registered", observerType, version)
+	}
+	tokenDataObserverConfigHandlers[key] = handler
+	return nil
+}
+
+// MustRegisterTokenDataObserverConfig is the same as RegisterTokenDataObserverConfig, but panics on error.
+func MustRegisterTokenDataObserverConfig(
+	observerType, version string,
+	handler TokenDataObserverConfigHandler,
+) {
+	if err := RegisterTokenDataObserverConfig(observerType, version, handler); err != nil {
+		panic(err)
+	}
+}
+
+func lookupTokenDataObserverConfigHandler(
+	observerType, version string,
+) (TokenDataObserverConfigHandler, bool) {
+	tokenDataObserverConfigHandlersMu.RLock()
+	defer tokenDataObserverConfigHandlersMu.RUnlock()
+
+	if h, ok := tokenDataObserverConfigHandlers[tokenDataObserverKey{observerType, version}]; ok {
+		return h, true
+	}
+	h, ok := tokenDataObserverConfigHandlers[tokenDataObserverKey{observerType, AnyTokenDataObserverVersion}]
+	return h, ok
+}
+
+func init() {
+	MustRegisterTokenDataObserverConfig(USDCCCTPHandlerType, AnyTokenDataObserverVersion,
+		TokenDataObserverConfigHandler{
+			NewConfig: func() TokenDataObserverTypeConfig { return &USDCCCTPObserverConfig{} },
+		})
+	MustRegisterTokenDataObserverConfig(USDCCCTPHandlerType, USDCCCTPVersion2,
+		TokenDataObserverConfigHandler{
+			NewConfig: func() TokenDataObserverTypeConfig { return &USDCCCTPObserverConfig{} },
+			Validate: func(c TokenDataObserverTypeConfig) error {
+				return c.(*USDCCCTPObserverConfig).ValidateV2()
+			},
+		})
+	MustRegisterTokenDataObserverConfig(RebaseTokenHandlerType, AnyTokenDataObserverVersion,
+		TokenDataObserverConfigHandler{
+			NewConfig: func() TokenDataObserverTypeConfig { return &RebaseTokenObserverConfig{} },
+		})
+}
@@ -0,0 +1,141 @@
+package pluginconfig
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type attestationTestConfig struct {
+	AttestationAPI string   `json:"attestationAPI"`
+	Tokens         []string `json:"tokens"`
+}
+
+func (c *attestationTestConfig) Validate() error {
+	if c.AttestationAPI == "" {
+		return errors.New("AttestationAPI not set")
+	}
+	return nil
+}
+
+// registryTestTypes makes the observer types registered by the tests unique, the registry is global and the same
+// type can't be registered twice, e.g. when running the tests with -count.
+var registryTestTypes atomic.Int64
+
+func registryTestType(name string) string {
+	return fmt.Sprintf("registry-test-%s-%d", name, registryTestTypes.Add(1))
+}
+
+func Test_TokenDataObserverRegistry_CustomType(t *testing.T) {
+	observerType := registryTestType("attestation")
+
+	require.NoError(t, RegisterTokenDataObserverConfig(observerType, AnyTokenDataObserverVersion,
+		TokenDataObserverConfigHandler{
+			NewConfig: func() TokenDataObserverTypeConfig { return &attestationTestConfig{} },
+			WellFormed: func(c TokenDataObserverTypeConfig) error {
+				if len(c.(*attestationTestConfig).Tokens) == 0 {
+					return errors.New("Tokens not set")
+				}
+				return nil
+			},
+		}))
+
+	t.Run("duplicate registration fails", func(t *testing.T) {
+		err := RegisterTokenDataObserverConfig(observerType, AnyTokenDataObserverVersion,
+			TokenDataObserverConfigHandler{
+				NewConfig: func() TokenDataObserverTypeConfig { return &attestationTestConfig{} },
+			})
+		require.ErrorContains(t, err, "already registered")
+	})
+
+	t.Run("json round trip", func(t *testing.T) {
+		configs := []TokenDataObserverConfig{
+			{
+				Type:    observerType,
+				Version: "1.0",
+				TypeConfig: &attestationTestConfig{
+					AttestationAPI: "http://localhost:8080",
+					Tokens:         []string{"0xabc"},
+				},
+			},
+		}
+
+		encoded, err := json.Marshal(configs)
+		require.NoError(t, err)
+		require.JSONEq(t, fmt.Sprintf(`[
+			{
+				"type": %q,
+				"version": "1.0",
+				"attestationAPI": "http://localhost:8080",
+				"tokens": ["0xabc"]
+			}
+		]`, observerType), string(encoded))
+
+		var decoded []TokenDataObserverConfig
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+		require.Equal(t, configs, decoded)
+		require.NoError(t, decoded[0].Validate())
+	})
+
+	t.Run("registered hooks are used for validation", func(t *testing.T) {
+		cfg := TokenDataObserverConfig{
+			Type:       observerType,
+			Version:    "1.0",
+			TypeConfig: &attestationTestConfig{AttestationAPI: "http://localhost:8080"},
+		}
+		require.ErrorContains(t, cfg.WellFormed(), "Tokens not set")
+
+		cfg = TokenDataObserverConfig{
+			Type:       observerType,
+			Version:    "1.0",
+			TypeConfig: &attestationTestConfig{Tokens: []string{"0xabc"}},
+		}
+		require.NoError(t, cfg.WellFormed())
+		require.ErrorContains(t, cfg.Validate(), "AttestationAPI not set")
+
+		cfg = TokenDataObserverConfig{Type: observerType, Version: "1.0"}
+		require.ErrorContains(t, cfg.WellFormed(), "is empty")
+	})
+}
+
+func Test_TokenDataObserverRegistry_VersionPrecedence(t *testing.T) {
+	observerType := registryTestType("versioned")
+
+	type v2Config struct {
+		attestationTestConfig
+		FastTransfer bool `json:"fastTransfer"`
+	}
+
+	require.NoError(t, RegisterTokenDataObserverConfig(observerType, AnyTokenDataObserverVersion,
+		TokenDataObserverConfigHandler{
+			NewConfig: func() TokenDataObserverTypeConfig { return &attestationTestConfig{} },
+		}))
+	require.NoError(t, RegisterTokenDataObserverConfig(observerType, "2.0",
+		TokenDataObserverConfigHandler{
+			NewConfig: func() TokenDataObserverTypeConfig { return &v2Config{} },
+		}))
+
+	var v1 TokenDataObserverConfig
+	require.NoError(t, json.Unmarshal(
+		[]byte(fmt.Sprintf(`{"type": %q, "version": "1.0", "attestationAPI": "a"}`, observerType)), &v1))
+	require.IsType(t, &attestationTestConfig{}, v1.TypeConfig)
+
+	var v2 TokenDataObserverConfig
+	require.NoError(t, json.Unmarshal(
+		[]byte(fmt.Sprintf(`{"type": %q, "version": "2.0", "fastTransfer": true}`, observerType)), &v2))
+	require.IsType(t, &v2Config{}, v2.TypeConfig)
+	require.True(t, v2.TypeConfig.(*v2Config).FastTransfer)
+}
+
+func Test_TokenDataObserverRegistry_InvalidRegistration(t *testing.T) {
+	handler := TokenDataObserverConfigHandler{
+		NewConfig: func() TokenDataObserverTypeConfig { return &attestationTestConfig{} },
+	}
+	require.Error(t, RegisterTokenDataObserverConfig("", "1.0", handler))
+	require.Error(t, RegisterTokenDataObserverConfig("registry-test-invalid", "", handler))
+	require.Error(t, RegisterTokenDataObserverConfig("registry-test-invalid", "1.0", TokenDataObserverConfigHandler{}))
+}
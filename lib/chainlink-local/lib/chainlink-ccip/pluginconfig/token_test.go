@@ -60,9 +60,9 @@ func Test_TokenDataObserver_Unmarshal(t *testing.T) {
 			wantErr: false,
 			want: []TokenDataObserverConfig{
 				{
-					Type:                   "usdc-cctp",
-					Version:                "1.0",
-					USDCCCTPObserverConfig: &USDCCCTPObserverConfig{},
+					Type:       "usdc-cctp",
+					Version:    "1.0",
+					TypeConfig: &USDCCCTPObserverConfig{},
 				},
 			},
 		},
@@ -92,7 +92,7 @@ func Test_TokenDataObserver_Unmarshal(t *testing.T) {
 				{
 					Type:    "usdc-cctp",
 					Version: "1.0",
-					USDCCCTPObserverConfig: &USDCCCTPObserverConfig{
+					TypeConfig: &USDCCCTPObserverConfig{
 						AttestationConfig: AttestationConfig{
 							AttestationAPI:         "http://localhost:8080",
 							AttestationAPITimeout:  commonconfig.MustNewDuration(time.Second),
@@ -141,7 +141,7 @@ func Test_TokenDataObserver_Unmarshal(t *testing.T) {
 				{
 					Type:    "usdc-cctp",
 					Version: "1.0",
-					USDCCCTPObserverConfig: &USDCCCTPObserverConfig{
+					TypeConfig: &USDCCCTPObserverConfig{
 						AttestationConfig: AttestationConfig{
 							AttestationAPI:         "http://localhost:8080",
 							AttestationAPITimeout:  commonconfig.MustNewDuration(time.Second),
@@ -162,6 +162,35 @@ func Test_TokenDataObserver_Unmarshal(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "valid config with RebaseTokenObserverConfig",
+			json: `"tokenDataObservers": [
+							{
+							  "type": "rebase-token",
+							  "version": "1.0",
+							  "tokens": {
+								"1": {
+								  "sourcePoolAddress": "0xabc",
+								  "sourceTokenAddress": "0xefg"
+								}
+							  }
+							}
+				  	],`,
+			want: []TokenDataObserverConfig{
+				{
+					Type:    "rebase-token",
+					Version: "1.0",
+					TypeConfig: &RebaseTokenObserverConfig{
+						Tokens: map[cciptypes.ChainSelector]RebaseTokenConfig{
+							1: {
+								SourcePoolAddress:  "0xabc",
+								SourceTokenAddress: "0xefg",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -208,9 +237,9 @@ func Test_TokenDataObserver_Marshal(t *testing.T) {
 			name: "empty usdc is set",
 			config: []TokenDataObserverConfig{
 				{
-					Type:                   "usdc-cctp",
-					Version:                "1.0",
-					USDCCCTPObserverConfig: &USDCCCTPObserverConfig{},
+					Type:       "usdc-cctp",
+					Version:    "1.0",
+					TypeConfig: &USDCCCTPObserverConfig{},
 				},
 			},
 			wantJSON: `[
@@ -236,7 +265,7 @@ func Test_TokenDataObserver_Marshal(t *testing.T) {
 				{
 					Type:    "usdc-cctp",
 					Version: "1.0",
-					USDCCCTPObserverConfig: &USDCCCTPObserverConfig{
+					TypeConfig: &USDCCCTPObserverConfig{
 						AttestationConfig: AttestationConfig{
 							AttestationAPI:         "http://localhost:8080",
 							AttestationAPITimeout:  commonconfig.MustNewDuration(time.Second),
@@ -285,7 +314,7 @@ func Test_TokenDataObserver_Marshal(t *testing.T) {
 				{
 					Type:    "usdc-cctp",
 					Version: "1.0",
-					USDCCCTPObserverConfig: &USDCCCTPObserverConfig{
+					TypeConfig: &USDCCCTPObserverConfig{
 						AttestationConfig: AttestationConfig{
 							AttestationAPI:         "http://localhost:8080",
 							AttestationAPITimeout:  commonconfig.MustNewDuration(time.Second),
@@ -330,6 +359,35 @@ func Test_TokenDataObserver_Marshal(t *testing.T) {
 					   	}
 				  	   ]`,
 		},
+		{
+			name: "valid config with RebaseTokenObserverConfig",
+			config: []TokenDataObserverConfig{
+				{
+					Type:    "rebase-token",
+					Version: "1.0",
+					TypeConfig: &RebaseTokenObserverConfig{
+						Tokens: map[cciptypes.ChainSelector]RebaseTokenConfig{
+							1: {
+								SourcePoolAddress:  "0xabc",
+								SourceTokenAddress: "0xefg",
+							},
+						},
+					},
+				},
+			},
+			wantJSON: `[
+							{
+							  "type": "rebase-token",
+							  "version": "1.0",
+							  "tokens": {
+								"1": {
+								  "sourcePoolAddress": "0xabc",
+								  "sourceTokenAddress": "0xefg"
+								}
+							  }
+							}
+				  		]`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -414,9 +472,9 @@ func Test_TokenDataObserver_Validation(t *testing.T) {
 			name: "usdc type is set but struct is empty",
 			config: withBaseConfig(
 				TokenDataObserverConfig{
-					Type:                   "usdc-cctp",
-					Version:                "1.0",
-					USDCCCTPObserverConfig: &USDCCCTPObserverConfig{},
+					Type:       "usdc-cctp",
+					Version:    "1.0",
+					TypeConfig: &USDCCCTPObserverConfig{},
 				}),
 			usdcEnabled: true,
 			wantErr:     true,
@@ -428,7 +486,7 @@ func Test_TokenDataObserver_Validation(t *testing.T) {
 				TokenDataObserverConfig{
 					Type:    "usdc-cctp",
 					Version: "1.0",
-					USDCCCTPObserverConfig: &USDCCCTPObserverConfig{
+					TypeConfig: &USDCCCTPObserverConfig{
 						AttestationConfig: AttestationConfig{
 							AttestationAPI:         "http://localhost:8080",
 							AttestationAPITimeout:  commonconfig.MustNewDuration(time.Second),
@@ -445,14 +503,14 @@ func Test_TokenDataObserver_Validation(t *testing.T) {
 			name: "the same observer can't bet set twice",
 			config: withBaseConfig(
 				TokenDataObserverConfig{
-					Type:                   "usdc-cctp",
-					Version:                "1.0",
-					USDCCCTPObserverConfig: withUSDCConfig(),
+					Type:       "usdc-cctp",
+					Version:    "1.0",
+					TypeConfig: withUSDCConfig(),
 				},
 				TokenDataObserverConfig{
-					Type:                   "usdc-cctp",
-					Version:                "1.0",
-					USDCCCTPObserverConfig: withUSDCConfig(),
+					Type:       "usdc-cctp",
+					Version:    "1.0",
+					TypeConfig: withUSDCConfig(),
 				}),
 			usdcEnabled: true,
 			wantErr:     true,
@@ -462,25 +520,135 @@ func Test_TokenDataObserver_Validation(t *testing.T) {
 			name: "valid config with multiple the same observers types but different versions",
 			config: withBaseConfig(
 				TokenDataObserverConfig{
-					Type:                   "usdc-cctp",
-					Version:                "1.0",
-					USDCCCTPObserverConfig: withUSDCConfig(),
+					Type:       "usdc-cctp",
+					Version:    "1.0",
+					TypeConfig: withUSDCConfig(),
 				},
 				TokenDataObserverConfig{
-					Type:                   "usdc-cctp",
-					Version:                "2.0",
-					USDCCCTPObserverConfig: withUSDCConfig(),
+					Type:       "usdc-cctp",
+					Version:    "2.0",
+					TypeConfig: withUSDCConfig(),
 				},
 			),
 			usdcEnabled: true,
 		},
+		{
+			name: "usdc v2 requires message transmitter",
+			config: withBaseConfig(
+				TokenDataObserverConfig{
+					Type:    "usdc-cctp",
+					Version: "2.0",
+					TypeConfig: func() *USDCCCTPObserverConfig {
+						cfg := withUSDCConfig()
+						cfg.Tokens = map[cciptypes.ChainSelector]USDCCCTPTokenConfig{1: {SourcePoolAddress: "0xabc"}}
+						cfg.MinFinalityThreshold = 1000
+						return cfg
+					}(),
+				}),
+			usdcEnabled: true,
+			wantErr:     true,
+			errMsg:      "SourceMessageTransmitterAddress not set",
+		},
+		{
+			name: "usdc v2 with invalid finality threshold",
+			config: withBaseConfig(
+				TokenDataObserverConfig{
+					Type:    "usdc-cctp",
+					Version: "2.0",
+					TypeConfig: func() *USDCCCTPObserverConfig {
+						cfg := withUSDCConfig()
+						cfg.MinFinalityThreshold = 500
+						return cfg
+					}(),
+				}),
+			usdcEnabled: true,
+			wantErr:     true,
+			errMsg:      "MinFinalityThreshold must be between",
+		},
+		{
+			name: "usdc persistent cache dir must be absolute",
+			config: withBaseConfig(
+				TokenDataObserverConfig{
+					Type:    "usdc-cctp",
+					Version: "1.0",
+					TypeConfig: func() *USDCCCTPObserverConfig {
+						cfg := withUSDCConfig()
+						cfg.CacheDir = "relative/path"
+						return cfg
+					}(),
+				}),
+			usdcEnabled: true,
+			wantErr:     true,
+			errMsg:      "CacheDir must be an absolute path",
+		},
+		{
+			name: "usdc shared rate limiter file must be absolute",
+			config: withBaseConfig(
+				TokenDataObserverConfig{
+					Type:    "usdc-cctp",
+					Version: "1.0",
+					TypeConfig: func() *USDCCCTPObserverConfig {
+						cfg := withUSDCConfig()
+						cfg.AttestationAPIRateLimiterFile = "attestation.lock"
+						return cfg
+					}(),
+				}),
+			usdcEnabled: true,
+			wantErr:     true,
+			errMsg:      "AttestationAPIRateLimiterFile must be an absolute path",
+		},
+		{
+			name: "rebase token type is set but tokens are missing",
+			config: withBaseConfig(
+				TokenDataObserverConfig{
+					Type:       "rebase-token",
+					Version:    "1.0",
+					TypeConfig: &RebaseTokenObserverConfig{},
+				}),
+			wantErr: true,
+			errMsg:  "Tokens not set",
+		},
+		{
+			name: "rebase token type is set but token address is missing",
+			config: withBaseConfig(
+				TokenDataObserverConfig{
+					Type:    "rebase-token",
+					Version: "1.0",
+					TypeConfig: &RebaseTokenObserverConfig{
+						Tokens: map[cciptypes.ChainSelector]RebaseTokenConfig{
+							1: {SourcePoolAddress: "0xabc"},
+						},
+					},
+				}),
+			wantErr: true,
+			errMsg:  "SourceTokenAddress not set",
+		},
+		{
+			name: "valid config with usdc and rebase token observers",
+			config: withBaseConfig(
+				TokenDataObserverConfig{
+					Type:       "usdc-cctp",
+					Version:    "1.0",
+					TypeConfig: withUSDCConfig(),
+				},
+				TokenDataObserverConfig{
+					Type:    "rebase-token",
+					Version: "1.0",
+					TypeConfig: &RebaseTokenObserverConfig{
+						Tokens: map[cciptypes.ChainSelector]RebaseTokenConfig{
+							1: {SourcePoolAddress: "0xabc", SourceTokenAddress: "0xefg"},
+						},
+					},
+				}),
+			usdcEnabled: true,
+		},
 		{
 			name: "valid config with single usdc observer",
 			config: withBaseConfig(
 				TokenDataObserverConfig{
-					Type:                   "usdc-cctp",
-					Version:                "1.0",
-					USDCCCTPObserverConfig: withUSDCConfig(),
+					Type:       "usdc-cctp",
+					Version:    "1.0",
+					TypeConfig: withUSDCConfig(),
 				}),
 			usdcEnabled: true,
 		},